@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -107,6 +108,10 @@ import (
 
 const (
 	appName = "emoneyd"
+
+	// Upgrade running the module migrations that move the previous proposer of the distribution module from the
+	// side database into the consensus state.
+	upgProposerInState = "proposer-in-state"
 )
 
 var (
@@ -148,7 +153,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		// em modules
-		inflation.ModuleName:         {authtypes.Minter},
+		inflation.ModuleName:         {authtypes.Minter, authtypes.Burner},
 		emslashing.ModuleName:        nil, // TODO Remove this line?
 		liquidityprovider.ModuleName: {authtypes.Minter, authtypes.Burner},
 		buyback.ModuleName:           {authtypes.Burner},
@@ -173,7 +178,10 @@ type EMoneyApp struct {
 	interfaceRegistry types.InterfaceRegistry
 
 	database     db.DB
-	currentBatch db.Batch
+	currentBatch *journaledBatch
+
+	// Adds the slashing state kept in database to state-sync snapshots
+	slashingSnapshotter *emslashing.Snapshotter
 
 	invCheckPeriod uint
 
@@ -362,6 +370,13 @@ func NewApp(
 				return app.mm.RunMigrations(ctx, app.configurator, newVM)
 			})
 
+		app.upgradeKeeper.SetUpgradeHandler(
+			upgProposerInState,
+			func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				ctx.Logger().Info("Upgrading to " + upgProposerInState)
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			})
+
 		upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
 		if err != nil {
 			panic(err)
@@ -411,12 +426,15 @@ func NewApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.inflationKeeper = inflation.NewKeeper(app.appCodec, keys[inflation.StoreKey], app.bankKeeper, app.accountKeeper, app.stakingKeeper, buyback.AccountName, authtypes.FeeCollectorName)
+	app.inflationKeeper = inflation.NewKeeper(app.appCodec, keys[inflation.StoreKey], app.bankKeeper, app.accountKeeper, app.stakingKeeper, app.distrKeeper, buyback.AccountName, authtypes.FeeCollectorName)
+	app.bankKeeper.SetBalanceSettler(app.inflationKeeper)
 	app.lpKeeper = liquidityprovider.NewKeeper(app.appCodec, keys[lptypes.StoreKey], app.bankKeeper)
 	app.issuerKeeper = issuer.NewKeeper(app.appCodec, keys[issuer.StoreKey], app.lpKeeper, app.inflationKeeper, app.bankKeeper)
-	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper, app.paramsKeeper)
+	app.bankKeeper.SetTransferRestrictions(app.issuerKeeper)
+	app.lpKeeper.SetSupplyCaps(app.issuerKeeper)
+	app.authorityKeeper = authority.NewKeeper(app.appCodec, app.interfaceRegistry, keys[authority.StoreKey], app.issuerKeeper, app.inflationKeeper, app.bankKeeper, app, &app.upgradeKeeper, app.paramsKeeper)
 	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.accountKeeper, app.bankKeeper)
-	app.buybackKeeper = buyback.NewKeeper(app.appCodec, keys[buyback.StoreKey], app.marketKeeper, app.accountKeeper, app.stakingKeeper, app.bankKeeper)
+	app.buybackKeeper = buyback.NewKeeper(app.appCodec, keys[buyback.StoreKey], app.GetSubspace(buyback.ModuleName), app.marketKeeper, app.accountKeeper, app.stakingKeeper, app.bankKeeper, app.distrKeeper, authtypes.FeeCollectorName)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
@@ -443,7 +461,7 @@ func NewApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
-		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), app.distrKeeper, app.accountKeeper, app.bankKeeper, app.database),
+		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), keys[distrtypes.StoreKey], app.distrKeeper, app.accountKeeper, app.bankKeeper, app.database),
 		liquidityprovider.NewAppModule(app.lpKeeper),
 		issuer.NewAppModule(app.issuerKeeper),
 		authority.NewAppModule(app.authorityKeeper),
@@ -556,6 +574,8 @@ func NewApp(
 			SignModeHandler:  encodingConfig.TxConfig.SignModeHandler(),
 			SigGasConsumer:   sdkante.DefaultSigVerificationGasConsumer,
 			StakingKeeper:    app.stakingKeeper,
+			GasPricesKeeper:  app.authorityKeeper,
+			MarketKeeper:     app.marketKeeper,
 			IBCChannelkeeper: channelkeeper.Keeper{},
 		},
 	)
@@ -565,6 +585,15 @@ func NewApp(
 
 	app.SetAnteHandler(anteHandler)
 
+	app.slashingSnapshotter = emslashing.NewSnapshotter(
+		app.slashingKeeper, app.database, cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+	)
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(app.slashingSnapshotter); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -574,6 +603,14 @@ func NewApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to load latest version: %s", err))
 		}
+
+		recovered, err := recoverDatabase(app.database, app.LastBlockHeight())
+		if err != nil {
+			tmos.Exit(fmt.Sprintf("failed to recover database: %s", err))
+		}
+		if recovered {
+			logger.Info("recovered database after unclean shutdown", "height", app.LastBlockHeight())
+		}
 	}
 
 	app.scopedIBCKeeper = scopedIBCKeeper
@@ -612,7 +649,7 @@ func (app *EMoneyApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock)
 		panic(s)
 	}
 
-	app.currentBatch = app.database.NewBatch() // store in app state as ctx is different in end block
+	app.currentBatch = newJournaledBatch(app.database) // store in app state as ctx is different in end block
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ctx = apptypes.WithCurrentBatch(ctx, app.currentBatch)
 
@@ -626,13 +663,31 @@ func (app *EMoneyApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci
 	app.Logger(ctx).Info(fmt.Sprintf("Endblock: Block %v was proposed by %v", ctx.BlockHeight(), sdk.ValAddress(proposerAddress)))
 
 	response := app.mm.EndBlock(ctx, req)
-	err := app.currentBatch.Write() // Write non-IAVL state to database
-	if err != nil {                 // todo (reviewer): should we panic or ignore? panics are not handled downstream will cause a crash
+	// Write non-IAVL state to database. It is only marked as committed once the IAVL stores have been committed.
+	if err := app.currentBatch.WritePending(ctx.BlockHeight()); err != nil {
 		panic(err)
 	}
+
+	app.slashingSnapshotter.Capture(ctx.BlockHeight())
 	return response
 }
 
+// Commit commits the IAVL stores and then marks the non-IAVL state of the block as committed
+func (app *EMoneyApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+
+	if app.currentBatch != nil {
+		app.currentBatch.Close()
+		app.currentBatch = nil
+	}
+
+	if err := commitDatabase(app.database, app.LastBlockHeight()); err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
 // InitChainer application update at chain initialization
 func (app *EMoneyApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) (res abci.ResponseInitChain) {
 	var genesisState GenesisState
@@ -642,7 +697,15 @@ func (app *EMoneyApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) (r
 
 	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	// Modules import their non-IAVL state into the database
+	batch := app.database.NewBatch()
+	ctx = apptypes.WithCurrentBatch(ctx, batch)
+	res = app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+
+	return res
 }
 
 func (app *EMoneyApp) ModuleAccountAddrs() map[string]bool {
@@ -760,6 +823,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(buyback.ModuleName)
 
 	return paramsKeeper
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -30,6 +31,7 @@ import (
 	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/authority"
 	authtypes "github.com/e-money/em-ledger/x/authority/types"
+	emdistr "github.com/e-money/em-ledger/x/distribution"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -502,6 +504,35 @@ func Test_Upgrade(t *testing.T) {
 	}
 }
 
+func TestProposerInStateUpgrade(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	var (
+		suite    = emAppTests{}.initEmApp(t)
+		app      = suite.app
+		ctx      = suite.ctx
+		proposer = sdk.ConsAddress("proposer____________")
+		plan     = upgradetypes.Plan{Name: upgProposerInState, Height: ctx.BlockHeight()}
+	)
+
+	// Recreate the state of a chain at version 2 of the distribution module, which kept the previous proposer in the
+	// side database only.
+	distrStore := ctx.KVStore(app.keys[distrtypes.StoreKey])
+	distrStore.Delete([]byte("emdistr/proposerinstate"))
+	require.NoError(t, app.database.Set([]byte("emdistr/previousproposer"), proposer))
+
+	fromVM := app.upgradeKeeper.GetModuleVersionMap(ctx)
+	fromVM[distrtypes.ModuleName] = 2
+	app.upgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+	require.False(t, emdistr.IsProposerInState(ctx, app.keys[distrtypes.StoreKey]))
+
+	app.upgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	require.True(t, emdistr.IsProposerInState(ctx, app.keys[distrtypes.StoreKey]))
+	require.Equal(t, proposer, app.distrKeeper.GetPreviousProposerConsAddr(ctx))
+	require.Equal(t, app.mm.GetVersionMap(), app.upgradeKeeper.GetModuleVersionMap(ctx))
+}
+
 func executePlan(
 	ctx sdk.Context, t *testing.T, uk upgradekeeper.Keeper, ak authority.Keeper,
 	plan upgradetypes.Plan,
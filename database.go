@@ -0,0 +1,211 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package emoney
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// The non-IAVL database is written before the IAVL stores are committed. Every block's writes are stored together with
+// the previous values of the keys they touch and the height of the block. Once the IAVL commit has completed the
+// block is marked as committed and the undo entries are removed. On startup a block that never reached the IAVL
+// stores is rolled back, so Tendermint can replay it against consistent state.
+var (
+	dbKeyCommittedHeight = []byte("emapp/committedheight")
+	dbKeyPendingHeight   = []byte("emapp/pendingheight")
+	dbKeyUndoPrefix      = []byte("emapp/undo/")
+)
+
+const (
+	undoValueAbsent  byte = 0x0
+	undoValuePresent byte = 0x1
+)
+
+// journaledBatch is a db.Batch that keeps track of the keys it modifies.
+type journaledBatch struct {
+	db.Batch
+
+	database db.DB
+	keys     map[string]struct{}
+}
+
+func newJournaledBatch(database db.DB) *journaledBatch {
+	return &journaledBatch{
+		Batch:    database.NewBatch(),
+		database: database,
+		keys:     make(map[string]struct{}),
+	}
+}
+
+func (b *journaledBatch) Set(key, value []byte) error {
+	b.keys[string(key)] = struct{}{}
+	return b.Batch.Set(key, value)
+}
+
+func (b *journaledBatch) Delete(key []byte) error {
+	b.keys[string(key)] = struct{}{}
+	return b.Batch.Delete(key)
+}
+
+// WritePending atomically writes the batch along with the information needed to roll it back. The batch is flushed to
+// disk, as it must be durable before the IAVL stores are committed.
+func (b *journaledBatch) WritePending(height int64) error {
+	for key := range b.keys {
+		previous, err := b.database.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+
+		if err := b.Batch.Set(undoKey([]byte(key)), encodeUndoValue(previous)); err != nil {
+			return err
+		}
+	}
+
+	if err := b.Batch.Set(dbKeyPendingHeight, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		return err
+	}
+
+	return b.Batch.WriteSync()
+}
+
+// commitDatabase marks the pending block as committed once the IAVL stores have been committed at the same height.
+func commitDatabase(database db.DB, height int64) error {
+	batch := database.NewBatch()
+	defer batch.Close()
+
+	undoKeys, err := collectUndoKeys(database)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range undoKeys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Delete(dbKeyPendingHeight); err != nil {
+		return err
+	}
+
+	if err := batch.Set(dbKeyCommittedHeight, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// rollbackDatabase restores the values that were overwritten by the pending block.
+func rollbackDatabase(database db.DB) error {
+	batch := database.NewBatch()
+	defer batch.Close()
+
+	undoKeys, err := collectUndoKeys(database)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range undoKeys {
+		bz, err := database.Get(key)
+		if err != nil {
+			return err
+		}
+
+		originalKey := key[len(dbKeyUndoPrefix):]
+		if len(bz) > 0 && bz[0] == undoValuePresent {
+			err = batch.Set(originalKey, bz[1:])
+		} else {
+			err = batch.Delete(originalKey)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Delete(dbKeyPendingHeight); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// recoverDatabase brings the database in line with the IAVL stores committed at lastHeight. A block that was written
+// to the database without being committed is rolled back, while a block that was committed without being marked as
+// such is completed. Any other difference cannot be recovered from and is returned as an error.
+func recoverDatabase(database db.DB, lastHeight int64) (recovered bool, err error) {
+	pending, found, err := getHeight(database, dbKeyPendingHeight)
+	if err != nil {
+		return false, err
+	}
+
+	if found {
+		switch pending {
+		case lastHeight:
+			return true, commitDatabase(database, lastHeight)
+		case lastHeight + 1:
+			if err := rollbackDatabase(database); err != nil {
+				return false, err
+			}
+			recovered = true
+		default:
+			return false, fmt.Errorf("database has uncommitted changes for height %v, application state is at height %v", pending, lastHeight)
+		}
+	}
+
+	committed, found, err := getHeight(database, dbKeyCommittedHeight)
+	if err != nil {
+		return false, err
+	}
+
+	// Databases created before the committed height was tracked are accepted as is.
+	if found && committed != lastHeight {
+		return false, fmt.Errorf("database is at height %v, application state is at height %v", committed, lastHeight)
+	}
+
+	return recovered, nil
+}
+
+func getHeight(database db.DB, key []byte) (int64, bool, error) {
+	bz, err := database.Get(key)
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true, nil
+}
+
+func collectUndoKeys(database db.DB) ([][]byte, error) {
+	iterator, err := db.IteratePrefix(database, dbKeyUndoPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+
+	return keys, iterator.Error()
+}
+
+func undoKey(key []byte) []byte {
+	return append(append([]byte{}, dbKeyUndoPrefix...), key...)
+}
+
+func encodeUndoValue(value []byte) []byte {
+	if value == nil {
+		return []byte{undoValueAbsent}
+	}
+
+	return append([]byte{undoValuePresent}, value...)
+}
@@ -0,0 +1,81 @@
+package emoney
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestDatabaseRecovery(t *testing.T) {
+	database := db.NewMemDB()
+	require.NoError(t, database.Set([]byte("a"), []byte("a1")))
+	require.NoError(t, database.Set([]byte("b"), []byte("b1")))
+	require.NoError(t, commitDatabase(database, 10))
+
+	writeBlock := func(height int64) {
+		batch := newJournaledBatch(database)
+		defer batch.Close()
+
+		require.NoError(t, batch.Set([]byte("a"), []byte("a2")))
+		require.NoError(t, batch.Delete([]byte("b")))
+		require.NoError(t, batch.Set([]byte("c"), []byte("c1")))
+		require.NoError(t, batch.WritePending(height))
+	}
+
+	// Crash before the IAVL commit: the block is rolled back
+	writeBlock(11)
+	requireValue(t, database, "a", "a2")
+
+	recovered, err := recoverDatabase(database, 10)
+	require.NoError(t, err)
+	require.True(t, recovered)
+	requireValue(t, database, "a", "a1")
+	requireValue(t, database, "b", "b1")
+	requireValue(t, database, "c", "")
+
+	// Crash after the IAVL commit: the block is marked as committed
+	writeBlock(11)
+
+	recovered, err = recoverDatabase(database, 11)
+	require.NoError(t, err)
+	require.True(t, recovered)
+	requireValue(t, database, "a", "a2")
+	requireValue(t, database, "b", "")
+	requireValue(t, database, "c", "c1")
+
+	undoKeys, err := collectUndoKeys(database)
+	require.NoError(t, err)
+	require.Empty(t, undoKeys)
+
+	// Clean restart
+	recovered, err = recoverDatabase(database, 11)
+	require.NoError(t, err)
+	require.False(t, recovered)
+
+	// Heights that cannot be reconciled
+	_, err = recoverDatabase(database, 12)
+	require.Error(t, err)
+
+	writeBlock(12)
+	_, err = recoverDatabase(database, 9)
+	require.Error(t, err)
+}
+
+func TestDatabaseRecoveryWithoutHeight(t *testing.T) {
+	// Databases written before the committed height was tracked
+	database := db.NewMemDB()
+	require.NoError(t, database.Set([]byte("a"), []byte("a1")))
+
+	recovered, err := recoverDatabase(database, 100)
+	require.NoError(t, err)
+	require.False(t, recovered)
+}
+
+func requireValue(t *testing.T, database db.DB, key, expected string) {
+	t.Helper()
+
+	bz, err := database.Get([]byte(key))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+}
@@ -6,12 +6,14 @@ package bank
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
@@ -659,6 +661,172 @@ func TestUndelegateCoins(t *testing.T) {
 	}
 }
 
+func TestTransferRestrictions(t *testing.T) {
+	var (
+		ctx        = sdk.Context{}.WithContext(context.Background())
+		addr1      = randomAddress()
+		addr2      = randomAddress()
+		restricted = errors.New("restricted")
+	)
+
+	specs := map[string]struct {
+		restrictions restrictionsMock
+		expErr       error
+	}{
+		"no restrictions": {},
+		"sender restricted": {
+			restrictions: restrictionsMock{sender: addr1},
+			expErr:       restricted,
+		},
+		"recipient restricted": {
+			restrictions: restrictionsMock{recipient: addr2},
+			expErr:       restricted,
+		},
+		"other accounts restricted": {
+			restrictions: restrictionsMock{sender: addr2, recipient: addr1},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var nestedCalls int
+			nestedBk := senderBankKeeperMock{
+				SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+					nestedCalls++
+					return nil
+				},
+				InputOutputCoinsFn: func(ctx sdk.Context, in []banktypes.Input, out []banktypes.Output) error {
+					nestedCalls++
+					return nil
+				},
+				SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+					nestedCalls++
+					return nil
+				},
+				SendCoinsFromModuleToAccountFn: func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+					nestedCalls++
+					return nil
+				},
+			}
+			wrappedBankKeeper := Wrap(nestedBk)
+			spec.restrictions.err = restricted
+			wrappedBankKeeper.SetTransferRestrictions(spec.restrictions)
+
+			// when
+			gotSendErr := wrappedBankKeeper.SendCoins(ctx, addr1, addr2, coins("1token"))
+			gotInputOutputErr := wrappedBankKeeper.InputOutputCoins(ctx,
+				[]banktypes.Input{{Address: addr1.String(), Coins: coins("1token")}},
+				[]banktypes.Output{{Address: addr2.String(), Coins: coins("1token")}},
+			)
+
+			// then
+			assert.Equal(t, spec.expErr, gotSendErr)
+			assert.Equal(t, spec.expErr, gotInputOutputErr)
+			if spec.expErr != nil {
+				assert.Zero(t, nestedCalls)
+			} else {
+				assert.Equal(t, 2, nestedCalls)
+			}
+
+			// Module accounts are exempt, the account on the other side is not
+			gotToModuleErr := wrappedBankKeeper.SendCoinsFromAccountToModule(ctx, addr1, "module", coins("1token"))
+			assert.Equal(t, spec.restrictions.sender.Equals(addr1), gotToModuleErr != nil)
+			gotFromModuleErr := wrappedBankKeeper.SendCoinsFromModuleToAccount(ctx, "module", addr2, coins("1token"))
+			assert.Equal(t, spec.restrictions.recipient.Equals(addr2), gotFromModuleErr != nil)
+
+			// Exempt transfers are never restricted
+			exemptCtx := apptypes.WithoutTransferRestrictions(ctx)
+			assert.NoError(t, wrappedBankKeeper.SendCoins(exemptCtx, addr1, addr2, coins("1token")))
+			assert.NoError(t, wrappedBankKeeper.SendCoinsFromAccountToModule(exemptCtx, addr1, "module", coins("1token")))
+		})
+	}
+}
+
+func TestBalanceSettlement(t *testing.T) {
+	var (
+		ctx       = sdk.Context{}.WithContext(context.Background())
+		addr1     = randomAddress()
+		addr2     = randomAddress()
+		moduleAcc = randomAddress()
+	)
+
+	var settled []sdk.AccAddress
+	nestedBk := senderBankKeeperMock{
+		SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+			// balances are settled before they change
+			assert.Len(t, settled, 2)
+			return nil
+		},
+		InputOutputCoinsFn: func(ctx sdk.Context, in []banktypes.Input, out []banktypes.Output) error {
+			return nil
+		},
+		SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+			return nil
+		},
+		SendCoinsFromModuleToAccountFn: func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+			return nil
+		},
+		DelegateCoinsFn: func(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+			return nil
+		},
+		UndelegateCoinsFn: func(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+			return nil
+		},
+	}
+	wrappedBankKeeper := Wrap(nestedBk)
+	wrappedBankKeeper.SetBalanceSettler(settlerMock(func(_ sdk.Context, account sdk.AccAddress, _ sdk.Coins) error {
+		settled = append(settled, account)
+		return nil
+	}))
+
+	require.NoError(t, wrappedBankKeeper.SendCoins(ctx, addr1, addr2, coins("1token")))
+	assert.Equal(t, []sdk.AccAddress{addr1, addr2}, settled)
+
+	settled = nil
+	require.NoError(t, wrappedBankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: addr1.String(), Coins: coins("1token")}},
+		[]banktypes.Output{{Address: addr2.String(), Coins: coins("1token")}},
+	))
+	assert.Equal(t, []sdk.AccAddress{addr1, addr2}, settled)
+
+	settled = nil
+	require.NoError(t, wrappedBankKeeper.SendCoinsFromAccountToModule(ctx, addr1, "module", coins("1token")))
+	require.NoError(t, wrappedBankKeeper.SendCoinsFromModuleToAccount(ctx, "module", addr2, coins("1token")))
+	require.NoError(t, wrappedBankKeeper.DelegateCoins(ctx, addr1, moduleAcc, coins("1token")))
+	require.NoError(t, wrappedBankKeeper.UndelegateCoins(ctx, moduleAcc, addr2, coins("1token")))
+	assert.Equal(t, []sdk.AccAddress{addr1, addr2, addr1, addr2}, settled)
+
+	// A failed settlement aborts the transfer
+	wrappedBankKeeper.SetBalanceSettler(settlerMock(func(sdk.Context, sdk.AccAddress, sdk.Coins) error {
+		return errors.New("settlement failed")
+	}))
+	assert.Error(t, wrappedBankKeeper.SendCoins(ctx, addr1, addr2, coins("1token")))
+}
+
+type settlerMock func(ctx sdk.Context, account sdk.AccAddress, amt sdk.Coins) error
+
+func (m settlerMock) SettleBalance(ctx sdk.Context, account sdk.AccAddress, amt sdk.Coins) error {
+	return m(ctx, account, amt)
+}
+
+type restrictionsMock struct {
+	sender, recipient sdk.AccAddress
+	err               error
+}
+
+func (m restrictionsMock) ValidateSend(_ sdk.Context, sender sdk.AccAddress, _ sdk.Coins) error {
+	if sender.Equals(m.sender) {
+		return m.err
+	}
+	return nil
+}
+
+func (m restrictionsMock) ValidateReceive(_ sdk.Context, recipient sdk.AccAddress, _ sdk.Coins) error {
+	if recipient.Equals(m.recipient) {
+		return m.err
+	}
+	return nil
+}
+
 type senderBankKeeperMock struct {
 	bankkeeper.Keeper
 	InputOutputCoinsFn                   func(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
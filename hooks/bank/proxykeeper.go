@@ -11,13 +11,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	apptypes "github.com/e-money/em-ledger/types"
 )
 
 var _ bankkeeper.Keeper = (*ProxyKeeper)(nil)
 
+// TransferRestrictions decides whether an account may send or receive coins.
+type TransferRestrictions interface {
+	ValidateSend(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error
+	ValidateReceive(ctx sdk.Context, recipient sdk.AccAddress, amt sdk.Coins) error
+}
+
+// BalanceSettler applies pending changes to the balances of an account before they are modified.
+type BalanceSettler interface {
+	SettleBalance(ctx sdk.Context, account sdk.AccAddress, amt sdk.Coins) error
+}
+
 type ProxyKeeper struct {
-	bk        bankkeeper.Keeper
-	listeners []func(sdk.Context, []sdk.AccAddress)
+	bk           bankkeeper.Keeper
+	listeners    []func(sdk.Context, []sdk.AccAddress)
+	restrictions TransferRestrictions
+	settler      BalanceSettler
 }
 
 func Wrap(bk bankkeeper.Keeper) *ProxyKeeper {
@@ -28,6 +42,44 @@ func (pk *ProxyKeeper) AddBalanceListener(l func(sdk.Context, []sdk.AccAddress))
 	pk.listeners = append(pk.listeners, l)
 }
 
+// SetTransferRestrictions registers the restrictions enforced on transfers between accounts.
+func (pk *ProxyKeeper) SetTransferRestrictions(r TransferRestrictions) {
+	pk.restrictions = r
+}
+
+// SetBalanceSettler registers the settler called before the balances of an account change.
+func (pk *ProxyKeeper) SetBalanceSettler(s BalanceSettler) {
+	pk.settler = s
+}
+
+func (pk ProxyKeeper) settle(ctx sdk.Context, amt sdk.Coins, accounts ...sdk.AccAddress) error {
+	if pk.settler == nil {
+		return nil
+	}
+	for _, account := range deduplicate(accounts) {
+		if err := pk.settler.SettleBalance(ctx, account, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSend returns an error when the sender is not allowed to send the coins.
+func (pk ProxyKeeper) ValidateSend(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error {
+	if pk.restrictions == nil || apptypes.TransferRestrictionsSkipped(ctx) {
+		return nil
+	}
+	return pk.restrictions.ValidateSend(ctx, sender, amt)
+}
+
+// ValidateReceive returns an error when the recipient is not allowed to receive the coins.
+func (pk ProxyKeeper) ValidateReceive(ctx sdk.Context, recipient sdk.AccAddress, amt sdk.Coins) error {
+	if pk.restrictions == nil || apptypes.TransferRestrictionsSkipped(ctx) {
+		return nil
+	}
+	return pk.restrictions.ValidateReceive(ctx, recipient, amt)
+}
+
 func (pk ProxyKeeper) notifyListeners(ctx sdk.Context, accounts ...sdk.AccAddress) {
 	accounts = deduplicate(accounts)
 	for _, l := range pk.listeners {
@@ -49,27 +101,54 @@ func deduplicate(accounts []sdk.AccAddress) []sdk.AccAddress {
 }
 
 func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
-	err := pk.bk.InputOutputCoins(ctx, inputs, outputs)
-	if err != nil {
-		return err
-	}
-
 	accounts := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
 	for _, a := range inputs {
-		// invalid addresses were handled before in the wrapped keeper
+		// invalid addresses are handled in the wrapped keeper
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
+		if err := pk.ValidateSend(ctx, addr, a.Coins); err != nil {
+			return err
+		}
 		accounts = append(accounts, addr)
 	}
 	for _, a := range outputs {
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
+		if err := pk.ValidateReceive(ctx, addr, a.Coins); err != nil {
+			return err
+		}
 		accounts = append(accounts, addr)
 	}
 
+	for i, a := range inputs {
+		if err := pk.settle(ctx, a.Coins, accounts[i]); err != nil {
+			return err
+		}
+	}
+	for i, a := range outputs {
+		if err := pk.settle(ctx, a.Coins, accounts[len(inputs)+i]); err != nil {
+			return err
+		}
+	}
+
+	err := pk.bk.InputOutputCoins(ctx, inputs, outputs)
+	if err != nil {
+		return err
+	}
+
 	pk.notifyListeners(ctx, accounts...)
 	return nil
 }
 
 func (pk ProxyKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := pk.ValidateSend(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if err := pk.ValidateReceive(ctx, toAddr, amt); err != nil {
+		return err
+	}
+	if err := pk.settle(ctx, amt, fromAddr, toAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.SendCoins(ctx, fromAddr, toAddr, amt)
 	if err != nil {
 		return err
@@ -192,6 +271,13 @@ func (pk *ProxyKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(banktype
 }
 
 func (pk *ProxyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := pk.ValidateReceive(ctx, recipientAddr, amt); err != nil {
+		return err
+	}
+	if err := pk.settle(ctx, amt, recipientAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
 	if err != nil {
 		return err
@@ -205,6 +291,13 @@ func (pk *ProxyKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule
 }
 
 func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := pk.ValidateSend(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	if err := pk.settle(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
 	if err != nil {
 		return err
@@ -214,6 +307,10 @@ func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr
 }
 
 func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := pk.settle(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
 	if err != nil {
 		return err
@@ -223,6 +320,10 @@ func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderA
 }
 
 func (pk *ProxyKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := pk.settle(ctx, amt, recipientAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
 	if err != nil {
 		return err
@@ -236,6 +337,10 @@ func (pk *ProxyKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coi
 }
 
 func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := pk.settle(ctx, amt, delegatorAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
 	if err != nil {
 		return err
@@ -245,6 +350,10 @@ func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAd
 }
 
 func (pk *ProxyKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := pk.settle(ctx, amt, delegatorAddr); err != nil {
+		return err
+	}
+
 	err := pk.bk.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
 	if err != nil {
 		return err
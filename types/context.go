@@ -8,8 +8,9 @@ import (
 type contextKey uint8
 
 const (
-	_            contextKey = iota
-	currentBatch contextKey = iota
+	_                        contextKey = iota
+	currentBatch             contextKey = iota
+	skipTransferRestrictions contextKey = iota
 )
 
 func GetCurrentBatch(ctx sdk.Context) db.Batch {
@@ -20,3 +21,14 @@ func GetCurrentBatch(ctx sdk.Context) db.Batch {
 func WithCurrentBatch(ctx sdk.Context, batch db.Batch) sdk.Context {
 	return ctx.WithValue(currentBatch, batch)
 }
+
+// WithoutTransferRestrictions marks transfers made with the context as exempt from issuer restrictions, such as
+// clawbacks and demurrage.
+func WithoutTransferRestrictions(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(skipTransferRestrictions, true)
+}
+
+func TransferRestrictionsSkipped(ctx sdk.Context) bool {
+	v, _ := ctx.Value(skipTransferRestrictions).(bool)
+	return v
+}
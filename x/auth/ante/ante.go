@@ -15,7 +15,9 @@ type EmAnteHandlerOptions struct {
 	AccountKeeper    sdkante.AccountKeeper
 	BankKeeper       types.BankKeeper
 	FeegrantKeeper   FeegrantKeeper
-	StakingKeeper    StakingKeeper // em-ledger for special handling of staking fees
+	StakingKeeper    StakingKeeper   // em-ledger for special handling of staking fees
+	GasPricesKeeper  GasPricesKeeper // em-ledger minimum gas prices per message type
+	MarketKeeper     MarketKeeper    // em-ledger conversion of fees paid in other denominations
 	SignModeHandler  authsigning.SignModeHandler
 	SigGasConsumer   func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	IBCChannelkeeper channelkeeper.Keeper
@@ -46,7 +48,7 @@ func NewAnteHandler(options EmAnteHandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		sdkante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		sdkante.NewRejectExtensionOptionsDecorator(),
-		sdkante.NewMempoolFeeDecorator(),
+		NewMempoolFeeDecorator(options.GasPricesKeeper, options.MarketKeeper),
 		sdkante.NewValidateBasicDecorator(),
 		sdkante.NewTxTimeoutHeightDecorator(),
 		sdkante.NewValidateMemoDecorator(options.AccountKeeper),
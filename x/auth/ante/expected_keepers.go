@@ -1,6 +1,10 @@
 package ante
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authoritytypes "github.com/e-money/em-ledger/x/authority/types"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
+)
 
 type StakingKeeper interface {
 	BondDenom(sdk.Context) string
@@ -10,3 +14,15 @@ type StakingKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// GasPricesKeeper defines the expected keeper of the per message type minimum gas prices
+// and of the fee conversion settings.
+type GasPricesKeeper interface {
+	GetMessageGasPrices(ctx sdk.Context, typeURL string) (sdk.DecCoins, bool)
+	GetFeeConversion(ctx sdk.Context) authoritytypes.FeeConversion
+}
+
+// MarketKeeper defines the expected market keeper used to convert fees.
+type MarketKeeper interface {
+	GetInstrument(ctx sdk.Context, src, dst string) *markettypes.MarketData
+}
@@ -26,6 +26,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	embank "github.com/e-money/em-ledger/hooks/bank"
 	"github.com/e-money/em-ledger/x/auth/ante"
 	"github.com/e-money/em-ledger/x/buyback"
 	"github.com/stretchr/testify/require"
@@ -100,12 +101,36 @@ func (suite *AnteTestSuite) TestMultipleFeeDenoms() {
 	require.Equal(suite.T(), coins("450chf,5000eeur").String(), buybackBalance.String())
 }
 
+func (suite *AnteTestSuite) TestFrozenFeeDenom() {
+	suite.setup()
+	ctx := suite.ctx
+
+	payerAccount := suite.createAccount(ctx, coins("500ungm,8000eeur"))
+
+	proxy := embank.Wrap(suite.bk)
+	proxy.SetTransferRestrictions(mockRestrictions{frozenDenom: "eeur", frozen: payerAccount.GetAddress()})
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.ak, proxy, mockStakingKeeper{"ungm"}, suite.fk))
+
+	tx := mockFeeTX{fee: coins("400eeur"), feePayer: payerAccount.GetAddress()}
+	_, err := anteHandler(ctx, tx, false)
+	require.Error(suite.T(), err)
+
+	buybackBalance := suite.getModuleBalance(ctx, buyback.ModuleName)
+	require.True(suite.T(), buybackBalance.IsZero())
+
+	// Fees in other denominations can still be paid
+	tx = mockFeeTX{fee: coins("500ungm"), feePayer: payerAccount.GetAddress()}
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(suite.T(), err)
+}
+
 type AnteTestSuite struct {
 	suite.Suite
 
 	ctx         sdk.Context
 	ak          authkeeper.AccountKeeper
 	bk          bankkeeper.BaseKeeper
+	fk          feegrantkeeper.Keeper
 	anteHandler sdk.AnteHandler
 }
 
@@ -176,6 +201,7 @@ func (suite *AnteTestSuite) setup() {
 	suite.anteHandler = sdk.ChainAnteDecorators(dfd)
 	suite.ak = ak
 	suite.bk = bk
+	suite.fk = fk
 	suite.ctx = ctx
 }
 
@@ -216,6 +242,10 @@ type (
 	mockStakingKeeper struct {
 		bondDenom string
 	}
+	mockRestrictions struct {
+		frozenDenom string
+		frozen      sdk.AccAddress
+	}
 )
 
 func (m mockFeeTX) GetMsgs() []sdk.Msg {
@@ -246,6 +276,17 @@ func (msk mockStakingKeeper) BondDenom(sdk.Context) string {
 	return msk.bondDenom
 }
 
+func (m mockRestrictions) ValidateSend(_ sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error {
+	if sender.Equals(m.frozen) && amt.AmountOf(m.frozenDenom).IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%v is frozen", sender)
+	}
+	return nil
+}
+
+func (m mockRestrictions) ValidateReceive(sdk.Context, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
 func setAccBalance(suite *AnteTestSuite, ctx sdk.Context, acc sdk.AccAddress, bk bankkeeper.Keeper, balance sdk.Coins) {
 	err := bk.SendCoinsFromModuleToAccount(
 		ctx, authtypes.ModuleName, acc, balance.Sub(bk.GetAllBalances(ctx, acc)),
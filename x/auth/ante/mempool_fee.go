@@ -0,0 +1,131 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MempoolFeeDecorator will check if the transaction's fee is at least as large
+// as the minimum gas prices of each message in the transaction.
+// Messages without an authority-set override use the node's minimum gas prices (ctx.MinGasPrices()).
+// The fee must satisfy every distinct minimum that applies to the transaction.
+// Fees paid in a denomination without a minimum gas price are converted to the
+// reference denomination of the fee conversion settings using the last traded market price.
+// This only applies to minimum gas prices that include the reference denomination.
+// If fee is too low, decorator returns error and tx is rejected from mempool.
+// Note this only applies when ctx.CheckTx = true
+// If fee is high enough or not CheckTx, then call next AnteHandler
+// CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
+// From SDK v0.45.9 https://github.com/cosmos/cosmos-sdk/blob/v0.45.9/x/auth/ante/fee.go
+type MempoolFeeDecorator struct {
+	gasPricesKeeper GasPricesKeeper
+	marketKeeper    MarketKeeper
+}
+
+func NewMempoolFeeDecorator(gpk GasPricesKeeper, mk MarketKeeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		gasPricesKeeper: gpk,
+		marketKeeper:    mk,
+	}
+}
+
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	// Ensure that the provided fees meet a minimum threshold for the validator,
+	// if this is a CheckTx. This is only for local mempool purposes, and thus
+	// is only ran on check tx.
+	if ctx.IsCheckTx() && !simulate {
+		for _, minGasPrices := range mfd.minGasPrices(ctx, tx.GetMsgs()) {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdk.NewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) && !mfd.isConvertedFeeSufficient(ctx, feeCoins, requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// minGasPrices returns the distinct, non-zero minimum gas prices applying to msgs.
+func (mfd MempoolFeeDecorator) minGasPrices(ctx sdk.Context, msgs []sdk.Msg) []sdk.DecCoins {
+	var (
+		res  []sdk.DecCoins
+		seen = make(map[string]bool)
+	)
+
+	add := func(gasPrices sdk.DecCoins) {
+		if gasPrices.IsZero() || seen[gasPrices.String()] {
+			return
+		}
+		seen[gasPrices.String()] = true
+		res = append(res, gasPrices)
+	}
+
+	if mfd.gasPricesKeeper == nil || len(msgs) == 0 {
+		add(ctx.MinGasPrices())
+		return res
+	}
+
+	for _, msg := range msgs {
+		if gasPrices, found := mfd.gasPricesKeeper.GetMessageGasPrices(ctx, sdk.MsgTypeURL(msg)); found {
+			add(gasPrices)
+			continue
+		}
+		add(ctx.MinGasPrices())
+	}
+
+	return res
+}
+
+// isConvertedFeeSufficient checks whether any of the fee coins is worth at least
+// the required fee in the reference denomination at the last traded market price.
+// Prices older than the maximum price age of the fee conversion settings are ignored.
+func (mfd MempoolFeeDecorator) isConvertedFeeSufficient(ctx sdk.Context, feeCoins, requiredFees sdk.Coins) bool {
+	if mfd.gasPricesKeeper == nil || mfd.marketKeeper == nil {
+		return false
+	}
+
+	conversion := mfd.gasPricesKeeper.GetFeeConversion(ctx)
+	if !conversion.Enabled() {
+		return false
+	}
+
+	required := requiredFees.AmountOf(conversion.ReferenceDenom)
+	if required.IsZero() {
+		return false
+	}
+
+	oldestPrice := ctx.BlockTime().Add(-conversion.MaxPriceAge)
+	for _, fee := range feeCoins {
+		if fee.Denom == conversion.ReferenceDenom {
+			continue
+		}
+
+		md := mfd.marketKeeper.GetInstrument(ctx, fee.Denom, conversion.ReferenceDenom)
+		if md == nil || md.LastPrice == nil || md.Timestamp == nil || md.Timestamp.Before(oldestPrice) {
+			continue
+		}
+
+		if fee.Amount.ToDec().Mul(*md.LastPrice).GTE(required.ToDec()) {
+			return true
+		}
+	}
+
+	return false
+}
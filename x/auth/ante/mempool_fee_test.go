@@ -0,0 +1,180 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/e-money/em-ledger/x/auth/ante"
+	authoritytypes "github.com/e-money/em-ledger/x/authority/types"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMempoolFeeMessageGasPrices(t *testing.T) {
+	gpk := mockGasPricesKeeper{
+		msgGasPrices: map[string]sdk.DecCoins{
+			sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): sdk.NewDecCoins(sdk.NewDecCoinFromDec("eeur", sdk.MustNewDecFromStr("0.1"))),
+		},
+	}
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator(gpk, nil))
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger()).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("eeur", sdk.MustNewDecFromStr("0.01"))))
+
+	specs := map[string]struct {
+		msgs   []sdk.Msg
+		fee    sdk.Coins
+		expErr bool
+	}{
+		"default minimum met": {
+			msgs: []sdk.Msg{&banktypes.MsgSend{}},
+			fee:  coins("1000eeur"),
+		},
+		"default minimum not met": {
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}},
+			fee:    coins("999eeur"),
+			expErr: true,
+		},
+		"override minimum met": {
+			msgs: []sdk.Msg{&banktypes.MsgMultiSend{}},
+			fee:  coins("10000eeur"),
+		},
+		"override minimum not met": {
+			msgs:   []sdk.Msg{&banktypes.MsgMultiSend{}},
+			fee:    coins("1000eeur"),
+			expErr: true,
+		},
+		"mixed messages must meet every minimum": {
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			fee:    coins("9999eeur"),
+			expErr: true,
+		},
+		"no messages": {
+			fee: coins("1000eeur"),
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tx := mockMsgsFeeTX{msgs: spec.msgs, fee: spec.fee, gas: 100000}
+			_, err := anteHandler(ctx, tx, false)
+			if spec.expErr {
+				require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+				return
+			}
+			require.NoError(t, err)
+
+			// Fees are only checked against the mempool minimums in CheckTx
+			_, err = anteHandler(ctx.WithIsCheckTx(false), mockMsgsFeeTX{msgs: spec.msgs, gas: 100000}, false)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMempoolFeeConversion(t *testing.T) {
+	var (
+		now       = time.Now()
+		stale     = now.Add(-2 * time.Hour)
+		price     = sdk.MustNewDecFromStr("0.008")
+		gpk       = mockGasPricesKeeper{conversion: authoritytypes.FeeConversion{ReferenceDenom: "eeur", MaxPriceAge: time.Hour}}
+		mk        = mockMarketKeeper{}
+		ctx       = sdk.NewContext(nil, tmproto.Header{Time: now}, true, log.NewNopLogger())
+		minPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("eeur", sdk.MustNewDecFromStr("0.01")))
+	)
+	ctx = ctx.WithMinGasPrices(minPrices)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator(gpk, mk))
+
+	// 1000eeur required
+	tx := func(fee string) sdk.Tx {
+		return mockMsgsFeeTX{msgs: []sdk.Msg{&banktypes.MsgSend{}}, fee: coins(fee), gas: 100000}
+	}
+
+	_, err := anteHandler(ctx, tx("125000ejpy"), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), "no market price")
+
+	mk["ejpy/eeur"] = &markettypes.MarketData{Source: "ejpy", Destination: "eeur", LastPrice: &price, Timestamp: &stale}
+	_, err = anteHandler(ctx, tx("125000ejpy"), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), "stale market price")
+
+	mk["ejpy/eeur"].Timestamp = &now
+	_, err = anteHandler(ctx, tx("125000ejpy"), false)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx, tx("124999ejpy"), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	gpk.conversion = authoritytypes.FeeConversion{}
+	anteHandler = sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator(gpk, mk))
+	_, err = anteHandler(ctx, tx("125000ejpy"), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), "fee conversion disabled")
+}
+
+func TestMempoolFeeConversionWithoutReferenceGasPrice(t *testing.T) {
+	var (
+		now   = time.Now()
+		price = sdk.MustNewDecFromStr("0.008")
+		gpk   = mockGasPricesKeeper{conversion: authoritytypes.FeeConversion{ReferenceDenom: "eeur", MaxPriceAge: time.Hour}}
+		mk    = mockMarketKeeper{"ejpy/eeur": &markettypes.MarketData{Source: "ejpy", Destination: "eeur", LastPrice: &price, Timestamp: &now}}
+		ctx   = sdk.NewContext(nil, tmproto.Header{Time: now}, true, log.NewNopLogger())
+	)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator(gpk, mk))
+	tx := mockMsgsFeeTX{msgs: []sdk.Msg{&banktypes.MsgSend{}}, fee: coins("125000ejpy"), gas: 100000}
+
+	// Fees are only converted when the minimum gas prices include the reference denomination
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("echf", sdk.MustNewDecFromStr("0.01"))))
+	_, err := anteHandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("echf", sdk.MustNewDecFromStr("0.01")),
+		sdk.NewDecCoinFromDec("eeur", sdk.MustNewDecFromStr("0.01")),
+	))
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+}
+
+type (
+	mockGasPricesKeeper struct {
+		msgGasPrices map[string]sdk.DecCoins
+		conversion   authoritytypes.FeeConversion
+	}
+
+	mockMarketKeeper map[string]*markettypes.MarketData
+
+	mockMsgsFeeTX struct {
+		mockFeeTX
+		msgs []sdk.Msg
+		fee  sdk.Coins
+		gas  uint64
+	}
+)
+
+func (m mockGasPricesKeeper) GetMessageGasPrices(_ sdk.Context, typeURL string) (sdk.DecCoins, bool) {
+	gasPrices, found := m.msgGasPrices[typeURL]
+	return gasPrices, found
+}
+
+func (m mockGasPricesKeeper) GetFeeConversion(sdk.Context) authoritytypes.FeeConversion {
+	return m.conversion
+}
+
+func (m mockMarketKeeper) GetInstrument(_ sdk.Context, src, dst string) *markettypes.MarketData {
+	return m[src+"/"+dst]
+}
+
+func (m mockMsgsFeeTX) GetMsgs() []sdk.Msg {
+	return m.msgs
+}
+
+func (m mockMsgsFeeTX) GetGas() uint64 {
+	return m.gas
+}
+
+func (m mockMsgsFeeTX) GetFee() sdk.Coins {
+	return m.fee
+}
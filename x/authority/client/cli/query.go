@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetGasPricesCmd(),
+		GetMessageGasPricesCmd(),
+		GetFeeConversionCmd(),
 		GetUpgradePlanCmd(),
 	)
 
@@ -52,6 +54,54 @@ func GetGasPricesCmd() *cobra.Command {
 	return cmd
 }
 
+func GetMessageGasPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-gas-prices",
+		Short: "Query the minimum gas prices overridden per message type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MessageGasPrices(cmd.Context(), &types.QueryMessageGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetFeeConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-conversion",
+		Short: "Query the reference denomination and maximum price age used to convert fees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeConversion(cmd.Context(), &types.QueryFeeConversionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetUpgradePlanCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upgrade-plan",
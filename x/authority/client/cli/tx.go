@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -20,6 +21,7 @@ import (
 	upgtypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/e-money/em-ledger/util"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/os"
 )
@@ -34,7 +36,12 @@ func GetTxCmd() *cobra.Command {
 	authorityCmds.AddCommand(
 		GetCmdCreateIssuer(),
 		getCmdDestroyIssuer(),
+		getCmdTransferDenom(),
+		getCmdSetSupplyCap(),
+		getCmdSetInflationDistribution(),
 		getCmdSetGasPrices(),
+		getCmdSetMessageGasPrices(),
+		getCmdSetFeeConversion(),
 		GetCmdReplaceAuthority(),
 		GetCmdScheduleUpgrade(),
 		getCmdSetParameters(),
@@ -75,6 +82,82 @@ func getCmdSetGasPrices() *cobra.Command {
 	return cmd
 }
 
+func getCmdSetMessageGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-message-gas-prices [authority_key_or_address] [message_type_url] [minimum_gas_prices]",
+		Example: "emd tx authority set-message-gas-prices masterkey /em.market.v1.MsgAddLimitOrder 0.0001eeur,0.0000001ejpy",
+		Short:   "Override the minimum gas prices for transactions containing a message type",
+		Long: `Override the chain-wide minimum gas prices for transactions containing the given message type.
+Passing empty gas prices ("") removes the override.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasPrices, err := sdk.ParseDecCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetMessageGasPrices{
+				TypeUrl:   args[1],
+				GasPrices: gasPrices,
+				Authority: clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetFeeConversion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-fee-conversion [authority_key_or_address] [reference_denom] [max_price_age]",
+		Example: "emd tx authority set-fee-conversion masterkey eeur 1h",
+		Short:   "Accept fees in any denomination traded against a reference denomination",
+		Long: `Accept fees in any denomination with a market instrument against the reference denomination.
+The required fee is converted using the last traded price, which must be no older than max_price_age.
+Only fees required in the reference denomination are converted, so the chain minimum gas prices must include it.
+Message gas prices set without the reference denomination are not converted.
+Passing an empty reference denomination ("") disables fee conversion.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var maxPriceAge time.Duration
+			if len(args) == 3 {
+				maxPriceAge, err = time.ParseDuration(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgSetFeeConversion{
+				ReferenceDenom: args[1],
+				MaxPriceAge:    maxPriceAge,
+				Authority:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 const (
 	DenomDescFlagName = "denominations"
 	denomDescDefValue = "e-Money EUR stablecoin"
@@ -157,6 +240,147 @@ func getCmdDestroyIssuer() *cobra.Command {
 	return cmd
 }
 
+const flagRevokeLiquidityProviders = "revoke-liquidity-providers"
+
+func getCmdTransferDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-denom [authority_key_or_address] [denomination] [from_issuer_address] [to_issuer_address]",
+		Example: "emd tx authority transfer-denom masterkey eeur emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum --revoke-liquidity-providers",
+		Short:   "Move a denomination from one issuer to another",
+		Long: `Move control of a denomination from one issuer to another. Supply, inflation and metadata are unaffected.
+Existing liquidity providers keep their mintable amounts under the new issuer unless --revoke-liquidity-providers is set.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromIssuer, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			toIssuer, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			revoke, err := cmd.Flags().GetBool(flagRevokeLiquidityProviders)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferDenom{
+				Authority:                clientCtx.GetFromAddress().String(),
+				Denom:                    args[1],
+				FromIssuer:               fromIssuer.String(),
+				ToIssuer:                 toIssuer.String(),
+				RevokeLiquidityProviders: revoke,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagRevokeLiquidityProviders, false, "Remove the denomination from the mintable amounts of all liquidity providers")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-supply-cap [authority_key_or_address] [cap]",
+		Example: "emd tx authority set-supply-cap masterkey 1000000000000eeur",
+		Short:   "Cap the supply of a denomination",
+		Long: `Cap the supply plus the outstanding mintable amounts of a denomination.
+Issuers cannot increase mintable amounts beyond the cap. A zero cap removes it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			supplyCap, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetSupplyCap{
+				Authority: clientCtx.GetFromAddress().String(),
+				Cap:       supplyCap,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetInflationDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-inflation-distribution [authority_key_or_address] [denomination] [recipient=weight]...",
+		Example: "emd tx authority set-inflation-distribution masterkey eeur buyback=3 community_pool=1 emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum=1",
+		Short:   "Direct the tokens minted by inflation of a denomination",
+		Long: `Split the tokens minted by inflation of a denomination between module accounts and addresses by weight.
+Recipients are module account names, bech32 addresses or community_pool for the community pool of the distribution
+module. Omit the recipients to restore the default destination.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			targets := make([]inflationtypes.DistributionTarget, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				parts := strings.SplitN(arg, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("expected recipient=weight: %v", arg)
+				}
+
+				weight, err := sdk.NewDecFromStr(parts[1])
+				if err != nil {
+					return err
+				}
+
+				target := inflationtypes.DistributionTarget{Weight: weight}
+				if parts[0] == inflationtypes.CommunityPoolRecipient {
+					target.CommunityPool = true
+				} else if _, err := sdk.AccAddressFromBech32(parts[0]); err == nil {
+					target.Address = parts[0]
+				} else {
+					target.ModuleAccount = parts[0]
+				}
+				targets = append(targets, target)
+			}
+
+			msg := &types.MsgSetInflationDistribution{
+				Authority: clientCtx.GetFromAddress().String(),
+				Denom:     args[1],
+				Targets:   targets,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdReplaceAuthority() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "replace [authority_key_or_address] new_authority_address",
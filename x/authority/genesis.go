@@ -28,5 +28,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) error
 	}
 	keeper.BootstrapAuthority(ctx, authKey)
 	keeper.SetGasPrices(ctx, authKey, state.MinGasPrices)
+	for _, gp := range state.MessageGasPrices {
+		if _, err := keeper.SetMessageGasPrices(ctx, authKey, gp.TypeUrl, gp.Minimum); err != nil {
+			return sdkerrors.Wrap(err, "message gas prices")
+		}
+	}
+	if state.FeeConversion.Enabled() {
+		if _, err := keeper.SetFeeConversion(ctx, authKey, state.FeeConversion); err != nil {
+			return sdkerrors.Wrap(err, "fee conversion")
+		}
+	}
 	return nil
 }
@@ -28,10 +28,30 @@ func newHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.DestroyIssuer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgTransferDenom:
+			res, err := msgServer.TransferDenom(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetSupplyCap:
+			res, err := msgServer.SetSupplyCap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetInflationDistribution:
+			res, err := msgServer.SetInflationDistribution(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSetGasPrices:
 			res, err := msgServer.SetGasPrices(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetMessageGasPrices:
+			res, err := msgServer.SetMessageGasPrices(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetFeeConversion:
+			res, err := msgServer.SetFeeConversion(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgReplaceAuthority:
 			res, err := msgServer.ReplaceAuthority(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
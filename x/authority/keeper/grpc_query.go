@@ -20,6 +20,22 @@ func (k Keeper) GasPrices(c context.Context, req *types.QueryGasPricesRequest) (
 	return &types.QueryGasPricesResponse{MinGasPrices: gasPrices}, nil
 }
 
+func (k Keeper) MessageGasPrices(c context.Context, req *types.QueryMessageGasPricesRequest) (*types.QueryMessageGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMessageGasPricesResponse{MessageGasPrices: k.GetAllMessageGasPrices(ctx)}, nil
+}
+
+func (k Keeper) FeeConversion(c context.Context, req *types.QueryFeeConversionRequest) (*types.QueryFeeConversionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeConversionResponse{FeeConversion: k.GetFeeConversion(ctx)}, nil
+}
+
 func (k Keeper) UpgradePlan(c context.Context, req *types.QueryUpgradePlanRequest) (*types.QueryUpgradePlanResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -17,9 +17,12 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,14 +31,19 @@ import (
 const (
 	keyAuthorityAccAddress = "AuthorityAccountAddress"
 	keyGasPrices           = "GasPrices"
+	keyFeeConversion       = "FeeConversion"
+
+	keyPrefixMessageGasPrices = "MessageGasPrices/"
 )
 
 var _ authorityKeeper = Keeper{}
 
 type Keeper struct {
 	cdc           codec.BinaryCodec
+	registry      codectypes.InterfaceRegistry
 	storeKey      sdk.StoreKey
 	ik            issuer.Keeper
+	inflation     types.InflationKeeper
 	bankKeeper    types.BankKeeper
 	upgradeKeeper types.UpgradeKeeper
 	paramsKeeper  types.ParamsKeeper
@@ -45,14 +53,16 @@ type Keeper struct {
 }
 
 func NewKeeper(
-	cdc codec.Codec, storeKey sdk.StoreKey,
-	issuerKeeper issuer.Keeper, bankKeeper types.BankKeeper,
+	cdc codec.Codec, registry codectypes.InterfaceRegistry, storeKey sdk.StoreKey,
+	issuerKeeper issuer.Keeper, inflationKeeper types.InflationKeeper, bankKeeper types.BankKeeper,
 	gasPricesKeeper types.GasPricesKeeper, upgradeKeeper types.UpgradeKeeper,
 	paramsKeeper types.ParamsKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
+		registry:      registry,
 		ik:            issuerKeeper,
+		inflation:     inflationKeeper,
 		bankKeeper:    bankKeeper,
 		gpk:           gasPricesKeeper,
 		storeKey:      storeKey,
@@ -155,6 +165,10 @@ func (k Keeper) SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, newPrice
 		}
 	}
 
+	if conversion := k.GetFeeConversion(ctx); conversion.Enabled() && !newPrices.AmountOf(conversion.ReferenceDenom).IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNoReferenceGasPrice, "fee conversion uses %v", conversion.ReferenceDenom)
+	}
+
 	gasPrices := types.GasPrices{Minimum: newPrices}
 	bz := k.cdc.MustMarshalLengthPrefixed(&gasPrices)
 	store := ctx.KVStore(k.storeKey)
@@ -179,6 +193,131 @@ func (k Keeper) GetGasPrices(ctx sdk.Context) sdk.DecCoins {
 	return gasPrices.Minimum
 }
 
+// SetMessageGasPrices overrides the minimum gas prices for transactions
+// containing the given message type. Empty gas prices remove the override.
+func (k Keeper) SetMessageGasPrices(ctx sdk.Context, authority sdk.AccAddress, typeURL string, newPrices sdk.DecCoins) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMessageTypeURL(typeURL); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefixMessageGasPrices))
+	if newPrices.Empty() {
+		store.Delete([]byte(typeURL))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+
+	// Gas prices of a type that is not known to the chain would never be enforced
+	msg, err := k.registry.Resolve(typeURL)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMsgTypeURL, "%q: %v", typeURL, err)
+	}
+	if _, ok := msg.(sdk.Msg); !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMsgTypeURL, "%q is not a message", typeURL)
+	}
+
+	if !newPrices.IsValid() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidGasPrices, "%v", newPrices)
+	}
+
+	supply, _, err := k.bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrGetTotalSupply, "%v", err)
+	}
+	for _, d := range newPrices {
+		if supply.AmountOf(d.Denom).IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownDenom, "%v", d.Denom)
+		}
+	}
+
+	gasPrices := types.MessageGasPrices{TypeUrl: typeURL, Minimum: newPrices}
+	store.Set([]byte(typeURL), k.cdc.MustMarshal(&gasPrices))
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// GetMessageGasPrices returns the minimum gas prices overriding the chain-wide
+// minimum for the given message type, if any.
+func (k Keeper) GetMessageGasPrices(ctx sdk.Context, typeURL string) (sdk.DecCoins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefixMessageGasPrices))
+	bz := store.Get([]byte(typeURL))
+	if bz == nil {
+		return nil, false
+	}
+
+	var gasPrices types.MessageGasPrices
+	k.cdc.MustUnmarshal(bz, &gasPrices)
+	return gasPrices.Minimum, true
+}
+
+func (k Keeper) GetAllMessageGasPrices(ctx sdk.Context) []types.MessageGasPrices {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefixMessageGasPrices))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := make([]types.MessageGasPrices, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var gasPrices types.MessageGasPrices
+		k.cdc.MustUnmarshal(iterator.Value(), &gasPrices)
+		res = append(res, gasPrices)
+	}
+
+	return res
+}
+
+// SetFeeConversion configures paying fees in any denomination with a recently
+// traded market instrument against the reference denomination.
+// An empty reference denomination disables fee conversion.
+func (k Keeper) SetFeeConversion(ctx sdk.Context, authority sdk.AccAddress, conversion types.FeeConversion) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if conversion.ReferenceDenom == "" {
+		store.Delete([]byte(keyFeeConversion))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+
+	if err := conversion.Validate(); err != nil {
+		return nil, err
+	}
+
+	supply, _, err := k.bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrGetTotalSupply, "%v", err)
+	}
+	if supply.AmountOf(conversion.ReferenceDenom).IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownDenom, "%v", conversion.ReferenceDenom)
+	}
+
+	// Fees are only converted into the reference denomination when a minimum gas price is set in it
+	if !k.GetGasPrices(ctx).AmountOf(conversion.ReferenceDenom).IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNoReferenceGasPrice, "%v", conversion.ReferenceDenom)
+	}
+
+	store.Set([]byte(keyFeeConversion), k.cdc.MustMarshal(&conversion))
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// GetFeeConversion returns the fee conversion settings. The reference
+// denomination is empty if fee conversion is disabled.
+func (k Keeper) GetFeeConversion(ctx sdk.Context) types.FeeConversion {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(keyFeeConversion))
+
+	var conversion types.FeeConversion
+	if bz == nil {
+		return conversion
+	}
+	k.cdc.MustUnmarshal(bz, &conversion)
+	return conversion
+}
+
 func (k Keeper) destroyIssuer(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error) {
 	if err := k.ValidateAuthority(ctx, authority); err != nil {
 		return nil, err
@@ -187,6 +326,30 @@ func (k Keeper) destroyIssuer(ctx sdk.Context, authority sdk.AccAddress, issuerA
 	return k.ik.RemoveIssuer(ctx, issuerAddress)
 }
 
+func (k Keeper) transferDenom(ctx sdk.Context, authority sdk.AccAddress, denom string, fromIssuer, toIssuer sdk.AccAddress, revokeLiquidityProviders bool) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	return k.ik.TransferDenom(ctx, denom, fromIssuer, toIssuer, revokeLiquidityProviders)
+}
+
+func (k Keeper) setSupplyCap(ctx sdk.Context, authority sdk.AccAddress, supplyCap sdk.Coin) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	return k.ik.SetSupplyCap(ctx, supplyCap)
+}
+
+func (k Keeper) setInflationDistribution(ctx sdk.Context, authority sdk.AccAddress, denom string, targets []inflationtypes.DistributionTarget) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	return k.inflation.SetDistribution(ctx, denom, targets)
+}
+
 func (k Keeper) ValidateAuthority(ctx sdk.Context, address sdk.AccAddress) error {
 	authority, formerAuth, err := k.getAuthorities(ctx)
 	if err != nil {
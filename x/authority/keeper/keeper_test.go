@@ -7,6 +7,7 @@ package keeper
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -30,8 +31,10 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer"
 	"github.com/e-money/em-ledger/x/liquidityprovider"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/require"
@@ -288,6 +291,97 @@ func TestManageGasPrices1(t *testing.T) {
 	require.True(t, types.ErrUnknownDenom.Is(err))
 }
 
+func TestManageMessageGasPrices(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+
+	var (
+		accAuthority = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		accRandom    = mustParseAddress("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		orderMsgType = "/em.market.v1.MsgAddLimitOrder"
+	)
+
+	keeper.BootstrapAuthority(ctx, accAuthority)
+
+	_, found := keeper.GetMessageGasPrices(ctx, orderMsgType)
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllMessageGasPrices(ctx))
+
+	coins, _ := sdk.ParseDecCoins("0.0001eeur")
+
+	_, err := keeper.SetMessageGasPrices(ctx, accRandom, orderMsgType, coins)
+	require.Error(t, err)
+
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, "MsgAddLimitOrder", coins)
+	require.True(t, types.ErrInvalidMsgTypeURL.Is(err))
+
+	// Type URLs must resolve to a message known to the chain
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, "/cosmos.bank.v1beta1.MsgSnd", coins)
+	require.True(t, types.ErrInvalidMsgTypeURL.Is(err))
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, "/cosmos.base.v1beta1.Coin", coins)
+	require.True(t, types.ErrInvalidMsgTypeURL.Is(err))
+
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, orderMsgType, coins)
+	require.NoError(t, err)
+
+	gasPrices, found := keeper.GetMessageGasPrices(ctx, orderMsgType)
+	require.True(t, found)
+	require.Equal(t, coins, gasPrices)
+	require.Equal(t, []types.MessageGasPrices{{TypeUrl: orderMsgType, Minimum: coins}}, keeper.GetAllMessageGasPrices(ctx))
+
+	// Do not allow fees to be set in token denominations that are not present in the chain
+	unknown, _ := sdk.ParseDecCoins("0.0000001esek")
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, orderMsgType, unknown)
+	require.True(t, types.ErrUnknownDenom.Is(err))
+
+	// Empty gas prices remove the override
+	_, err = keeper.SetMessageGasPrices(ctx, accAuthority, orderMsgType, sdk.NewDecCoins())
+	require.NoError(t, err)
+	_, found = keeper.GetMessageGasPrices(ctx, orderMsgType)
+	require.False(t, found)
+}
+
+func TestManageFeeConversion(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+
+	var (
+		accAuthority = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		accRandom    = mustParseAddress("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		conversion   = types.FeeConversion{ReferenceDenom: "eeur", MaxPriceAge: time.Hour}
+	)
+
+	keeper.BootstrapAuthority(ctx, accAuthority)
+	require.False(t, keeper.GetFeeConversion(ctx).Enabled())
+
+	_, err := keeper.SetFeeConversion(ctx, accRandom, conversion)
+	require.Error(t, err)
+
+	_, err = keeper.SetFeeConversion(ctx, accAuthority, types.FeeConversion{ReferenceDenom: "eeur"})
+	require.True(t, types.ErrInvalidMaxAge.Is(err))
+
+	_, err = keeper.SetFeeConversion(ctx, accAuthority, types.FeeConversion{ReferenceDenom: "esek", MaxPriceAge: time.Hour})
+	require.True(t, types.ErrUnknownDenom.Is(err))
+
+	// The reference denomination must be one that fees are charged in
+	_, err = keeper.SetGasPrices(ctx, accAuthority, sdk.NewDecCoins(sdk.NewDecCoinFromDec("echf", sdk.NewDecWithPrec(1, 2))))
+	require.NoError(t, err)
+	_, err = keeper.SetFeeConversion(ctx, accAuthority, conversion)
+	require.True(t, types.ErrNoReferenceGasPrice.Is(err))
+
+	_, err = keeper.SetGasPrices(ctx, accAuthority, sdk.NewDecCoins(sdk.NewDecCoinFromDec("eeur", sdk.NewDecWithPrec(1, 4))))
+	require.NoError(t, err)
+	_, err = keeper.SetFeeConversion(ctx, accAuthority, conversion)
+	require.NoError(t, err)
+	require.Equal(t, conversion, keeper.GetFeeConversion(ctx))
+
+	// Gas prices cannot drop the reference denomination while fees are converted
+	_, err = keeper.SetGasPrices(ctx, accAuthority, sdk.NewDecCoins(sdk.NewDecCoinFromDec("echf", sdk.NewDecWithPrec(1, 2))))
+	require.True(t, types.ErrNoReferenceGasPrice.Is(err))
+
+	_, err = keeper.SetFeeConversion(ctx, accAuthority, types.FeeConversion{})
+	require.NoError(t, err)
+	require.False(t, keeper.GetFeeConversion(ctx).Enabled())
+}
+
 func TestReplaceAuthority(t *testing.T) {
 	ctx, keeper, _, _ := createTestComponents(t)
 
@@ -442,7 +536,7 @@ func createTestComponentWithEncodingConfig(t *testing.T, encConfig simappparams.
 		sdk.NewCoin("eeur", sdk.NewInt(5000))))
 
 	gpk := new(mockGasPricesKeeper)
-	keeper := NewKeeper(encConfig.Marshaler, authKey, ik, bk, gpk, upgK, pk)
+	keeper := NewKeeper(encConfig.Marshaler, encConfig.InterfaceRegistry, authKey, ik, mockInflationKeeper{}, bk, gpk, upgK, pk)
 
 	return ctx, keeper, ik, gpk
 }
@@ -467,10 +561,22 @@ func (m mockInflationKeeper) SetInflation(ctx sdk.Context, inflation sdk.Dec, de
 	return
 }
 
+func (m mockInflationKeeper) SetInflationSchedule(sdk.Context, string, []inflationtypes.InflationStep) (_ *sdk.Result, _ error) {
+	return
+}
+
+func (m mockInflationKeeper) SetDistribution(sdk.Context, string, []inflationtypes.DistributionTarget) (_ *sdk.Result, _ error) {
+	return
+}
+
 func (m mockInflationKeeper) AddDenoms(sdk.Context, []string) (_ *sdk.Result, _ error) {
 	return
 }
 
+func (m mockInflationKeeper) GetDemurrageIndex(sdk.Context, string) (sdk.Dec, bool) {
+	return sdk.OneDec(), false
+}
+
 var encodingConfig simappparams.EncodingConfig
 
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
@@ -498,6 +604,7 @@ func MakeTestEncodingConfig() simappparams.EncodingConfig {
 
 	ModuleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	markettypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	return encodingConfig
 }
 
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -18,8 +19,13 @@ var _ types.MsgServer = msgServer{}
 type authorityKeeper interface {
 	createIssuer(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress, denoms []types.Denomination) (*sdk.Result, error)
 	destroyIssuer(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error)
+	transferDenom(ctx sdk.Context, authority sdk.AccAddress, denom string, fromIssuer, toIssuer sdk.AccAddress, revokeLiquidityProviders bool) (*sdk.Result, error)
+	setSupplyCap(ctx sdk.Context, authority sdk.AccAddress, supplyCap sdk.Coin) (*sdk.Result, error)
+	setInflationDistribution(ctx sdk.Context, authority sdk.AccAddress, denom string, targets []inflationtypes.DistributionTarget) (*sdk.Result, error)
 	replaceAuthority(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
 	SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
+	SetMessageGasPrices(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error)
+	SetFeeConversion(ctx sdk.Context, authority sdk.AccAddress, conversion types.FeeConversion) (*sdk.Result, error)
 	ScheduleUpgrade(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
 	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
 	SetParams(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
@@ -75,6 +81,68 @@ func (m msgServer) DestroyIssuer(goCtx context.Context, msg *types.MsgDestroyIss
 	return &types.MsgDestroyIssuerResponse{}, nil
 }
 
+func (m msgServer) TransferDenom(goCtx context.Context, msg *types.MsgTransferDenom) (*types.MsgTransferDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+	fromIssuer, err := sdk.AccAddressFromBech32(msg.FromIssuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from issuer")
+	}
+	toIssuer, err := sdk.AccAddressFromBech32(msg.ToIssuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "to issuer")
+	}
+
+	result, err := m.k.transferDenom(ctx, authority, msg.Denom, fromIssuer, toIssuer, msg.RevokeLiquidityProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgTransferDenomResponse{}, nil
+}
+
+func (m msgServer) SetSupplyCap(goCtx context.Context, msg *types.MsgSetSupplyCap) (*types.MsgSetSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.setSupplyCap(ctx, authority, msg.Cap)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetSupplyCapResponse{}, nil
+}
+
+func (m msgServer) SetInflationDistribution(goCtx context.Context, msg *types.MsgSetInflationDistribution) (*types.MsgSetInflationDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.setInflationDistribution(ctx, authority, msg.Denom, msg.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetInflationDistributionResponse{}, nil
+}
+
 func (m msgServer) SetGasPrices(goCtx context.Context, msg *types.MsgSetGasPrices) (*types.MsgSetGasPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	authority, err := sdk.AccAddressFromBech32(msg.Authority)
@@ -93,6 +161,42 @@ func (m msgServer) SetGasPrices(goCtx context.Context, msg *types.MsgSetGasPrice
 	return &types.MsgSetGasPricesResponse{}, nil
 }
 
+func (m msgServer) SetMessageGasPrices(goCtx context.Context, msg *types.MsgSetMessageGasPrices) (*types.MsgSetMessageGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.SetMessageGasPrices(ctx, authority, msg.TypeUrl, msg.GasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetMessageGasPricesResponse{}, nil
+}
+
+func (m msgServer) SetFeeConversion(goCtx context.Context, msg *types.MsgSetFeeConversion) (*types.MsgSetFeeConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.SetFeeConversion(ctx, authority, msg.FeeConversion())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetFeeConversionResponse{}, nil
+}
+
 func (m msgServer) ReplaceAuthority(goCtx context.Context, msg *types.MsgReplaceAuthority) (*types.MsgReplaceAuthorityResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	authorityAcc, err := sdk.AccAddressFromBech32(msg.Authority)
@@ -12,6 +12,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
@@ -289,6 +290,71 @@ func TestSetGasPrices(t *testing.T) {
 	}
 }
 
+func TestSetMessageGasPrices(t *testing.T) {
+	var (
+		authorityAddr = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		gotAuthority  sdk.AccAddress
+		gotTypeURL    string
+		gotGasPrices  sdk.DecCoins
+	)
+
+	keeper := authorityKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req    *types.MsgSetMessageGasPrices
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error)
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgSetMessageGasPrices{
+				Authority: authorityAddr.String(),
+				TypeUrl:   "/em.market.v1.MsgAddLimitOrder",
+				GasPrices: sdk.DecCoins{sdk.NewDecCoin("eeur", sdk.OneInt())},
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error) {
+				gotAuthority, gotTypeURL, gotGasPrices = authority, typeURL, gasprices
+				return &sdk.Result{}, nil
+			},
+		},
+		"authority invalid": {
+			req: &types.MsgSetMessageGasPrices{
+				Authority: "invalid",
+				TypeUrl:   "/em.market.v1.MsgAddLimitOrder",
+				GasPrices: sdk.DecCoins{sdk.NewDecCoin("eeur", sdk.OneInt())},
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetMessageGasPrices{
+				Authority: authorityAddr.String(),
+				TypeUrl:   "/em.market.v1.MsgAddLimitOrder",
+				GasPrices: sdk.DecCoins{sdk.NewDecCoin("eeur", sdk.OneInt())},
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.setMsgGasPricesfn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+			_, gotErr := svr.SetMessageGasPrices(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.req.Authority, gotAuthority.String())
+			assert.Equal(t, spec.req.TypeUrl, gotTypeURL)
+			assert.Equal(t, spec.req.GasPrices, gotGasPrices)
+		})
+	}
+}
+
 func TestScheduleUpgrade(t *testing.T) {
 	var (
 		authorityAddr = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
@@ -608,14 +674,19 @@ func TestGrpcSetParams(t *testing.T) {
 
 // mock implementation of authorityKeeper interface
 type authorityKeeperMock struct {
-	createIssuerfn     func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress, denoms []types.Denomination) (*sdk.Result, error)
-	destroyIssuerfn    func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error)
-	SetGasPricesfn     func(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
-	replaceAuthorityfn func(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
-	scheduleUpgradefn  func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
-	getUpgradePlanfn   func(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
-	applyUpgradefn     func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
-	setParamsfn        func(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
+	createIssuerfn             func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress, denoms []types.Denomination) (*sdk.Result, error)
+	destroyIssuerfn            func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error)
+	transferDenomfn            func(ctx sdk.Context, authority sdk.AccAddress, denom string, fromIssuer, toIssuer sdk.AccAddress, revokeLiquidityProviders bool) (*sdk.Result, error)
+	setSupplyCapfn             func(ctx sdk.Context, authority sdk.AccAddress, supplyCap sdk.Coin) (*sdk.Result, error)
+	setInflationDistributionfn func(ctx sdk.Context, authority sdk.AccAddress, denom string, targets []inflationtypes.DistributionTarget) (*sdk.Result, error)
+	SetGasPricesfn             func(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
+	setMsgGasPricesfn          func(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error)
+	setFeeConversionfn         func(ctx sdk.Context, authority sdk.AccAddress, conversion types.FeeConversion) (*sdk.Result, error)
+	replaceAuthorityfn         func(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
+	scheduleUpgradefn          func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	getUpgradePlanfn           func(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
+	applyUpgradefn             func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	setParamsfn                func(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
 }
 
 func (a authorityKeeperMock) SetParams(
@@ -642,6 +713,27 @@ func (a authorityKeeperMock) destroyIssuer(ctx sdk.Context, authority sdk.AccAdd
 	return a.destroyIssuerfn(ctx, authority, issuerAddress)
 }
 
+func (a authorityKeeperMock) transferDenom(ctx sdk.Context, authority sdk.AccAddress, denom string, fromIssuer, toIssuer sdk.AccAddress, revokeLiquidityProviders bool) (*sdk.Result, error) {
+	if a.transferDenomfn == nil {
+		panic("not expected to be called")
+	}
+	return a.transferDenomfn(ctx, authority, denom, fromIssuer, toIssuer, revokeLiquidityProviders)
+}
+
+func (a authorityKeeperMock) setSupplyCap(ctx sdk.Context, authority sdk.AccAddress, supplyCap sdk.Coin) (*sdk.Result, error) {
+	if a.setSupplyCapfn == nil {
+		panic("not expected to be called")
+	}
+	return a.setSupplyCapfn(ctx, authority, supplyCap)
+}
+
+func (a authorityKeeperMock) setInflationDistribution(ctx sdk.Context, authority sdk.AccAddress, denom string, targets []inflationtypes.DistributionTarget) (*sdk.Result, error) {
+	if a.setInflationDistributionfn == nil {
+		panic("not expected to be called")
+	}
+	return a.setInflationDistributionfn(ctx, authority, denom, targets)
+}
+
 func (a authorityKeeperMock) SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error) {
 	if a.SetGasPricesfn == nil {
 		panic("not expected to be called")
@@ -649,6 +741,20 @@ func (a authorityKeeperMock) SetGasPrices(ctx sdk.Context, authority sdk.AccAddr
 	return a.SetGasPricesfn(ctx, authority, gasprices)
 }
 
+func (a authorityKeeperMock) SetMessageGasPrices(ctx sdk.Context, authority sdk.AccAddress, typeURL string, gasprices sdk.DecCoins) (*sdk.Result, error) {
+	if a.setMsgGasPricesfn == nil {
+		panic("not expected to be called")
+	}
+	return a.setMsgGasPricesfn(ctx, authority, typeURL, gasprices)
+}
+
+func (a authorityKeeperMock) SetFeeConversion(ctx sdk.Context, authority sdk.AccAddress, conversion types.FeeConversion) (*sdk.Result, error) {
+	if a.setFeeConversionfn == nil {
+		panic("not expected to be called")
+	}
+	return a.setFeeConversionfn(ctx, authority, conversion)
+}
+
 func (a authorityKeeperMock) replaceAuthority(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error) {
 	if a.replaceAuthorityfn == nil {
 		panic("not expected to be called")
@@ -96,8 +96,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	authority := am.keeper.GetAuthoritySet(ctx)
 	genesis := &types.GenesisState{
-		AuthorityKey: authority.Address,
-		MinGasPrices: am.keeper.GetGasPrices(ctx),
+		AuthorityKey:     authority.Address,
+		MinGasPrices:     am.keeper.GetGasPrices(ctx),
+		MessageGasPrices: am.keeper.GetAllMessageGasPrices(ctx),
+		FeeConversion:    am.keeper.GetFeeConversion(ctx),
 	}
 	return cdc.MustMarshalJSON(genesis)
 }
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -133,41 +134,161 @@ func (m *GasPrices) GetMinimum() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	return nil
 }
 
+// MessageGasPrices overrides the chain-wide minimum gas prices for a single
+// message type.
+type MessageGasPrices struct {
+	TypeUrl string                                      `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	Minimum github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum" yaml:"minimum"`
+}
+
+func (m *MessageGasPrices) Reset()         { *m = MessageGasPrices{} }
+func (m *MessageGasPrices) String() string { return proto.CompactTextString(m) }
+func (*MessageGasPrices) ProtoMessage()    {}
+func (*MessageGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f91f8bbecb83881, []int{2}
+}
+func (m *MessageGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageGasPrices.Merge(m, src)
+}
+func (m *MessageGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageGasPrices proto.InternalMessageInfo
+
+func (m *MessageGasPrices) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageGasPrices) GetMinimum() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Minimum
+	}
+	return nil
+}
+
+// FeeConversion allows fees to be paid in any denomination with a market
+// instrument against the reference denomination. The required fee is converted
+// using the last traded price, which must be no older than max_price_age.
+// Only minimum gas prices in the reference denomination are converted.
+type FeeConversion struct {
+	ReferenceDenom string        `protobuf:"bytes,1,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	MaxPriceAge    time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age"`
+}
+
+func (m *FeeConversion) Reset()         { *m = FeeConversion{} }
+func (m *FeeConversion) String() string { return proto.CompactTextString(m) }
+func (*FeeConversion) ProtoMessage()    {}
+func (*FeeConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f91f8bbecb83881, []int{3}
+}
+func (m *FeeConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConversion.Merge(m, src)
+}
+func (m *FeeConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConversion proto.InternalMessageInfo
+
+func (m *FeeConversion) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *FeeConversion) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Authority)(nil), "em.authority.v1.Authority")
 	proto.RegisterType((*GasPrices)(nil), "em.authority.v1.GasPrices")
+	proto.RegisterType((*MessageGasPrices)(nil), "em.authority.v1.MessageGasPrices")
+	proto.RegisterType((*FeeConversion)(nil), "em.authority.v1.FeeConversion")
 }
 
 func init() { proto.RegisterFile("em/authority/v1/authority.proto", fileDescriptor_3f91f8bbecb83881) }
 
 var fileDescriptor_3f91f8bbecb83881 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x14, 0x8c, 0xa9, 0x44, 0xb5, 0x29, 0x2d, 0x52, 0x54, 0xa4, 0x65, 0x85, 0xe2, 0x28, 0xa7, 0x95,
-	0x60, 0x6d, 0xa5, 0xdc, 0x38, 0xd1, 0x00, 0x82, 0x0b, 0x12, 0x8a, 0x38, 0x71, 0xa9, 0x9c, 0xe4,
-	0x6d, 0x6a, 0x11, 0xc7, 0xab, 0xd8, 0x59, 0x91, 0x03, 0x3f, 0xc0, 0xa9, 0xdf, 0xc1, 0x97, 0xf4,
-	0xd8, 0x23, 0xa7, 0x14, 0xed, 0xfe, 0xc1, 0x7e, 0x41, 0xb5, 0xb1, 0xad, 0xb6, 0xa7, 0xe4, 0xcd,
-	0xbc, 0x99, 0x79, 0x9a, 0xc4, 0xc7, 0x20, 0x28, 0xeb, 0xf4, 0xa5, 0x6c, 0xb9, 0xee, 0xe9, 0x3a,
-	0xb9, 0x1f, 0xc8, 0xaa, 0x95, 0x5a, 0x06, 0xcf, 0x41, 0x90, 0x7b, 0x6c, 0x9d, 0xcc, 0x4e, 0x2b,
-	0x59, 0xc9, 0x91, 0xa3, 0xfb, 0x37, 0xb3, 0x36, 0x0b, 0x0b, 0xa9, 0x84, 0x54, 0x34, 0x67, 0x0a,
-	0xe8, 0x3a, 0xc9, 0x41, 0xb3, 0x84, 0x16, 0x92, 0x37, 0x96, 0xc7, 0x95, 0x94, 0x55, 0x0d, 0x74,
-	0x9c, 0xf2, 0x6e, 0x49, 0x35, 0x17, 0xa0, 0x34, 0x13, 0x2b, 0xb3, 0x10, 0x0f, 0xc8, 0x9f, 0x9c,
-	0xbb, 0x9c, 0xe0, 0x8d, 0x7f, 0xc8, 0xca, 0xb2, 0x05, 0xa5, 0xa6, 0x28, 0x42, 0xf3, 0x49, 0x1a,
-	0xec, 0x06, 0x7c, 0xd2, 0x33, 0x51, 0xbf, 0x8b, 0x2d, 0x11, 0x67, 0x6e, 0x25, 0x78, 0xef, 0x9f,
-	0x2c, 0x65, 0x2b, 0xa0, 0xbd, 0x70, 0xa2, 0x27, 0xa3, 0xe8, 0xe5, 0x6e, 0xc0, 0x2f, 0x8c, 0xe8,
-	0x31, 0x1f, 0x67, 0xc7, 0x06, 0x38, 0xb7, 0x0e, 0xcc, 0x3f, 0xae, 0x99, 0xd2, 0x17, 0x42, 0x96,
-	0x7c, 0xc9, 0xa1, 0x9c, 0x1e, 0x44, 0x68, 0x7e, 0x74, 0x36, 0x23, 0xe6, 0x6c, 0xe2, 0xce, 0x26,
-	0xdf, 0xdd, 0xd9, 0x69, 0x74, 0x3d, 0x60, 0x6f, 0x37, 0xe0, 0x53, 0x13, 0xf0, 0x48, 0x1e, 0x5f,
-	0xdd, 0x62, 0x94, 0x3d, 0xdb, 0x63, 0x5f, 0x1d, 0xf4, 0x07, 0xf9, 0x93, 0xcf, 0x4c, 0x7d, 0x6b,
-	0x79, 0x01, 0x2a, 0xf8, 0xed, 0x1f, 0x0a, 0xde, 0x70, 0xd1, 0x89, 0x29, 0x8a, 0x0e, 0xe6, 0x47,
-	0x67, 0xaf, 0x88, 0x69, 0x90, 0xec, 0x1b, 0x24, 0xb6, 0x41, 0xf2, 0x11, 0x8a, 0x0f, 0x92, 0x37,
-	0xe9, 0x27, 0x1b, 0x66, 0x2b, 0xb0, 0xd2, 0xf8, 0xef, 0x2d, 0x7e, 0x5d, 0x71, 0x7d, 0xd9, 0xe5,
-	0xa4, 0x90, 0x82, 0xda, 0x6f, 0x60, 0x1e, 0x0b, 0x55, 0xfe, 0xa4, 0xba, 0x5f, 0x81, 0x72, 0x2e,
-	0x2a, 0x73, 0x99, 0xe9, 0x97, 0xeb, 0x4d, 0x88, 0x6e, 0x36, 0x21, 0xfa, 0xbf, 0x09, 0xd1, 0xd5,
-	0x36, 0xf4, 0x6e, 0xb6, 0xa1, 0xf7, 0x6f, 0x1b, 0x7a, 0x3f, 0xc8, 0x03, 0x3f, 0x58, 0x08, 0xd9,
-	0x40, 0x4f, 0x41, 0x2c, 0x6a, 0x28, 0x2b, 0x68, 0xe9, 0xaf, 0x07, 0x3f, 0xcb, 0xe8, 0x9d, 0x3f,
-	0x1d, 0xab, 0x79, 0x7b, 0x17, 0x00, 0x00, 0xff, 0xff, 0x05, 0xd5, 0xd3, 0x36, 0x49, 0x02, 0x00,
-	0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xb4, 0x12, 0xa5, 0x13, 0xd2, 0x22, 0x53, 0x50, 0x1b, 0x21, 0x3b, 0xf2, 0xaa, 0x12,
+	0x74, 0x46, 0x29, 0x3b, 0x56, 0x34, 0x09, 0x8f, 0x4d, 0x25, 0x64, 0xc1, 0x86, 0x8d, 0x35, 0xb1,
+	0x6f, 0xdc, 0x11, 0x1e, 0x4f, 0x34, 0x63, 0x47, 0xc9, 0x82, 0x1f, 0x60, 0xd5, 0x25, 0xdf, 0xc0,
+	0x9e, 0x0f, 0x60, 0xd7, 0x65, 0x97, 0xac, 0x5c, 0x94, 0xfc, 0x41, 0xbe, 0x00, 0xd9, 0x1e, 0x93,
+	0x07, 0x1f, 0xc0, 0xca, 0x33, 0xe7, 0xde, 0x73, 0x1f, 0xc7, 0x67, 0xb0, 0x03, 0x82, 0xb2, 0x2c,
+	0xbd, 0x92, 0x8a, 0xa7, 0x33, 0x3a, 0xe9, 0xae, 0x2e, 0x64, 0xac, 0x64, 0x2a, 0xad, 0x43, 0x10,
+	0x64, 0x85, 0x4d, 0xba, 0xed, 0xa3, 0x48, 0x46, 0xb2, 0x8c, 0xd1, 0xe2, 0x54, 0xa5, 0xb5, 0xed,
+	0x40, 0x6a, 0x21, 0x35, 0x1d, 0x32, 0x0d, 0x74, 0xd2, 0x1d, 0x42, 0xca, 0xba, 0x34, 0x90, 0x3c,
+	0x31, 0x71, 0x27, 0x92, 0x32, 0x8a, 0x81, 0x96, 0xb7, 0x61, 0x36, 0xa2, 0x29, 0x17, 0xa0, 0x53,
+	0x26, 0xc6, 0x75, 0x81, 0xed, 0x84, 0x30, 0x53, 0x2c, 0xe5, 0xd2, 0x14, 0x70, 0x73, 0x84, 0xf7,
+	0x2f, 0xea, 0x39, 0xac, 0xe7, 0x78, 0x8f, 0x85, 0xa1, 0x02, 0xad, 0x8f, 0x51, 0x07, 0x9d, 0xee,
+	0xf7, 0xac, 0x65, 0xee, 0x1c, 0xcc, 0x98, 0x88, 0x5f, 0xba, 0x26, 0xe0, 0x7a, 0x75, 0x8a, 0xf5,
+	0x0a, 0x1f, 0x8c, 0xa4, 0x12, 0xa0, 0xfc, 0x9a, 0xb4, 0x53, 0x92, 0x4e, 0x96, 0xb9, 0xf3, 0xb8,
+	0x22, 0x6d, 0xc6, 0x5d, 0xaf, 0x55, 0x01, 0x17, 0xa6, 0x02, 0xc3, 0xad, 0x98, 0xe9, 0xd4, 0x17,
+	0x32, 0xe4, 0x23, 0x0e, 0xe1, 0xf1, 0x6e, 0x07, 0x9d, 0x36, 0xcf, 0xdb, 0xa4, 0x9a, 0x9a, 0xd4,
+	0x53, 0x93, 0x0f, 0xf5, 0x5a, 0xbd, 0xce, 0x4d, 0xee, 0x34, 0x96, 0xb9, 0x73, 0x54, 0x35, 0xd8,
+	0xa0, 0xbb, 0xd7, 0x77, 0x0e, 0xf2, 0x1e, 0x14, 0xd8, 0x65, 0x0d, 0x7d, 0x45, 0x78, 0xff, 0x2d,
+	0xd3, 0xef, 0x15, 0x0f, 0x40, 0x5b, 0x5f, 0xf0, 0x9e, 0xe0, 0x09, 0x17, 0x99, 0x38, 0x46, 0x9d,
+	0xdd, 0xd3, 0xe6, 0xf9, 0x53, 0x52, 0x29, 0x4c, 0x0a, 0x85, 0x89, 0x51, 0x98, 0x0c, 0x20, 0xe8,
+	0x4b, 0x9e, 0xf4, 0x5e, 0x9b, 0x66, 0x46, 0x02, 0x43, 0x75, 0xbf, 0xdf, 0x39, 0xcf, 0x22, 0x9e,
+	0x5e, 0x65, 0x43, 0x12, 0x48, 0x41, 0xcd, 0x3f, 0xaa, 0x3e, 0x67, 0x3a, 0xfc, 0x4c, 0xd3, 0xd9,
+	0x18, 0x74, 0x5d, 0x45, 0x7b, 0x75, 0x4f, 0xf7, 0x27, 0xc2, 0x0f, 0x2f, 0x41, 0x6b, 0x16, 0xc1,
+	0x6a, 0x26, 0x82, 0xef, 0x17, 0xf9, 0x7e, 0xa6, 0x62, 0xa3, 0xfa, 0xa3, 0x65, 0xee, 0x1c, 0x56,
+	0x2d, 0xeb, 0x88, 0xeb, 0xed, 0x15, 0xc7, 0x8f, 0x2a, 0x5e, 0xdf, 0x61, 0xe7, 0x3f, 0xec, 0xf0,
+	0x03, 0xe1, 0xd6, 0x1b, 0x80, 0xbe, 0x4c, 0x26, 0xa0, 0x34, 0x97, 0x89, 0xd5, 0xc7, 0x87, 0x0a,
+	0x46, 0xa0, 0x20, 0x09, 0xc0, 0x0f, 0x21, 0x91, 0xc2, 0xec, 0xd1, 0x5e, 0xe6, 0xce, 0x93, 0xaa,
+	0xed, 0x56, 0x82, 0xeb, 0x1d, 0xfc, 0x45, 0x06, 0x05, 0x60, 0xf9, 0xb8, 0x25, 0xd8, 0xd4, 0x1f,
+	0x17, 0x9a, 0xf8, 0x2c, 0x82, 0xd2, 0x4b, 0xcd, 0xf3, 0x93, 0x7f, 0xac, 0x30, 0x30, 0x06, 0xde,
+	0x76, 0xc2, 0x06, 0xdb, 0xfd, 0x56, 0x38, 0xa1, 0x29, 0xd8, 0xb4, 0x14, 0xf9, 0x22, 0x82, 0xde,
+	0xbb, 0x9b, 0xb9, 0x8d, 0x6e, 0xe7, 0x36, 0xfa, 0x3d, 0xb7, 0xd1, 0xf5, 0xc2, 0x6e, 0xdc, 0x2e,
+	0xec, 0xc6, 0xaf, 0x85, 0xdd, 0xf8, 0x44, 0xd6, 0x74, 0x80, 0x33, 0x21, 0x13, 0x98, 0x51, 0x10,
+	0x67, 0x31, 0x84, 0x11, 0x28, 0x3a, 0x5d, 0x7b, 0xc8, 0xa5, 0x26, 0xc3, 0x7b, 0xe5, 0x2c, 0x2f,
+	0xfe, 0x0c, 0x00, 0x6c, 0x79, 0xb8, 0xb1, 0xe5, 0x03, 0x00, 0x00,
 }
 
 func (m *Authority) Marshal() (dAtA []byte, err error) {
@@ -252,6 +373,88 @@ func (m *GasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minimum) > 0 {
+		for iNdEx := len(m.Minimum) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minimum[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthority(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintAuthority(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthority(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintAuthority(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthority(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthority(v)
 	base := offset
@@ -297,6 +500,40 @@ func (m *GasPrices) Size() (n int) {
 	return n
 }
 
+func (m *MessageGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthority(uint64(l))
+	}
+	if len(m.Minimum) > 0 {
+		for _, e := range m.Minimum {
+			l = e.Size()
+			n += 1 + l + sovAuthority(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovAuthority(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovAuthority(uint64(l))
+	return n
+}
+
 func sovAuthority(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -534,6 +771,237 @@ func (m *GasPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessageGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthority
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minimum = append(m.Minimum, types.DecCoin{})
+			if err := m.Minimum[len(m.Minimum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthority(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthority
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthority
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthority(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthority
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthority(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,7 +24,12 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateIssuer{}, "e-money/MsgCreateIssuer", nil)
 	cdc.RegisterConcrete(&MsgDestroyIssuer{}, "e-money/MsgDestroyIssuer", nil)
+	cdc.RegisterConcrete(&MsgTransferDenom{}, "e-money/MsgTransferDenom", nil)
+	cdc.RegisterConcrete(&MsgSetSupplyCap{}, "e-money/MsgSetSupplyCap", nil)
+	cdc.RegisterConcrete(&MsgSetInflationDistribution{}, "e-money/MsgSetInflationDistribution", nil)
 	cdc.RegisterConcrete(&MsgSetGasPrices{}, "e-money/MsgSetGasPrices", nil)
+	cdc.RegisterConcrete(&MsgSetMessageGasPrices{}, "e-money/MsgSetMessageGasPrices", nil)
+	cdc.RegisterConcrete(&MsgSetFeeConversion{}, "e-money/MsgSetFeeConversion", nil)
 	cdc.RegisterConcrete(&MsgReplaceAuthority{}, "e-money/MsgReplaceAuthority", nil)
 	cdc.RegisterConcrete(&MsgScheduleUpgrade{}, "e-money/MsgScheduleUpgrade", nil)
 	cdc.RegisterConcrete(&MsgSetParameters{}, "e-money/MsgSetParameters", nil)
@@ -34,7 +39,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateIssuer{},
 		&MsgDestroyIssuer{},
+		&MsgTransferDenom{},
+		&MsgSetSupplyCap{},
+		&MsgSetInflationDistribution{},
 		&MsgSetGasPrices{},
+		&MsgSetMessageGasPrices{},
+		&MsgSetFeeConversion{},
 		&MsgReplaceAuthority{},
 		&MsgScheduleUpgrade{},
 		&MsgSetParameters{},
@@ -18,5 +18,8 @@ var (
 	ErrMissingFlag           = sdkerrors.Register(ModuleName, 7, "missing flag")
 	ErrGetTotalSupply        = sdkerrors.Register(ModuleName, 8, "GetPaginatedSupply() erred")
 	//	ErrPlanTimeIsSet         = sdkerrors.Register(ModuleName, 8, "upgrade plan cannot set time")
-	ErrNoParams = sdkerrors.Register(ModuleName, 9, "no parameter changes specified")
+	ErrNoParams            = sdkerrors.Register(ModuleName, 9, "no parameter changes specified")
+	ErrInvalidMsgTypeURL   = sdkerrors.Register(ModuleName, 10, "invalid message type url")
+	ErrInvalidMaxAge       = sdkerrors.Register(ModuleName, 11, "invalid maximum price age")
+	ErrNoReferenceGasPrice = sdkerrors.Register(ModuleName, 12, "no minimum gas price in the reference denomination")
 )
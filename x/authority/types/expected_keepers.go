@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 type (
@@ -28,6 +29,10 @@ type (
 		SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler)
 	}
 
+	InflationKeeper interface {
+		SetDistribution(ctx sdk.Context, denom string, targets []inflationtypes.DistributionTarget) (*sdk.Result, error)
+	}
+
 	ParamsKeeper interface {
 		GetSubspace(name string) (ss params.Subspace, found bool)
 	}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Enabled reports whether fees may be paid in denominations converted to the reference denomination.
+func (fc FeeConversion) Enabled() bool {
+	return fc.ReferenceDenom != ""
+}
+
+func (fc FeeConversion) Validate() error {
+	if err := sdk.ValidateDenom(fc.ReferenceDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, err.Error())
+	}
+
+	if fc.MaxPriceAge <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidMaxAge, "%v", fc.MaxPriceAge)
+	}
+
+	return nil
+}
@@ -26,8 +26,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	AuthorityKey string                                      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" yaml:"key"`
-	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	AuthorityKey     string                                      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" yaml:"key"`
+	MinGasPrices     github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	MessageGasPrices []MessageGasPrices                          `protobuf:"bytes,3,rep,name=message_gas_prices,json=messageGasPrices,proto3" json:"message_gas_prices" yaml:"message_gas_prices"`
+	FeeConversion    FeeConversion                               `protobuf:"bytes,4,opt,name=fee_conversion,json=feeConversion,proto3" json:"fee_conversion" yaml:"fee_conversion"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,20 @@ func (m *GenesisState) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecC
 	return nil
 }
 
+func (m *GenesisState) GetMessageGasPrices() []MessageGasPrices {
+	if m != nil {
+		return m.MessageGasPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeConversion() FeeConversion {
+	if m != nil {
+		return m.FeeConversion
+	}
+	return FeeConversion{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.authority.v1.GenesisState")
 }
@@ -84,28 +100,33 @@ func init() {
 func init() { proto.RegisterFile("em/authority/v1/genesis.proto", fileDescriptor_51063264c25bc319) }
 
 var fileDescriptor_51063264c25bc319 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x33, 0x7f, 0xe1, 0x07, 0x63, 0x51, 0x28, 0x0a, 0xa5, 0xe8, 0xa4, 0x64, 0x55, 0x90,
-	0xce, 0x18, 0xdd, 0xb9, 0xb3, 0x0a, 0x15, 0x74, 0x21, 0x75, 0xe7, 0xa6, 0x4c, 0xd2, 0x4b, 0x3a,
-	0xb4, 0x33, 0x53, 0x32, 0xd3, 0xe2, 0xbc, 0x45, 0x9f, 0xc3, 0x27, 0xe9, 0xb2, 0xe0, 0xc6, 0x55,
-	0x94, 0xf4, 0x0d, 0xfa, 0x04, 0xd2, 0x24, 0xd5, 0xea, 0x6a, 0x06, 0xce, 0xb9, 0xdf, 0x39, 0xf7,
-	0xba, 0xa7, 0x20, 0x28, 0x9b, 0x9a, 0xa1, 0x4a, 0xb8, 0xb1, 0x74, 0x16, 0xd0, 0x18, 0x24, 0x68,
-	0xae, 0xc9, 0x24, 0x51, 0x46, 0xd5, 0x0e, 0x41, 0x90, 0x6f, 0x99, 0xcc, 0x82, 0xc6, 0x51, 0xac,
-	0x62, 0x95, 0x6b, 0x74, 0xf3, 0x2b, 0x6c, 0x0d, 0x1c, 0x29, 0x2d, 0x94, 0xa6, 0x21, 0xd3, 0x40,
-	0x67, 0x41, 0x08, 0x86, 0x05, 0x34, 0x52, 0x5c, 0x96, 0xba, 0xf7, 0x37, 0xe5, 0x87, 0x99, 0x1b,
-	0xfc, 0x37, 0xe4, 0x56, 0xbb, 0x45, 0xf2, 0x93, 0x61, 0x06, 0x6a, 0xe7, 0x6e, 0x65, 0x04, 0xb6,
-	0x8e, 0x9a, 0xa8, 0xb5, 0xd7, 0xc1, 0x59, 0xea, 0x55, 0xaf, 0xb7, 0x23, 0xf7, 0x60, 0xd7, 0xa9,
-	0xe7, 0x5a, 0x26, 0xc6, 0x57, 0xfe, 0x08, 0xac, 0xdf, 0xdb, 0x58, 0x6b, 0x73, 0xe4, 0x1e, 0x08,
-	0x2e, 0xfb, 0x31, 0xd3, 0xfd, 0x49, 0xc2, 0x23, 0xd0, 0xf5, 0x7f, 0xcd, 0x4a, 0x6b, 0xff, 0xe2,
-	0x84, 0x14, 0xed, 0xc8, 0xa6, 0x1d, 0x29, 0xdb, 0x91, 0x5b, 0x88, 0x6e, 0x14, 0x97, 0x9d, 0x87,
-	0x45, 0xea, 0x39, 0xeb, 0xd4, 0x3b, 0x2e, 0x78, 0xbf, 0x09, 0xfe, 0xeb, 0x87, 0x77, 0x16, 0x73,
-	0x33, 0x9c, 0x86, 0x24, 0x52, 0x82, 0x96, 0x6b, 0x16, 0x4f, 0x5b, 0x0f, 0x46, 0xd4, 0xd8, 0x09,
-	0xe8, 0x2d, 0x4c, 0xf7, 0xaa, 0x82, 0xcb, 0x2e, 0xd3, 0x8f, 0xf9, 0x74, 0xe7, 0x6e, 0x91, 0x61,
-	0xb4, 0xcc, 0x30, 0xfa, 0xcc, 0x30, 0x9a, 0xaf, 0xb0, 0xb3, 0x5c, 0x61, 0xe7, 0x7d, 0x85, 0x9d,
-	0x67, 0xb2, 0x03, 0x85, 0xb6, 0x50, 0x12, 0x2c, 0x05, 0xd1, 0x1e, 0xc3, 0x20, 0x86, 0x84, 0xbe,
-	0xec, 0x1c, 0x2b, 0x0f, 0x08, 0xff, 0xe7, 0x67, 0xba, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xe1,
-	0x96, 0xe9, 0x1d, 0xaf, 0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0x23, 0x82, 0xd9, 0xba, 0x4a, 0x50, 0xa8, 0x8b, 0x3b, 0xe9, 0xe6, 0x54, 0x90,
+	0xce, 0x98, 0xf5, 0xe6, 0xcd, 0xac, 0xb8, 0x82, 0x0a, 0x12, 0x6f, 0x5e, 0xca, 0x24, 0xfd, 0x36,
+	0x1d, 0xda, 0x99, 0x29, 0x99, 0x69, 0x30, 0x6f, 0xd1, 0xe7, 0x10, 0x7c, 0x8f, 0x1e, 0x7b, 0xf4,
+	0x14, 0x25, 0x7d, 0x83, 0x3e, 0x81, 0x34, 0x49, 0xbb, 0x4d, 0x7b, 0x4a, 0xe0, 0xfb, 0xff, 0xff,
+	0xdf, 0x6f, 0xfe, 0x7c, 0xf6, 0x25, 0x70, 0x42, 0xe7, 0x7a, 0x2c, 0x53, 0xa6, 0x73, 0x92, 0xf9,
+	0x24, 0x01, 0x01, 0x8a, 0x29, 0x3c, 0x4b, 0xa5, 0x96, 0xce, 0x53, 0xe0, 0x78, 0x3f, 0xc6, 0x99,
+	0x7f, 0xf1, 0x3c, 0x91, 0x89, 0xac, 0x66, 0x64, 0xfb, 0x57, 0xcb, 0x2e, 0x50, 0x2c, 0x15, 0x97,
+	0x8a, 0x44, 0x54, 0x01, 0xc9, 0xfc, 0x08, 0x34, 0xf5, 0x49, 0x2c, 0x99, 0x68, 0xe6, 0xee, 0xf1,
+	0x96, 0xfb, 0xcc, 0x4a, 0xe0, 0xfd, 0xb6, 0xec, 0xce, 0x6d, 0xbd, 0xf9, 0xbb, 0xa6, 0x1a, 0x9c,
+	0x37, 0xb6, 0x35, 0x81, 0xbc, 0x6b, 0xf6, 0xcc, 0xfe, 0xe3, 0x00, 0x95, 0x85, 0xdb, 0x79, 0xbf,
+	0xb3, 0x7c, 0x86, 0x7c, 0x53, 0xb8, 0x76, 0x4e, 0xf9, 0xf4, 0x9d, 0x37, 0x81, 0xdc, 0x0b, 0xb7,
+	0x52, 0x67, 0x61, 0xda, 0xe7, 0x9c, 0x89, 0x61, 0x42, 0xd5, 0x70, 0x96, 0xb2, 0x18, 0x54, 0xf7,
+	0x41, 0xcf, 0xea, 0x9f, 0x5d, 0xbf, 0xc2, 0x35, 0x1d, 0xde, 0xd2, 0xe1, 0x86, 0x0e, 0x7f, 0x80,
+	0xf8, 0x46, 0x32, 0x11, 0x7c, 0x59, 0x16, 0xae, 0xb1, 0x29, 0xdc, 0x17, 0x75, 0x5e, 0x3b, 0xc1,
+	0xfb, 0xf5, 0xd7, 0x7d, 0x9d, 0x30, 0x3d, 0x9e, 0x47, 0x38, 0x96, 0x9c, 0x34, 0xcf, 0xac, 0x3f,
+	0x03, 0x35, 0x9a, 0x10, 0x9d, 0xcf, 0x40, 0xed, 0xc2, 0x54, 0xd8, 0xe1, 0x4c, 0xdc, 0x52, 0xf5,
+	0xad, 0x72, 0x3b, 0xa9, 0xed, 0x70, 0x50, 0x8a, 0x26, 0x70, 0x48, 0x65, 0x55, 0x54, 0x57, 0xf8,
+	0xa8, 0x5a, 0xfc, 0xb5, 0x96, 0xee, 0xed, 0xc1, 0x55, 0x83, 0xf6, 0xb2, 0x41, 0x3b, 0x89, 0xf2,
+	0xc2, 0x67, 0xfc, 0xc8, 0xe4, 0x8c, 0xec, 0xf3, 0x3b, 0x80, 0x61, 0x2c, 0x45, 0x06, 0xa9, 0x62,
+	0x52, 0x74, 0x1f, 0xf6, 0xcc, 0xfe, 0xd9, 0x35, 0x3a, 0xd9, 0xf7, 0x11, 0xe0, 0x66, 0xaf, 0x0a,
+	0x2e, 0xdb, 0x3d, 0xb4, 0x33, 0xbc, 0xf0, 0xc9, 0x5d, 0x4b, 0xfd, 0x69, 0x59, 0x22, 0x73, 0x55,
+	0x22, 0xf3, 0x5f, 0x89, 0xcc, 0xc5, 0x1a, 0x19, 0xab, 0x35, 0x32, 0xfe, 0xac, 0x91, 0xf1, 0x03,
+	0x1f, 0xd4, 0x05, 0x03, 0x2e, 0x05, 0xe4, 0x04, 0xf8, 0x60, 0x0a, 0xa3, 0x04, 0x52, 0xf2, 0xf3,
+	0xe0, 0x0c, 0xaa, 0xea, 0xa2, 0x47, 0xd5, 0x01, 0xbc, 0xfd, 0x3f, 0x00, 0xd3, 0x39, 0x83, 0xfa,
+	0x89, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +149,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeConversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MessageGasPrices) > 0 {
+		for iNdEx := len(m.MessageGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MinGasPrices) > 0 {
 		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +224,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MessageGasPrices) > 0 {
+		for _, e := range m.MessageGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeConversion.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -283,6 +336,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageGasPrices = append(m.MessageGasPrices, MessageGasPrices{})
+			if err := m.MessageGasPrices[len(m.MessageGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,15 +5,23 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateIssuer{}
 	_ sdk.Msg = &MsgDestroyIssuer{}
+	_ sdk.Msg = &MsgTransferDenom{}
+	_ sdk.Msg = &MsgSetSupplyCap{}
+	_ sdk.Msg = &MsgSetInflationDistribution{}
 	_ sdk.Msg = &MsgSetGasPrices{}
+	_ sdk.Msg = &MsgSetMessageGasPrices{}
+	_ sdk.Msg = &MsgSetFeeConversion{}
 	_ sdk.Msg = &MsgReplaceAuthority{}
 	_ sdk.Msg = &MsgScheduleUpgrade{}
 	_ sdk.Msg = &MsgSetParameters{}
@@ -21,10 +29,20 @@ var (
 
 func (msg MsgDestroyIssuer) Type() string { return "destroy_issuer" }
 
+func (msg MsgTransferDenom) Type() string { return "transfer_denom" }
+
+func (msg MsgSetSupplyCap) Type() string { return "set_supply_cap" }
+
+func (msg MsgSetInflationDistribution) Type() string { return "set_inflation_distribution" }
+
 func (msg MsgCreateIssuer) Type() string { return "create_issuer" }
 
 func (msg MsgSetGasPrices) Type() string { return "set_gas_prices" }
 
+func (msg MsgSetMessageGasPrices) Type() string { return "set_message_gas_prices" }
+
+func (msg MsgSetFeeConversion) Type() string { return "set_fee_conversion" }
+
 func (msg MsgReplaceAuthority) Type() string { return "replace_authority" }
 
 func (msg MsgScheduleUpgrade) Type() string { return "schedule_upgrade" }
@@ -42,6 +60,48 @@ func (msg MsgDestroyIssuer) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgTransferDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromIssuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToIssuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgSetSupplyCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := msg.Cap.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgSetInflationDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if err := inflationtypes.ValidateDistribution(msg.Targets); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 func (msg MsgCreateIssuer) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
@@ -71,6 +131,47 @@ func (msg MsgSetGasPrices) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgSetMessageGasPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := ValidateMessageTypeURL(msg.TypeUrl); err != nil {
+		return err
+	}
+
+	if !msg.GasPrices.Empty() && !msg.GasPrices.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%v", msg.GasPrices)
+	}
+
+	return nil
+}
+
+func (msg MsgSetFeeConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.ReferenceDenom == "" {
+		return nil
+	}
+
+	return msg.FeeConversion().Validate()
+}
+
+func (msg MsgSetFeeConversion) FeeConversion() FeeConversion {
+	return FeeConversion{ReferenceDenom: msg.ReferenceDenom, MaxPriceAge: msg.MaxPriceAge}
+}
+
+// ValidateMessageTypeURL checks that typeURL is of the form returned by sdk.MsgTypeURL, e.g. /em.market.v1.MsgAddLimitOrder
+func ValidateMessageTypeURL(typeURL string) error {
+	if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") || strings.ContainsAny(typeURL, " \t\n") {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%q", typeURL)
+	}
+
+	return nil
+}
+
 func (msg MsgReplaceAuthority) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
@@ -119,6 +220,30 @@ func (msg MsgDestroyIssuer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (msg MsgTransferDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetSupplyCap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetInflationDistribution) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgCreateIssuer) GetSigners() []sdk.AccAddress {
 	from, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
@@ -135,6 +260,22 @@ func (msg MsgSetGasPrices) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (msg MsgSetMessageGasPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetFeeConversion) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgReplaceAuthority) GetSigners() []sdk.AccAddress {
 	from, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
@@ -163,6 +304,18 @@ func (msg MsgDestroyIssuer) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+func (msg MsgTransferDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSupplyCap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetInflationDistribution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
 func (msg MsgCreateIssuer) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
@@ -171,6 +324,14 @@ func (msg MsgSetGasPrices) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+func (msg MsgSetMessageGasPrices) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetFeeConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
 func (msg MsgReplaceAuthority) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
@@ -185,10 +346,20 @@ func (msg MsgSetParameters) GetSignBytes() []byte {
 
 func (msg MsgDestroyIssuer) Route() string { return ModuleName }
 
+func (msg MsgTransferDenom) Route() string { return ModuleName }
+
+func (msg MsgSetSupplyCap) Route() string { return ModuleName }
+
+func (msg MsgSetInflationDistribution) Route() string { return ModuleName }
+
 func (msg MsgCreateIssuer) Route() string { return ModuleName }
 
 func (msg MsgSetGasPrices) Route() string { return ModuleName }
 
+func (msg MsgSetMessageGasPrices) Route() string { return ModuleName }
+
+func (msg MsgSetFeeConversion) Route() string { return ModuleName }
+
 func (msg MsgReplaceAuthority) Route() string { return ModuleName }
 
 func (msg MsgScheduleUpgrade) Route() string { return ModuleName }
@@ -112,6 +112,166 @@ func (m *QueryGasPricesResponse) GetMinGasPrices() github_com_cosmos_cosmos_sdk_
 	return nil
 }
 
+type QueryMessageGasPricesRequest struct {
+}
+
+func (m *QueryMessageGasPricesRequest) Reset()         { *m = QueryMessageGasPricesRequest{} }
+func (m *QueryMessageGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageGasPricesRequest) ProtoMessage()    {}
+func (*QueryMessageGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{2}
+}
+func (m *QueryMessageGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageGasPricesRequest.Merge(m, src)
+}
+func (m *QueryMessageGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageGasPricesRequest proto.InternalMessageInfo
+
+type QueryMessageGasPricesResponse struct {
+	MessageGasPrices []MessageGasPrices `protobuf:"bytes,1,rep,name=message_gas_prices,json=messageGasPrices,proto3" json:"message_gas_prices" yaml:"message_gas_prices"`
+}
+
+func (m *QueryMessageGasPricesResponse) Reset()         { *m = QueryMessageGasPricesResponse{} }
+func (m *QueryMessageGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageGasPricesResponse) ProtoMessage()    {}
+func (*QueryMessageGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{3}
+}
+func (m *QueryMessageGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMessageGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMessageGasPricesResponse) GetMessageGasPrices() []MessageGasPrices {
+	if m != nil {
+		return m.MessageGasPrices
+	}
+	return nil
+}
+
+type QueryFeeConversionRequest struct {
+}
+
+func (m *QueryFeeConversionRequest) Reset()         { *m = QueryFeeConversionRequest{} }
+func (m *QueryFeeConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionRequest) ProtoMessage()    {}
+func (*QueryFeeConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{4}
+}
+func (m *QueryFeeConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionRequest.Merge(m, src)
+}
+func (m *QueryFeeConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeConversionRequest proto.InternalMessageInfo
+
+type QueryFeeConversionResponse struct {
+	FeeConversion FeeConversion `protobuf:"bytes,1,opt,name=fee_conversion,json=feeConversion,proto3" json:"fee_conversion" yaml:"fee_conversion"`
+}
+
+func (m *QueryFeeConversionResponse) Reset()         { *m = QueryFeeConversionResponse{} }
+func (m *QueryFeeConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionResponse) ProtoMessage()    {}
+func (*QueryFeeConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{5}
+}
+func (m *QueryFeeConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionResponse.Merge(m, src)
+}
+func (m *QueryFeeConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeConversionResponse proto.InternalMessageInfo
+
+func (m *QueryFeeConversionResponse) GetFeeConversion() FeeConversion {
+	if m != nil {
+		return m.FeeConversion
+	}
+	return FeeConversion{}
+}
+
 type QueryUpgradePlanRequest struct {
 }
 
@@ -119,7 +279,7 @@ func (m *QueryUpgradePlanRequest) Reset()         { *m = QueryUpgradePlanRequest
 func (m *QueryUpgradePlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradePlanRequest) ProtoMessage()    {}
 func (*QueryUpgradePlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d766145e8bc7b365, []int{2}
+	return fileDescriptor_d766145e8bc7b365, []int{6}
 }
 func (m *QueryUpgradePlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,7 +316,7 @@ func (m *QueryUpgradePlanResponse) Reset()         { *m = QueryUpgradePlanRespon
 func (m *QueryUpgradePlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradePlanResponse) ProtoMessage()    {}
 func (*QueryUpgradePlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d766145e8bc7b365, []int{3}
+	return fileDescriptor_d766145e8bc7b365, []int{7}
 }
 func (m *QueryUpgradePlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +355,10 @@ func (m *QueryUpgradePlanResponse) GetPlan() types1.Plan {
 func init() {
 	proto.RegisterType((*QueryGasPricesRequest)(nil), "em.authority.v1.QueryGasPricesRequest")
 	proto.RegisterType((*QueryGasPricesResponse)(nil), "em.authority.v1.QueryGasPricesResponse")
+	proto.RegisterType((*QueryMessageGasPricesRequest)(nil), "em.authority.v1.QueryMessageGasPricesRequest")
+	proto.RegisterType((*QueryMessageGasPricesResponse)(nil), "em.authority.v1.QueryMessageGasPricesResponse")
+	proto.RegisterType((*QueryFeeConversionRequest)(nil), "em.authority.v1.QueryFeeConversionRequest")
+	proto.RegisterType((*QueryFeeConversionResponse)(nil), "em.authority.v1.QueryFeeConversionResponse")
 	proto.RegisterType((*QueryUpgradePlanRequest)(nil), "em.authority.v1.QueryUpgradePlanRequest")
 	proto.RegisterType((*QueryUpgradePlanResponse)(nil), "em.authority.v1.QueryUpgradePlanResponse")
 }
@@ -202,38 +366,48 @@ func init() {
 func init() { proto.RegisterFile("em/authority/v1/query.proto", fileDescriptor_d766145e8bc7b365) }
 
 var fileDescriptor_d766145e8bc7b365 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x95, 0x82, 0xc4, 0x05, 0x81, 0x64, 0x28, 0x0d, 0xa1, 0xb2, 0x8b, 0x55, 0xd1, 0x02,
-	0xca, 0x9d, 0x52, 0xb6, 0x8e, 0x01, 0x04, 0x03, 0x43, 0x89, 0xc4, 0xc2, 0x12, 0x5d, 0x9c, 0x27,
-	0xf7, 0x54, 0xdf, 0x9d, 0xeb, 0x3b, 0x47, 0x78, 0x45, 0x62, 0x45, 0x95, 0x58, 0x18, 0x3b, 0xb3,
-	0xf1, 0x2d, 0x3a, 0x56, 0x62, 0x61, 0x2a, 0x28, 0xe1, 0x13, 0xf0, 0x09, 0x90, 0xed, 0x73, 0x48,
-	0x93, 0x48, 0x74, 0x4a, 0x4e, 0xbf, 0xf7, 0x7e, 0xff, 0xfc, 0xf0, 0x7d, 0x10, 0x94, 0x65, 0xe6,
-	0x40, 0xa5, 0xdc, 0xe4, 0x74, 0xd4, 0xa1, 0x47, 0x19, 0xa4, 0x39, 0x49, 0x52, 0x65, 0x94, 0x7b,
-	0x0b, 0x04, 0x99, 0x82, 0x64, 0xd4, 0x69, 0xdd, 0x89, 0x54, 0xa4, 0x4a, 0x8c, 0x16, 0xff, 0xaa,
-	0xb1, 0x96, 0x17, 0x2a, 0x2d, 0x94, 0xa6, 0x03, 0xa6, 0x81, 0x8e, 0x3a, 0x03, 0x30, 0xac, 0x43,
-	0x43, 0xc5, 0xa5, 0xc5, 0x37, 0x22, 0xa5, 0xa2, 0x18, 0x28, 0x4b, 0x38, 0x65, 0x52, 0x2a, 0xc3,
-	0x0c, 0x57, 0x52, 0x5b, 0x74, 0xcb, 0x6e, 0x67, 0x49, 0x94, 0xb2, 0xe1, 0x3f, 0x02, 0xfb, 0x5e,
-	0xd0, 0x90, 0x87, 0xd3, 0x91, 0xe2, 0x51, 0xe1, 0xc1, 0x3a, 0x5e, 0x7b, 0x53, 0x38, 0x7f, 0xc9,
-	0xf4, 0x7e, 0xca, 0x43, 0xd0, 0x3d, 0x38, 0xca, 0x40, 0x9b, 0xe0, 0x1b, 0xc2, 0x77, 0xe7, 0x11,
-	0x9d, 0x28, 0xa9, 0xc1, 0x3d, 0x46, 0xf8, 0xa6, 0xe0, 0xb2, 0x1f, 0x31, 0xdd, 0x4f, 0x4a, 0xa8,
-	0x89, 0x36, 0xaf, 0xec, 0x34, 0x76, 0x37, 0x48, 0xa5, 0x46, 0x8a, 0x44, 0xc4, 0xaa, 0x91, 0xe7,
-	0x10, 0x3e, 0x53, 0x5c, 0x76, 0x5f, 0x9f, 0x9e, 0xfb, 0xce, 0x9f, 0x73, 0x7f, 0x2d, 0x67, 0x22,
-	0xde, 0x0b, 0x2e, 0x32, 0x04, 0x5f, 0x7f, 0xfa, 0x4f, 0x22, 0x6e, 0x0e, 0xb2, 0x01, 0x09, 0x95,
-	0xa0, 0xd6, 0x76, 0xf5, 0xd3, 0xd6, 0xc3, 0x43, 0x6a, 0xf2, 0x04, 0x74, 0x4d, 0xa6, 0x7b, 0x37,
-	0x04, 0x97, 0x53, 0x6b, 0x7b, 0xab, 0x5f, 0x4e, 0x7c, 0x27, 0xb8, 0x87, 0xd7, 0x4b, 0xcb, 0x6f,
-	0xab, 0x0a, 0xf6, 0x63, 0x26, 0xeb, 0x38, 0x0c, 0x37, 0x17, 0x21, 0x9b, 0xe7, 0x05, 0x5e, 0x4d,
-	0x62, 0x26, 0x9b, 0x68, 0x13, 0xcd, 0x86, 0xa8, 0x8b, 0xac, 0x73, 0x14, 0x3b, 0xdd, 0xdb, 0x36,
-	0x44, 0xa3, 0x0a, 0x51, 0xec, 0x05, 0xbd, 0x72, 0x7d, 0xf7, 0x64, 0x05, 0x5f, 0x2d, 0x35, 0xdc,
-	0x8f, 0x08, 0x5f, 0x9f, 0x7a, 0x73, 0x1f, 0x92, 0xb9, 0x73, 0x20, 0x4b, 0x1b, 0x6f, 0x6d, 0xff,
-	0x77, 0xae, 0xf2, 0x1b, 0x6c, 0x7f, 0xf8, 0xfe, 0xfb, 0xf3, 0xca, 0x03, 0xd7, 0xa7, 0xd0, 0x16,
-	0x4a, 0x42, 0x7e, 0xf1, 0x12, 0x23, 0xa6, 0xab, 0x4e, 0xdd, 0x4f, 0x08, 0x37, 0x66, 0x02, 0xbb,
-	0x3b, 0xcb, 0x15, 0x16, 0xeb, 0x6a, 0x3d, 0xba, 0xc4, 0xa4, 0x75, 0xf3, 0xb8, 0x74, 0xb3, 0xe5,
-	0x06, 0xcb, 0xdd, 0xd8, 0x16, 0xfb, 0x45, 0x45, 0xdd, 0x57, 0xa7, 0x63, 0x0f, 0x9d, 0x8d, 0x3d,
-	0xf4, 0x6b, 0xec, 0xa1, 0xe3, 0x89, 0xe7, 0x9c, 0x4d, 0x3c, 0xe7, 0xc7, 0xc4, 0x73, 0xde, 0x91,
-	0x99, 0x6f, 0x5f, 0xf3, 0x80, 0x68, 0xc7, 0x30, 0x8c, 0x20, 0xa5, 0xef, 0x67, 0x38, 0xcb, 0x3b,
-	0x18, 0x5c, 0x2b, 0xcf, 0xf7, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0x9c, 0xc9, 0x98,
-	0x88, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x3b, 0x8a, 0x26, 0x4e, 0x05, 0xc9, 0x28, 0x02, 0x0b, 0x6c, 0x61, 0x43, 0xa4, 0x16,
+	0xbb, 0x63, 0xeb, 0x8d, 0x63, 0xf1, 0xdf, 0x41, 0x13, 0x6c, 0xe2, 0xc5, 0x4b, 0x33, 0x6d, 0x5f,
+	0x96, 0x0d, 0xdd, 0x99, 0x65, 0x67, 0xdb, 0xd8, 0x2b, 0x89, 0x57, 0x43, 0xc2, 0xc5, 0x83, 0x07,
+	0x13, 0x6f, 0xde, 0xfc, 0x16, 0x1c, 0x49, 0xbc, 0x78, 0x42, 0x03, 0xfa, 0x05, 0xfc, 0x04, 0x66,
+	0x67, 0xa7, 0x4b, 0xdb, 0x5d, 0x02, 0xa7, 0x76, 0xf3, 0xbc, 0x7f, 0x7e, 0x4f, 0xdf, 0xa7, 0x8b,
+	0x17, 0xc0, 0xa3, 0xac, 0x1b, 0xee, 0x88, 0xc0, 0x0d, 0xfb, 0xb4, 0x57, 0xa1, 0x7b, 0x5d, 0x08,
+	0xfa, 0xb6, 0x1f, 0x88, 0x50, 0x90, 0x3b, 0xe0, 0xd9, 0x89, 0x68, 0xf7, 0x2a, 0xc6, 0x3d, 0x47,
+	0x38, 0x42, 0x69, 0x34, 0xfa, 0x16, 0x97, 0x19, 0x66, 0x4b, 0x48, 0x4f, 0x48, 0xda, 0x64, 0x12,
+	0x68, 0xaf, 0xd2, 0x84, 0x90, 0x55, 0x68, 0x4b, 0xb8, 0x5c, 0xeb, 0x8b, 0x8e, 0x10, 0x4e, 0x07,
+	0x28, 0xf3, 0x5d, 0xca, 0x38, 0x17, 0x21, 0x0b, 0x5d, 0xc1, 0xa5, 0x56, 0x57, 0x75, 0x77, 0xd7,
+	0x77, 0x02, 0xd6, 0x3e, 0x1f, 0xa0, 0x9f, 0x53, 0x3b, 0xf8, 0x6e, 0x52, 0x12, 0x3d, 0x68, 0xbd,
+	0x30, 0xee, 0xe3, 0x9c, 0x5b, 0x15, 0x58, 0xb3, 0x78, 0xe6, 0x4d, 0x64, 0xed, 0x05, 0x93, 0x5b,
+	0x81, 0xdb, 0x02, 0x59, 0x87, 0xbd, 0x2e, 0xc8, 0xd0, 0xfa, 0x8e, 0xf0, 0xfd, 0x71, 0x45, 0xfa,
+	0x82, 0x4b, 0x20, 0x07, 0x08, 0x4f, 0x79, 0x2e, 0x6f, 0x38, 0x4c, 0x36, 0x7c, 0x25, 0xcd, 0xa1,
+	0xe5, 0xeb, 0xc5, 0x7c, 0x75, 0xd1, 0x8e, 0x71, 0xec, 0xc8, 0xb2, 0xad, 0x71, 0xec, 0xa7, 0xd0,
+	0xda, 0x14, 0x2e, 0xaf, 0xbd, 0x3a, 0x3a, 0x29, 0xe4, 0xfe, 0x9d, 0x14, 0x66, 0xfa, 0xcc, 0xeb,
+	0x6c, 0x58, 0xa3, 0x13, 0xac, 0x6f, 0xbf, 0x0a, 0xeb, 0x8e, 0x1b, 0xee, 0x74, 0x9b, 0x76, 0x4b,
+	0x78, 0x54, 0xfb, 0x8a, 0x3f, 0xca, 0xb2, 0xbd, 0x4b, 0xc3, 0xbe, 0x0f, 0x72, 0x30, 0x4c, 0xd6,
+	0x6f, 0x7b, 0x2e, 0x4f, 0xd0, 0x36, 0x26, 0x3e, 0x7d, 0x29, 0xe4, 0x2c, 0x13, 0x2f, 0x2a, 0xe4,
+	0xd7, 0x20, 0x25, 0x73, 0x20, 0xe5, 0xe9, 0x10, 0xe1, 0xa5, 0x0b, 0x0a, 0xb4, 0xb5, 0x00, 0x13,
+	0x2f, 0xd6, 0xd2, 0xee, 0x56, 0xec, 0xb1, 0xbb, 0xdb, 0xe3, 0x63, 0x6a, 0x2b, 0xda, 0xe2, 0xbc,
+	0xb6, 0x98, 0x1a, 0x65, 0xd5, 0xa7, 0xbd, 0xb1, 0x26, 0x6b, 0x01, 0xcf, 0x2b, 0xa8, 0xe7, 0x00,
+	0x9b, 0x82, 0xf7, 0x20, 0x90, 0xae, 0xe0, 0x03, 0xe4, 0x7d, 0x84, 0x8d, 0x2c, 0x55, 0xf3, 0xb6,
+	0xf1, 0xd4, 0x36, 0x40, 0xa3, 0x95, 0x28, 0x73, 0x68, 0x19, 0x15, 0xf3, 0x55, 0x33, 0xc5, 0x3a,
+	0xd2, 0x5f, 0x5b, 0x1a, 0xbd, 0xc5, 0xe8, 0x0c, 0xab, 0x3e, 0xb9, 0x3d, 0x5c, 0x6d, 0xcd, 0xe3,
+	0x59, 0xc5, 0xf0, 0x36, 0xce, 0xde, 0x56, 0x87, 0x25, 0x7c, 0x0c, 0xcf, 0xa5, 0x25, 0x0d, 0xf7,
+	0x0c, 0x4f, 0xf8, 0x1d, 0x36, 0x40, 0x4a, 0xc2, 0x31, 0x48, 0xf0, 0x20, 0x1f, 0x51, 0x4f, 0xed,
+	0xae, 0x06, 0xca, 0xc7, 0x40, 0x51, 0x9f, 0x55, 0x57, 0xed, 0xd5, 0xbf, 0x13, 0xf8, 0x86, 0xda,
+	0x41, 0x3e, 0x20, 0x7c, 0x2b, 0xf9, 0xdd, 0xc8, 0x83, 0x94, 0xc7, 0xcc, 0x24, 0x1b, 0x6b, 0x97,
+	0xd6, 0xc5, 0xbc, 0xd6, 0xda, 0xfe, 0x8f, 0x3f, 0x87, 0xd7, 0x56, 0x48, 0x81, 0x42, 0xd9, 0x13,
+	0x1c, 0xfa, 0xa3, 0x7f, 0x1d, 0x87, 0xc9, 0xf8, 0x88, 0xe4, 0x2b, 0xc2, 0xd3, 0xe3, 0xb7, 0x27,
+	0xe5, 0xec, 0x35, 0x17, 0x64, 0xd1, 0xb0, 0xaf, 0x5a, 0xae, 0xe1, 0x1e, 0x2b, 0xb8, 0x12, 0x29,
+	0x5e, 0x02, 0x47, 0x75, 0xbe, 0x24, 0xf9, 0x8c, 0xf0, 0xe4, 0xc8, 0xd5, 0x49, 0x29, 0x7b, 0x67,
+	0x56, 0xf0, 0x8c, 0xf5, 0x2b, 0xd5, 0x6a, 0xb8, 0xaa, 0x82, 0x7b, 0x44, 0x4a, 0x97, 0xc1, 0x9d,
+	0x87, 0x8c, 0x7c, 0x44, 0x38, 0x3f, 0x94, 0x1a, 0x52, 0xcc, 0x5e, 0x98, 0xce, 0x9c, 0xf1, 0xf0,
+	0x0a, 0x95, 0x1a, 0xac, 0xa4, 0xc0, 0x56, 0x89, 0x95, 0x0d, 0xa6, 0xa3, 0xd8, 0x88, 0x72, 0x56,
+	0x7b, 0x79, 0x74, 0x6a, 0xa2, 0xe3, 0x53, 0x13, 0xfd, 0x3e, 0x35, 0xd1, 0xc1, 0x99, 0x99, 0x3b,
+	0x3e, 0x33, 0x73, 0x3f, 0xcf, 0xcc, 0xdc, 0x3b, 0x7b, 0xe8, 0xc5, 0x34, 0x98, 0x03, 0x5e, 0xb9,
+	0x03, 0x6d, 0x07, 0x02, 0xfa, 0x7e, 0x68, 0xa6, 0x7a, 0x49, 0x35, 0x6f, 0xaa, 0x77, 0xeb, 0x93,
+	0xff, 0x03, 0x00, 0xfb, 0xaf, 0x3c, 0x0e, 0x46, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -249,6 +423,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
+	MessageGasPrices(ctx context.Context, in *QueryMessageGasPricesRequest, opts ...grpc.CallOption) (*QueryMessageGasPricesResponse, error)
+	FeeConversion(ctx context.Context, in *QueryFeeConversionRequest, opts ...grpc.CallOption) (*QueryFeeConversionResponse, error)
 	UpgradePlan(ctx context.Context, in *QueryUpgradePlanRequest, opts ...grpc.CallOption) (*QueryUpgradePlanResponse, error)
 }
 
@@ -269,6 +445,24 @@ func (c *queryClient) GasPrices(ctx context.Context, in *QueryGasPricesRequest,
 	return out, nil
 }
 
+func (c *queryClient) MessageGasPrices(ctx context.Context, in *QueryMessageGasPricesRequest, opts ...grpc.CallOption) (*QueryMessageGasPricesResponse, error) {
+	out := new(QueryMessageGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Query/MessageGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeConversion(ctx context.Context, in *QueryFeeConversionRequest, opts ...grpc.CallOption) (*QueryFeeConversionResponse, error) {
+	out := new(QueryFeeConversionResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Query/FeeConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) UpgradePlan(ctx context.Context, in *QueryUpgradePlanRequest, opts ...grpc.CallOption) (*QueryUpgradePlanResponse, error) {
 	out := new(QueryUpgradePlanResponse)
 	err := c.cc.Invoke(ctx, "/em.authority.v1.Query/UpgradePlan", in, out, opts...)
@@ -281,6 +475,8 @@ func (c *queryClient) UpgradePlan(ctx context.Context, in *QueryUpgradePlanReque
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
+	MessageGasPrices(context.Context, *QueryMessageGasPricesRequest) (*QueryMessageGasPricesResponse, error)
+	FeeConversion(context.Context, *QueryFeeConversionRequest) (*QueryFeeConversionResponse, error)
 	UpgradePlan(context.Context, *QueryUpgradePlanRequest) (*QueryUpgradePlanResponse, error)
 }
 
@@ -291,6 +487,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPricesRequest) (*QueryGasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (*UnimplementedQueryServer) MessageGasPrices(ctx context.Context, req *QueryMessageGasPricesRequest) (*QueryMessageGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) FeeConversion(ctx context.Context, req *QueryFeeConversionRequest) (*QueryFeeConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeConversion not implemented")
+}
 func (*UnimplementedQueryServer) UpgradePlan(ctx context.Context, req *QueryUpgradePlanRequest) (*QueryUpgradePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlan not implemented")
 }
@@ -317,6 +519,42 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Query/MessageGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageGasPrices(ctx, req.(*QueryMessageGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Query/FeeConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeConversion(ctx, req.(*QueryFeeConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_UpgradePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUpgradePlanRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +581,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "MessageGasPrices",
+			Handler:    _Query_MessageGasPrices_Handler,
+		},
+		{
+			MethodName: "FeeConversion",
+			Handler:    _Query_FeeConversion_Handler,
+		},
 		{
 			MethodName: "UpgradePlan",
 			Handler:    _Query_UpgradePlan_Handler,
@@ -412,6 +658,122 @@ func (m *QueryGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMessageGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessageGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessageGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessageGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageGasPrices) > 0 {
+		for iNdEx := len(m.MessageGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeConversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryUpgradePlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -503,7 +865,7 @@ func (m *QueryGasPricesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryUpgradePlanRequest) Size() (n int) {
+func (m *QueryMessageGasPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -512,24 +874,68 @@ func (m *QueryUpgradePlanRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryUpgradePlanResponse) Size() (n int) {
+func (m *QueryMessageGasPricesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Plan.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MessageGasPrices) > 0 {
+		for _, e := range m.MessageGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryGasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeConversion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUpgradePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -663,6 +1069,273 @@ func (m *QueryGasPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMessageGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageGasPrices = append(m.MessageGasPrices, MessageGasPrices{})
+			if err := m.MessageGasPrices[len(m.MessageGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryUpgradePlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,22 +9,27 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
-	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	types1 "github.com/e-money/em-ledger/x/inflation/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -279,23 +284,30 @@ func (m *MsgDestroyIssuerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDestroyIssuerResponse proto.InternalMessageInfo
 
-type MsgSetGasPrices struct {
-	Authority string                                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
-}
-
-func (m *MsgSetGasPrices) Reset()         { *m = MsgSetGasPrices{} }
-func (m *MsgSetGasPrices) String() string { return proto.CompactTextString(m) }
-func (*MsgSetGasPrices) ProtoMessage()    {}
-func (*MsgSetGasPrices) Descriptor() ([]byte, []int) {
+// MsgTransferDenom moves a denomination from one issuer to another. Supply,
+// inflation and metadata of the denomination are kept.
+type MsgTransferDenom struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	FromIssuer string `protobuf:"bytes,3,opt,name=from_issuer,json=fromIssuer,proto3" json:"from_issuer,omitempty" yaml:"from_issuer"`
+	ToIssuer   string `protobuf:"bytes,4,opt,name=to_issuer,json=toIssuer,proto3" json:"to_issuer,omitempty" yaml:"to_issuer"`
+	// When set, the mintable amounts of the denomination are revoked from all
+	// liquidity providers. Otherwise they are kept under the new issuer.
+	RevokeLiquidityProviders bool `protobuf:"varint,5,opt,name=revoke_liquidity_providers,json=revokeLiquidityProviders,proto3" json:"revoke_liquidity_providers,omitempty" yaml:"revoke_liquidity_providers"`
+}
+
+func (m *MsgTransferDenom) Reset()         { *m = MsgTransferDenom{} }
+func (m *MsgTransferDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDenom) ProtoMessage()    {}
+func (*MsgTransferDenom) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{5}
 }
-func (m *MsgSetGasPrices) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetGasPrices.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferDenom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -305,47 +317,68 @@ func (m *MsgSetGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgSetGasPrices) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetGasPrices.Merge(m, src)
+func (m *MsgTransferDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDenom.Merge(m, src)
 }
-func (m *MsgSetGasPrices) XXX_Size() int {
+func (m *MsgTransferDenom) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetGasPrices) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetGasPrices.DiscardUnknown(m)
+func (m *MsgTransferDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDenom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetGasPrices proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferDenom proto.InternalMessageInfo
 
-func (m *MsgSetGasPrices) GetAuthority() string {
+func (m *MsgTransferDenom) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgSetGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *MsgTransferDenom) GetDenom() string {
 	if m != nil {
-		return m.GasPrices
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-type MsgSetGasPricesResponse struct {
+func (m *MsgTransferDenom) GetFromIssuer() string {
+	if m != nil {
+		return m.FromIssuer
+	}
+	return ""
 }
 
-func (m *MsgSetGasPricesResponse) Reset()         { *m = MsgSetGasPricesResponse{} }
-func (m *MsgSetGasPricesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetGasPricesResponse) ProtoMessage()    {}
-func (*MsgSetGasPricesResponse) Descriptor() ([]byte, []int) {
+func (m *MsgTransferDenom) GetToIssuer() string {
+	if m != nil {
+		return m.ToIssuer
+	}
+	return ""
+}
+
+func (m *MsgTransferDenom) GetRevokeLiquidityProviders() bool {
+	if m != nil {
+		return m.RevokeLiquidityProviders
+	}
+	return false
+}
+
+type MsgTransferDenomResponse struct {
+}
+
+func (m *MsgTransferDenomResponse) Reset()         { *m = MsgTransferDenomResponse{} }
+func (m *MsgTransferDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDenomResponse) ProtoMessage()    {}
+func (*MsgTransferDenomResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{6}
 }
-func (m *MsgSetGasPricesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetGasPricesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferDenomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -355,35 +388,37 @@ func (m *MsgSetGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgSetGasPricesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetGasPricesResponse.Merge(m, src)
+func (m *MsgTransferDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDenomResponse.Merge(m, src)
 }
-func (m *MsgSetGasPricesResponse) XXX_Size() int {
+func (m *MsgTransferDenomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetGasPricesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetGasPricesResponse.DiscardUnknown(m)
+func (m *MsgTransferDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDenomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetGasPricesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferDenomResponse proto.InternalMessageInfo
 
-type MsgReplaceAuthority struct {
-	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	NewAuthority string `protobuf:"bytes,2,opt,name=new_authority,json=newAuthority,proto3" json:"new_authority,omitempty" yaml:"new_authority"`
+// MsgSetSupplyCap sets a hard cap on the supply plus outstanding mintable
+// amounts of a denomination. A zero amount removes the cap.
+type MsgSetSupplyCap struct {
+	Authority string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Cap       types.Coin `protobuf:"bytes,2,opt,name=cap,proto3" json:"cap" yaml:"cap"`
 }
 
-func (m *MsgReplaceAuthority) Reset()         { *m = MsgReplaceAuthority{} }
-func (m *MsgReplaceAuthority) String() string { return proto.CompactTextString(m) }
-func (*MsgReplaceAuthority) ProtoMessage()    {}
-func (*MsgReplaceAuthority) Descriptor() ([]byte, []int) {
+func (m *MsgSetSupplyCap) Reset()         { *m = MsgSetSupplyCap{} }
+func (m *MsgSetSupplyCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSupplyCap) ProtoMessage()    {}
+func (*MsgSetSupplyCap) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{7}
 }
-func (m *MsgReplaceAuthority) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSupplyCap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReplaceAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSupplyCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReplaceAuthority.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSupplyCap.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -393,48 +428,47 @@ func (m *MsgReplaceAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgReplaceAuthority) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReplaceAuthority.Merge(m, src)
+func (m *MsgSetSupplyCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupplyCap.Merge(m, src)
 }
-func (m *MsgReplaceAuthority) XXX_Size() int {
+func (m *MsgSetSupplyCap) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReplaceAuthority) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReplaceAuthority.DiscardUnknown(m)
+func (m *MsgSetSupplyCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupplyCap.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReplaceAuthority proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSupplyCap proto.InternalMessageInfo
 
-func (m *MsgReplaceAuthority) GetAuthority() string {
+func (m *MsgSetSupplyCap) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgReplaceAuthority) GetNewAuthority() string {
+func (m *MsgSetSupplyCap) GetCap() types.Coin {
 	if m != nil {
-		return m.NewAuthority
+		return m.Cap
 	}
-	return ""
+	return types.Coin{}
 }
 
-type MsgReplaceAuthorityResponse struct {
-	NewAuthorityAddress string `protobuf:"bytes,1,opt,name=new_authority_address,json=newAuthorityAddress,proto3" json:"new_authority_address,omitempty" yaml:"new_authority_address"`
+type MsgSetSupplyCapResponse struct {
 }
 
-func (m *MsgReplaceAuthorityResponse) Reset()         { *m = MsgReplaceAuthorityResponse{} }
-func (m *MsgReplaceAuthorityResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgReplaceAuthorityResponse) ProtoMessage()    {}
-func (*MsgReplaceAuthorityResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetSupplyCapResponse) Reset()         { *m = MsgSetSupplyCapResponse{} }
+func (m *MsgSetSupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSupplyCapResponse) ProtoMessage()    {}
+func (*MsgSetSupplyCapResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{8}
 }
-func (m *MsgReplaceAuthorityResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSupplyCapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReplaceAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReplaceAuthorityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSupplyCapResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -444,42 +478,38 @@ func (m *MsgReplaceAuthorityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgReplaceAuthorityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReplaceAuthorityResponse.Merge(m, src)
+func (m *MsgSetSupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupplyCapResponse.Merge(m, src)
 }
-func (m *MsgReplaceAuthorityResponse) XXX_Size() int {
+func (m *MsgSetSupplyCapResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReplaceAuthorityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReplaceAuthorityResponse.DiscardUnknown(m)
+func (m *MsgSetSupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupplyCapResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReplaceAuthorityResponse proto.InternalMessageInfo
-
-func (m *MsgReplaceAuthorityResponse) GetNewAuthorityAddress() string {
-	if m != nil {
-		return m.NewAuthorityAddress
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetSupplyCapResponse proto.InternalMessageInfo
 
-type MsgScheduleUpgrade struct {
-	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	Plan      types1.Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan" yaml:"plan"`
+// MsgSetInflationDistribution replaces the recipients of the tokens minted by
+// inflation of a denomination. Empty targets restore the default destination.
+type MsgSetInflationDistribution struct {
+	Authority string                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Denom     string                      `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Targets   []types1.DistributionTarget `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets" yaml:"targets"`
 }
 
-func (m *MsgScheduleUpgrade) Reset()         { *m = MsgScheduleUpgrade{} }
-func (m *MsgScheduleUpgrade) String() string { return proto.CompactTextString(m) }
-func (*MsgScheduleUpgrade) ProtoMessage()    {}
-func (*MsgScheduleUpgrade) Descriptor() ([]byte, []int) {
+func (m *MsgSetInflationDistribution) Reset()         { *m = MsgSetInflationDistribution{} }
+func (m *MsgSetInflationDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInflationDistribution) ProtoMessage()    {}
+func (*MsgSetInflationDistribution) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{9}
 }
-func (m *MsgScheduleUpgrade) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetInflationDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgScheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetInflationDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgScheduleUpgrade.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetInflationDistribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -489,47 +519,54 @@ func (m *MsgScheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgScheduleUpgrade) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgScheduleUpgrade.Merge(m, src)
+func (m *MsgSetInflationDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInflationDistribution.Merge(m, src)
 }
-func (m *MsgScheduleUpgrade) XXX_Size() int {
+func (m *MsgSetInflationDistribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgScheduleUpgrade) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgScheduleUpgrade.DiscardUnknown(m)
+func (m *MsgSetInflationDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInflationDistribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgScheduleUpgrade proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetInflationDistribution proto.InternalMessageInfo
 
-func (m *MsgScheduleUpgrade) GetAuthority() string {
+func (m *MsgSetInflationDistribution) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgScheduleUpgrade) GetPlan() types1.Plan {
+func (m *MsgSetInflationDistribution) GetDenom() string {
 	if m != nil {
-		return m.Plan
+		return m.Denom
 	}
-	return types1.Plan{}
+	return ""
 }
 
-type MsgScheduleUpgradeResponse struct {
+func (m *MsgSetInflationDistribution) GetTargets() []types1.DistributionTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
 }
 
-func (m *MsgScheduleUpgradeResponse) Reset()         { *m = MsgScheduleUpgradeResponse{} }
-func (m *MsgScheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgScheduleUpgradeResponse) ProtoMessage()    {}
-func (*MsgScheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+type MsgSetInflationDistributionResponse struct {
+}
+
+func (m *MsgSetInflationDistributionResponse) Reset()         { *m = MsgSetInflationDistributionResponse{} }
+func (m *MsgSetInflationDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInflationDistributionResponse) ProtoMessage()    {}
+func (*MsgSetInflationDistributionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{10}
 }
-func (m *MsgScheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetInflationDistributionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgScheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetInflationDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgScheduleUpgradeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetInflationDistributionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -539,35 +576,35 @@ func (m *MsgScheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgScheduleUpgradeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgScheduleUpgradeResponse.Merge(m, src)
+func (m *MsgSetInflationDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInflationDistributionResponse.Merge(m, src)
 }
-func (m *MsgScheduleUpgradeResponse) XXX_Size() int {
+func (m *MsgSetInflationDistributionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgScheduleUpgradeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgScheduleUpgradeResponse.DiscardUnknown(m)
+func (m *MsgSetInflationDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInflationDistributionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgScheduleUpgradeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetInflationDistributionResponse proto.InternalMessageInfo
 
-type MsgSetParameters struct {
-	Authority string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	Changes   []proposal.ParamChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+type MsgSetGasPrices struct {
+	Authority string                                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
 }
 
-func (m *MsgSetParameters) Reset()         { *m = MsgSetParameters{} }
-func (m *MsgSetParameters) String() string { return proto.CompactTextString(m) }
-func (*MsgSetParameters) ProtoMessage()    {}
-func (*MsgSetParameters) Descriptor() ([]byte, []int) {
+func (m *MsgSetGasPrices) Reset()         { *m = MsgSetGasPrices{} }
+func (m *MsgSetGasPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasPrices) ProtoMessage()    {}
+func (*MsgSetGasPrices) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{11}
 }
-func (m *MsgSetParameters) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetGasPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetParameters.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetGasPrices.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -577,47 +614,47 @@ func (m *MsgSetParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSetParameters) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetParameters.Merge(m, src)
+func (m *MsgSetGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasPrices.Merge(m, src)
 }
-func (m *MsgSetParameters) XXX_Size() int {
+func (m *MsgSetGasPrices) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetParameters) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetParameters.DiscardUnknown(m)
+func (m *MsgSetGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasPrices.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetParameters proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetGasPrices proto.InternalMessageInfo
 
-func (m *MsgSetParameters) GetAuthority() string {
+func (m *MsgSetGasPrices) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgSetParameters) GetChanges() []proposal.ParamChange {
+func (m *MsgSetGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.Changes
+		return m.GasPrices
 	}
 	return nil
 }
 
-type MsgSetParametersResponse struct {
+type MsgSetGasPricesResponse struct {
 }
 
-func (m *MsgSetParametersResponse) Reset()         { *m = MsgSetParametersResponse{} }
-func (m *MsgSetParametersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetParametersResponse) ProtoMessage()    {}
-func (*MsgSetParametersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetGasPricesResponse) Reset()         { *m = MsgSetGasPricesResponse{} }
+func (m *MsgSetGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasPricesResponse) ProtoMessage()    {}
+func (*MsgSetGasPricesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1601f633ca5d263c, []int{12}
 }
-func (m *MsgSetParametersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetGasPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetParametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetParametersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetGasPricesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -627,998 +664,3126 @@ func (m *MsgSetParametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSetParametersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetParametersResponse.Merge(m, src)
+func (m *MsgSetGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasPricesResponse.Merge(m, src)
 }
-func (m *MsgSetParametersResponse) XXX_Size() int {
+func (m *MsgSetGasPricesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetParametersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetParametersResponse.DiscardUnknown(m)
+func (m *MsgSetGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasPricesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetParametersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetGasPricesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateIssuer)(nil), "em.authority.v1.MsgCreateIssuer")
-	proto.RegisterType((*Denomination)(nil), "em.authority.v1.Denomination")
-	proto.RegisterType((*MsgCreateIssuerResponse)(nil), "em.authority.v1.MsgCreateIssuerResponse")
-	proto.RegisterType((*MsgDestroyIssuer)(nil), "em.authority.v1.MsgDestroyIssuer")
-	proto.RegisterType((*MsgDestroyIssuerResponse)(nil), "em.authority.v1.MsgDestroyIssuerResponse")
-	proto.RegisterType((*MsgSetGasPrices)(nil), "em.authority.v1.MsgSetGasPrices")
-	proto.RegisterType((*MsgSetGasPricesResponse)(nil), "em.authority.v1.MsgSetGasPricesResponse")
-	proto.RegisterType((*MsgReplaceAuthority)(nil), "em.authority.v1.MsgReplaceAuthority")
-	proto.RegisterType((*MsgReplaceAuthorityResponse)(nil), "em.authority.v1.MsgReplaceAuthorityResponse")
-	proto.RegisterType((*MsgScheduleUpgrade)(nil), "em.authority.v1.MsgScheduleUpgrade")
-	proto.RegisterType((*MsgScheduleUpgradeResponse)(nil), "em.authority.v1.MsgScheduleUpgradeResponse")
-	proto.RegisterType((*MsgSetParameters)(nil), "em.authority.v1.MsgSetParameters")
-	proto.RegisterType((*MsgSetParametersResponse)(nil), "em.authority.v1.MsgSetParametersResponse")
+// MsgSetMessageGasPrices sets the minimum gas prices of a single message type,
+// overriding the chain-wide minimum. Empty gas prices remove the override.
+type MsgSetMessageGasPrices struct {
+	Authority string                                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	TypeUrl   string                                      `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
 }
 
-func init() { proto.RegisterFile("em/authority/v1/tx.proto", fileDescriptor_1601f633ca5d263c) }
-
-var fileDescriptor_1601f633ca5d263c = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0x8e, 0x49, 0x04, 0x62, 0x49, 0x04, 0x18, 0xaa, 0xba, 0x6e, 0x9a, 0x84, 0x2d, 0x07, 0x10,
-	0xc5, 0x56, 0xe8, 0xad, 0x52, 0x0f, 0x04, 0xaa, 0xd2, 0x43, 0x24, 0x64, 0xda, 0x0b, 0x52, 0x8b,
-	0x36, 0xf6, 0xd4, 0xb1, 0x1a, 0xff, 0xd4, 0xeb, 0x00, 0x79, 0x80, 0x4a, 0x55, 0x2f, 0xed, 0x73,
-	0xf4, 0x49, 0xb8, 0x54, 0x42, 0xea, 0xa5, 0xa7, 0xb4, 0x82, 0x37, 0xc8, 0x13, 0x54, 0xf6, 0xae,
-	0x1d, 0x3b, 0xb8, 0x02, 0xe5, 0xd0, 0x13, 0xde, 0x9d, 0xef, 0x9b, 0xfd, 0x76, 0x66, 0xf6, 0x23,
-	0x48, 0x02, 0x5b, 0x25, 0xfd, 0xa0, 0xeb, 0xfa, 0x56, 0x30, 0x50, 0x4f, 0x9b, 0x6a, 0x70, 0xae,
-	0x78, 0xbe, 0x1b, 0xb8, 0xe2, 0x22, 0xd8, 0x4a, 0x12, 0x51, 0x4e, 0x9b, 0xf2, 0xaa, 0xe9, 0x9a,
-	0x6e, 0x14, 0x53, 0xc3, 0x2f, 0x06, 0x93, 0x6b, 0xba, 0x4b, 0x6d, 0x97, 0xaa, 0x1d, 0x42, 0x41,
-	0x3d, 0x6d, 0x76, 0x20, 0x20, 0x4d, 0x55, 0x77, 0x2d, 0x87, 0xc7, 0xd7, 0x79, 0xbc, 0xef, 0x99,
-	0x3e, 0x31, 0xc6, 0x10, 0xbe, 0xe6, 0x28, 0xcc, 0x51, 0x1e, 0xf1, 0x89, 0x4d, 0x13, 0x10, 0x5b,
-	0x32, 0x0c, 0xfe, 0x29, 0xa0, 0xc5, 0x36, 0x35, 0xf7, 0x7c, 0x20, 0x01, 0xbc, 0xa2, 0xb4, 0x0f,
-	0xbe, 0xb8, 0x83, 0xe6, 0x13, 0x8d, 0x92, 0xd0, 0x10, 0x36, 0xe6, 0x5b, 0xab, 0xa3, 0x61, 0x7d,
-	0x69, 0x40, 0xec, 0xde, 0x33, 0x9c, 0x84, 0xb0, 0x36, 0x86, 0x89, 0x9b, 0x68, 0xd6, 0x8a, 0xd8,
-	0xd2, 0x4c, 0x44, 0x58, 0x1e, 0x0d, 0xeb, 0x15, 0x46, 0x60, 0xfb, 0x58, 0xe3, 0x00, 0x91, 0xa0,
-	0x8a, 0x01, 0x8e, 0x6b, 0x5b, 0x0e, 0x09, 0x2c, 0xd7, 0xa1, 0x52, 0xb1, 0x51, 0xdc, 0x58, 0xd8,
-	0x79, 0xa4, 0x4c, 0xd4, 0x46, 0xd9, 0x4f, 0xa1, 0x5a, 0xd5, 0x8b, 0x61, 0xbd, 0x30, 0x1a, 0xd6,
-	0x57, 0x59, 0xd2, 0x4c, 0x06, 0xac, 0x65, 0x33, 0xe2, 0x77, 0xa8, 0x9c, 0x26, 0x8b, 0x22, 0x2a,
-	0x85, 0xa5, 0x64, 0x97, 0xd1, 0xa2, 0x6f, 0x51, 0x42, 0x73, 0x86, 0x45, 0xbd, 0x1e, 0x19, 0x30,
-	0xc9, 0x5a, 0xbc, 0x14, 0x1b, 0x68, 0xc1, 0x00, 0xaa, 0xfb, 0x96, 0x17, 0x92, 0xa5, 0x62, 0x14,
-	0x4d, 0x6f, 0xe1, 0x07, 0xe8, 0xfe, 0x44, 0xd1, 0x34, 0xa0, 0x9e, 0xeb, 0x50, 0xc0, 0x1f, 0xd1,
-	0x52, 0x9b, 0x9a, 0xfb, 0x40, 0x03, 0xdf, 0x1d, 0xfc, 0x97, 0x82, 0x62, 0x19, 0x49, 0x93, 0x47,
-	0x26, 0x72, 0x7e, 0xb0, 0xfe, 0x1e, 0x41, 0xf0, 0x92, 0xd0, 0x43, 0xdf, 0xd2, 0x81, 0x4e, 0x25,
-	0xe7, 0x93, 0x80, 0x90, 0x49, 0xe8, 0x89, 0x17, 0xa5, 0x90, 0x66, 0xa2, 0x96, 0x55, 0x15, 0x36,
-	0x61, 0x4a, 0x58, 0x50, 0x85, 0xcf, 0x97, 0xb2, 0x0f, 0xfa, 0x9e, 0x6b, 0x39, 0xad, 0x03, 0xde,
-	0xb1, 0x65, 0x96, 0x77, 0xcc, 0xc6, 0xdf, 0x7f, 0xd7, 0xb7, 0x4c, 0x2b, 0xe8, 0xf6, 0x3b, 0x8a,
-	0xee, 0xda, 0x2a, 0x1f, 0x53, 0xf6, 0x67, 0x9b, 0x1a, 0x1f, 0xd4, 0x60, 0xe0, 0x01, 0x8d, 0x13,
-	0x51, 0x6d, 0xde, 0x8c, 0xb5, 0xf3, 0xca, 0xa7, 0xaf, 0x93, 0x5c, 0xf5, 0xb3, 0x80, 0x56, 0xda,
-	0xd4, 0xd4, 0xc0, 0xeb, 0x11, 0x1d, 0x76, 0x13, 0xe9, 0xd3, 0x5c, 0xf7, 0x39, 0xaa, 0x38, 0x70,
-	0x76, 0x32, 0xe6, 0xb1, 0x26, 0x48, 0xe3, 0x01, 0xcc, 0x84, 0xb1, 0x56, 0x76, 0xe0, 0x2c, 0x39,
-	0x12, 0x53, 0xf4, 0x30, 0x47, 0x49, 0xac, 0x54, 0x7c, 0x8d, 0xee, 0x65, 0xe8, 0x27, 0xc4, 0x30,
-	0x7c, 0xa0, 0x94, 0xab, 0x6b, 0x8c, 0x86, 0xf5, 0x6a, 0xce, 0x29, 0x31, 0x0c, 0x6b, 0x2b, 0xe9,
-	0xd3, 0x76, 0xf9, 0xee, 0x57, 0x01, 0x89, 0x61, 0x6d, 0xf4, 0x2e, 0x18, 0xfd, 0x1e, 0xbc, 0x61,
-	0x5e, 0x30, 0xd5, 0xf5, 0x5f, 0xa0, 0x92, 0xd7, 0x23, 0x4e, 0x74, 0xeb, 0x54, 0x9b, 0x63, 0x7b,
-	0x89, 0x3b, 0x7d, 0xd8, 0x23, 0x4e, 0x6b, 0x85, 0xb7, 0x79, 0x81, 0x25, 0x0c, 0x79, 0x58, 0x8b,
-	0xe8, 0xb8, 0x8a, 0xe4, 0x9b, 0x82, 0x92, 0x7e, 0x7d, 0x11, 0xa2, 0xa7, 0x72, 0x04, 0xc1, 0x61,
-	0xe8, 0x48, 0x10, 0x80, 0x3f, 0xdd, 0x6c, 0xb6, 0xd0, 0x9c, 0xde, 0x25, 0x8e, 0x99, 0xcc, 0x25,
-	0x8e, 0x05, 0x73, 0xab, 0x4b, 0xf4, 0x86, 0xcb, 0xbd, 0x08, 0xda, 0x2a, 0x85, 0xb2, 0xb5, 0x98,
-	0xc8, 0xdf, 0x50, 0x46, 0x4b, 0x2c, 0x74, 0xe7, 0xa2, 0x84, 0x8a, 0x6d, 0x6a, 0x8a, 0xc7, 0xa8,
-	0x9c, 0xf1, 0xc9, 0xc6, 0x0d, 0xc7, 0x9a, 0x30, 0x05, 0x79, 0xe3, 0x36, 0x44, 0x32, 0x12, 0x6f,
-	0x51, 0x25, 0xeb, 0x19, 0x6b, 0x79, 0xd4, 0x0c, 0x44, 0xde, 0xbc, 0x15, 0x92, 0xa4, 0x3f, 0x46,
-	0xe5, 0x8c, 0x05, 0xe4, 0x4a, 0x4f, 0x23, 0xf2, 0xa5, 0xe7, 0xbd, 0x3b, 0xf1, 0x3d, 0x5a, 0xba,
-	0xf1, 0xe6, 0xd6, 0xf3, 0xd8, 0x93, 0x28, 0xf9, 0xc9, 0x5d, 0x50, 0xc9, 0x39, 0x3a, 0x5a, 0x9c,
-	0x9c, 0xed, 0xc7, 0xb9, 0x22, 0xb3, 0x20, 0x79, 0xeb, 0x0e, 0xa0, 0x74, 0x1f, 0xb2, 0x03, 0xb9,
-	0xf6, 0x8f, 0x3a, 0x8c, 0x21, 0xf9, 0x7d, 0xc8, 0x1d, 0xa5, 0xd6, 0xc1, 0xc5, 0x55, 0x4d, 0xb8,
-	0xbc, 0xaa, 0x09, 0x7f, 0xae, 0x6a, 0xc2, 0xb7, 0xeb, 0x5a, 0xe1, 0xf2, 0xba, 0x56, 0xf8, 0x75,
-	0x5d, 0x2b, 0x1c, 0x2b, 0x29, 0x43, 0x84, 0x6d, 0xdb, 0x75, 0x60, 0xa0, 0x82, 0xbd, 0xdd, 0x03,
-	0xc3, 0x04, 0x5f, 0x3d, 0x4f, 0xfd, 0x9e, 0x88, 0xcc, 0xb1, 0x33, 0x1b, 0xfd, 0xff, 0x7e, 0xfa,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xb4, 0x26, 0x2c, 0x6c, 0x08, 0x00, 0x00,
+func (m *MsgSetMessageGasPrices) Reset()         { *m = MsgSetMessageGasPrices{} }
+func (m *MsgSetMessageGasPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageGasPrices) ProtoMessage()    {}
+func (*MsgSetMessageGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{13}
+}
+func (m *MsgSetMessageGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessageGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageGasPrices.Merge(m, src)
+}
+func (m *MsgSetMessageGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageGasPrices.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetMessageGasPrices proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error)
-	DestroyIssuer(ctx context.Context, in *MsgDestroyIssuer, opts ...grpc.CallOption) (*MsgDestroyIssuerResponse, error)
-	SetGasPrices(ctx context.Context, in *MsgSetGasPrices, opts ...grpc.CallOption) (*MsgSetGasPricesResponse, error)
-	ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error)
-	ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error)
-	SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error)
+func (m *MsgSetMessageGasPrices) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetMessageGasPrices) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetMessageGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
 }
 
-func (c *msgClient) CreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error) {
-	out := new(MsgCreateIssuerResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/CreateIssuer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetMessageGasPricesResponse struct {
 }
 
-func (c *msgClient) DestroyIssuer(ctx context.Context, in *MsgDestroyIssuer, opts ...grpc.CallOption) (*MsgDestroyIssuerResponse, error) {
-	out := new(MsgDestroyIssuerResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/DestroyIssuer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetMessageGasPricesResponse) Reset()         { *m = MsgSetMessageGasPricesResponse{} }
+func (m *MsgSetMessageGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageGasPricesResponse) ProtoMessage()    {}
+func (*MsgSetMessageGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{14}
+}
+func (m *MsgSetMessageGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetMessageGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageGasPricesResponse.Merge(m, src)
+}
+func (m *MsgSetMessageGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageGasPricesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetGasPrices(ctx context.Context, in *MsgSetGasPrices, opts ...grpc.CallOption) (*MsgSetGasPricesResponse, error) {
-	out := new(MsgSetGasPricesResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetGasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetMessageGasPricesResponse proto.InternalMessageInfo
+
+// MsgSetFeeConversion configures paying fees in denominations traded against
+// the reference denomination. An empty reference denomination disables it.
+// Only fees required in the reference denomination are converted, so it must
+// have a minimum gas price. Message gas prices without it are not converted.
+type MsgSetFeeConversion struct {
+	Authority      string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	ReferenceDenom string        `protobuf:"bytes,2,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	MaxPriceAge    time.Duration `protobuf:"bytes,3,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age"`
 }
 
-func (c *msgClient) ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error) {
-	out := new(MsgReplaceAuthorityResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/ReplaceAuthority", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeConversion) Reset()         { *m = MsgSetFeeConversion{} }
+func (m *MsgSetFeeConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeConversion) ProtoMessage()    {}
+func (*MsgSetFeeConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{15}
+}
+func (m *MsgSetFeeConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFeeConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeConversion.Merge(m, src)
+}
+func (m *MsgSetFeeConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeConversion.DiscardUnknown(m)
 }
 
-func (c *msgClient) ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error) {
-	out := new(MsgScheduleUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/ScheduleUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFeeConversion proto.InternalMessageInfo
+
+func (m *MsgSetFeeConversion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error) {
-	out := new(MsgSetParametersResponse)
-	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetParameters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeConversion) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateIssuer(context.Context, *MsgCreateIssuer) (*MsgCreateIssuerResponse, error)
-	DestroyIssuer(context.Context, *MsgDestroyIssuer) (*MsgDestroyIssuerResponse, error)
-	SetGasPrices(context.Context, *MsgSetGasPrices) (*MsgSetGasPricesResponse, error)
-	ReplaceAuthority(context.Context, *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error)
-	ScheduleUpgrade(context.Context, *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error)
-	SetParameters(context.Context, *MsgSetParameters) (*MsgSetParametersResponse, error)
+func (m *MsgSetFeeConversion) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgSetFeeConversionResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateIssuer(ctx context.Context, req *MsgCreateIssuer) (*MsgCreateIssuerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIssuer not implemented")
+func (m *MsgSetFeeConversionResponse) Reset()         { *m = MsgSetFeeConversionResponse{} }
+func (m *MsgSetFeeConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeConversionResponse) ProtoMessage()    {}
+func (*MsgSetFeeConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{16}
 }
-func (*UnimplementedMsgServer) DestroyIssuer(ctx context.Context, req *MsgDestroyIssuer) (*MsgDestroyIssuerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DestroyIssuer not implemented")
+func (m *MsgSetFeeConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetGasPrices(ctx context.Context, req *MsgSetGasPrices) (*MsgSetGasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetGasPrices not implemented")
+func (m *MsgSetFeeConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ReplaceAuthority(ctx context.Context, req *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplaceAuthority not implemented")
+func (m *MsgSetFeeConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeConversionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ScheduleUpgrade(ctx context.Context, req *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpgrade not implemented")
+func (m *MsgSetFeeConversionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetParameters(ctx context.Context, req *MsgSetParameters) (*MsgSetParametersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetParameters not implemented")
+func (m *MsgSetFeeConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeConversionResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSetFeeConversionResponse proto.InternalMessageInfo
 
-func _Msg_CreateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateIssuer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateIssuer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/CreateIssuer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateIssuer(ctx, req.(*MsgCreateIssuer))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgReplaceAuthority struct {
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	NewAuthority string `protobuf:"bytes,2,opt,name=new_authority,json=newAuthority,proto3" json:"new_authority,omitempty" yaml:"new_authority"`
 }
 
-func _Msg_DestroyIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDestroyIssuer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DestroyIssuer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/DestroyIssuer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DestroyIssuer(ctx, req.(*MsgDestroyIssuer))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgReplaceAuthority) Reset()         { *m = MsgReplaceAuthority{} }
+func (m *MsgReplaceAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceAuthority) ProtoMessage()    {}
+func (*MsgReplaceAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{17}
 }
-
-func _Msg_SetGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetGasPrices)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetGasPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/SetGasPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetGasPrices(ctx, req.(*MsgSetGasPrices))
+func (m *MsgReplaceAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReplaceAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceAuthority.Merge(m, src)
+}
+func (m *MsgReplaceAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceAuthority.DiscardUnknown(m)
 }
 
-func _Msg_ReplaceAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReplaceAuthority)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReplaceAuthority(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/ReplaceAuthority",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReplaceAuthority(ctx, req.(*MsgReplaceAuthority))
+var xxx_messageInfo_MsgReplaceAuthority proto.InternalMessageInfo
+
+func (m *MsgReplaceAuthority) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ScheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgScheduleUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ScheduleUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/ScheduleUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ScheduleUpgrade(ctx, req.(*MsgScheduleUpgrade))
+func (m *MsgReplaceAuthority) GetNewAuthority() string {
+	if m != nil {
+		return m.NewAuthority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetParameters)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetParameters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.authority.v1.Msg/SetParameters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetParameters(ctx, req.(*MsgSetParameters))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgReplaceAuthorityResponse struct {
+	NewAuthorityAddress string `protobuf:"bytes,1,opt,name=new_authority_address,json=newAuthorityAddress,proto3" json:"new_authority_address,omitempty" yaml:"new_authority_address"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "em.authority.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateIssuer",
-			Handler:    _Msg_CreateIssuer_Handler,
-		},
-		{
-			MethodName: "DestroyIssuer",
-			Handler:    _Msg_DestroyIssuer_Handler,
-		},
-		{
-			MethodName: "SetGasPrices",
-			Handler:    _Msg_SetGasPrices_Handler,
-		},
-		{
-			MethodName: "ReplaceAuthority",
-			Handler:    _Msg_ReplaceAuthority_Handler,
-		},
-		{
-			MethodName: "ScheduleUpgrade",
-			Handler:    _Msg_ScheduleUpgrade_Handler,
-		},
-		{
-			MethodName: "SetParameters",
-			Handler:    _Msg_SetParameters_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "em/authority/v1/tx.proto",
-}
-
-func (m *MsgCreateIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReplaceAuthorityResponse) Reset()         { *m = MsgReplaceAuthorityResponse{} }
+func (m *MsgReplaceAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceAuthorityResponse) ProtoMessage()    {}
+func (*MsgReplaceAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{18}
 }
-
-func (m *MsgCreateIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReplaceAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denominations) > 0 {
-		for iNdEx := len(m.Denominations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Denominations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgReplaceAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Denomination) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReplaceAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceAuthorityResponse.Merge(m, src)
 }
-
-func (m *Denomination) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReplaceAuthorityResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Denomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Display) > 0 {
-		i -= len(m.Display)
-		copy(dAtA[i:], m.Display)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Display)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgReplaceAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceAuthorityResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIssuerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReplaceAuthorityResponse proto.InternalMessageInfo
+
+func (m *MsgReplaceAuthorityResponse) GetNewAuthorityAddress() string {
+	if m != nil {
+		return m.NewAuthorityAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgScheduleUpgrade struct {
+	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Plan      types2.Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan" yaml:"plan"`
 }
 
-func (m *MsgCreateIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleUpgrade) Reset()         { *m = MsgScheduleUpgrade{} }
+func (m *MsgScheduleUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpgrade) ProtoMessage()    {}
+func (*MsgScheduleUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{19}
 }
-
-func (m *MsgDestroyIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDestroyIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpgrade.Merge(m, src)
 }
-
-func (m *MsgDestroyIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgScheduleUpgrade) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDestroyIssuerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgScheduleUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpgrade.DiscardUnknown(m)
 }
 
-func (m *MsgDestroyIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgScheduleUpgrade proto.InternalMessageInfo
 
-func (m *MsgDestroyIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgSetGasPrices) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleUpgrade) GetPlan() types2.Plan {
+	if m != nil {
+		return m.Plan
 	}
-	return dAtA[:n], nil
+	return types2.Plan{}
 }
 
-func (m *MsgSetGasPrices) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgScheduleUpgradeResponse struct {
 }
 
-func (m *MsgSetGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GasPrices) > 0 {
-		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgScheduleUpgradeResponse) Reset()         { *m = MsgScheduleUpgradeResponse{} }
+func (m *MsgScheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpgradeResponse) ProtoMessage()    {}
+func (*MsgScheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{20}
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetGasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgScheduleUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpgradeResponse.Merge(m, src)
 }
-
-func (m *MsgSetGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleUpgradeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSetGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpgradeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgReplaceAuthority) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgScheduleUpgradeResponse proto.InternalMessageInfo
 
-func (m *MsgReplaceAuthority) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetParameters struct {
+	Authority string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Changes   []proposal.ParamChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *MsgReplaceAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAuthority) > 0 {
-		i -= len(m.NewAuthority)
-		copy(dAtA[i:], m.NewAuthority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetParameters) Reset()         { *m = MsgSetParameters{} }
+func (m *MsgSetParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgSetParameters) ProtoMessage()    {}
+func (*MsgSetParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{21}
 }
-
-func (m *MsgReplaceAuthorityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgReplaceAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetParameters.Merge(m, src)
+}
+func (m *MsgSetParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetParameters.DiscardUnknown(m)
 }
 
-func (m *MsgReplaceAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAuthorityAddress) > 0 {
-		i -= len(m.NewAuthorityAddress)
-		copy(dAtA[i:], m.NewAuthorityAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthorityAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetParameters proto.InternalMessageInfo
+
+func (m *MsgSetParameters) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgScheduleUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetParameters) GetChanges() []proposal.ParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgScheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetParametersResponse struct {
 }
 
-func (m *MsgScheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetParametersResponse) Reset()         { *m = MsgSetParametersResponse{} }
+func (m *MsgSetParametersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetParametersResponse) ProtoMessage()    {}
+func (*MsgSetParametersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{22}
+}
+func (m *MsgSetParametersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetParametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetParametersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgScheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetParametersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetParametersResponse.Merge(m, src)
+}
+func (m *MsgSetParametersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetParametersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetParametersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgScheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetParametersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateIssuer)(nil), "em.authority.v1.MsgCreateIssuer")
+	proto.RegisterType((*Denomination)(nil), "em.authority.v1.Denomination")
+	proto.RegisterType((*MsgCreateIssuerResponse)(nil), "em.authority.v1.MsgCreateIssuerResponse")
+	proto.RegisterType((*MsgDestroyIssuer)(nil), "em.authority.v1.MsgDestroyIssuer")
+	proto.RegisterType((*MsgDestroyIssuerResponse)(nil), "em.authority.v1.MsgDestroyIssuerResponse")
+	proto.RegisterType((*MsgTransferDenom)(nil), "em.authority.v1.MsgTransferDenom")
+	proto.RegisterType((*MsgTransferDenomResponse)(nil), "em.authority.v1.MsgTransferDenomResponse")
+	proto.RegisterType((*MsgSetSupplyCap)(nil), "em.authority.v1.MsgSetSupplyCap")
+	proto.RegisterType((*MsgSetSupplyCapResponse)(nil), "em.authority.v1.MsgSetSupplyCapResponse")
+	proto.RegisterType((*MsgSetInflationDistribution)(nil), "em.authority.v1.MsgSetInflationDistribution")
+	proto.RegisterType((*MsgSetInflationDistributionResponse)(nil), "em.authority.v1.MsgSetInflationDistributionResponse")
+	proto.RegisterType((*MsgSetGasPrices)(nil), "em.authority.v1.MsgSetGasPrices")
+	proto.RegisterType((*MsgSetGasPricesResponse)(nil), "em.authority.v1.MsgSetGasPricesResponse")
+	proto.RegisterType((*MsgSetMessageGasPrices)(nil), "em.authority.v1.MsgSetMessageGasPrices")
+	proto.RegisterType((*MsgSetMessageGasPricesResponse)(nil), "em.authority.v1.MsgSetMessageGasPricesResponse")
+	proto.RegisterType((*MsgSetFeeConversion)(nil), "em.authority.v1.MsgSetFeeConversion")
+	proto.RegisterType((*MsgSetFeeConversionResponse)(nil), "em.authority.v1.MsgSetFeeConversionResponse")
+	proto.RegisterType((*MsgReplaceAuthority)(nil), "em.authority.v1.MsgReplaceAuthority")
+	proto.RegisterType((*MsgReplaceAuthorityResponse)(nil), "em.authority.v1.MsgReplaceAuthorityResponse")
+	proto.RegisterType((*MsgScheduleUpgrade)(nil), "em.authority.v1.MsgScheduleUpgrade")
+	proto.RegisterType((*MsgScheduleUpgradeResponse)(nil), "em.authority.v1.MsgScheduleUpgradeResponse")
+	proto.RegisterType((*MsgSetParameters)(nil), "em.authority.v1.MsgSetParameters")
+	proto.RegisterType((*MsgSetParametersResponse)(nil), "em.authority.v1.MsgSetParametersResponse")
 }
 
-func (m *MsgScheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("em/authority/v1/tx.proto", fileDescriptor_1601f633ca5d263c) }
+
+var fileDescriptor_1601f633ca5d263c = []byte{
+	// 1274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0x69, 0x9b, 0x4c, 0x92, 0x26, 0x75, 0x4a, 0x70, 0xdd, 0x74, 0xbd, 0x9d, 0xb6,
+	0x90, 0xa8, 0xad, 0xad, 0x04, 0x24, 0x24, 0x24, 0x84, 0xba, 0x09, 0xd0, 0x4a, 0x44, 0x8a, 0xdc,
+	0xf6, 0x52, 0x09, 0x56, 0x13, 0xef, 0x8b, 0x63, 0xd5, 0xff, 0x3a, 0xe3, 0x4d, 0xb3, 0x17, 0x4e,
+	0x80, 0x10, 0x17, 0x38, 0xf2, 0x19, 0xf8, 0x12, 0x5c, 0x7b, 0x41, 0xaa, 0xc4, 0x85, 0x03, 0xda,
+	0xa2, 0xe6, 0x03, 0x20, 0xed, 0x27, 0x40, 0xf6, 0x8c, 0x67, 0xed, 0x8d, 0x97, 0x0d, 0x8b, 0x80,
+	0x53, 0x76, 0xfc, 0x7e, 0xef, 0xcd, 0xef, 0xfd, 0xde, 0xbc, 0x99, 0x17, 0xa4, 0x41, 0x60, 0x91,
+	0x4e, 0x72, 0x18, 0x51, 0x2f, 0xe9, 0x5a, 0x47, 0x9b, 0x56, 0x72, 0x6c, 0xc6, 0x34, 0x4a, 0x22,
+	0x75, 0x09, 0x02, 0x53, 0x5a, 0xcc, 0xa3, 0x4d, 0xfd, 0xb2, 0x1b, 0xb9, 0x51, 0x66, 0xb3, 0xd2,
+	0x5f, 0x1c, 0xa6, 0xd7, 0x9d, 0x88, 0x05, 0x11, 0xb3, 0xf6, 0x09, 0x03, 0xeb, 0x68, 0x73, 0x1f,
+	0x12, 0xb2, 0x69, 0x39, 0x91, 0x17, 0x0a, 0xfb, 0x4d, 0x61, 0xef, 0xc4, 0x2e, 0x25, 0xed, 0x01,
+	0x44, 0xac, 0x05, 0x0a, 0x0b, 0x54, 0x4c, 0x28, 0x09, 0x98, 0x04, 0xf1, 0x65, 0xbe, 0x93, 0x1b,
+	0x45, 0xae, 0x0f, 0x56, 0xb6, 0xda, 0xef, 0x1c, 0x58, 0xed, 0x0e, 0x25, 0x89, 0x17, 0xe5, 0x3b,
+	0x19, 0x10, 0x58, 0x5e, 0x78, 0xe0, 0x67, 0xdf, 0xd2, 0x54, 0xe4, 0x82, 0x03, 0xf0, 0x2f, 0x0a,
+	0x5a, 0xda, 0x65, 0xee, 0x36, 0x05, 0x92, 0xc0, 0x03, 0xc6, 0x3a, 0x40, 0xd5, 0x2d, 0x34, 0x27,
+	0x93, 0xd4, 0x94, 0x86, 0xb2, 0x3e, 0xd7, 0xbc, 0xdc, 0xef, 0x19, 0xcb, 0x5d, 0x12, 0xf8, 0xef,
+	0x63, 0x69, 0xc2, 0xf6, 0x00, 0xa6, 0x6e, 0xa0, 0xf3, 0x5e, 0xe6, 0xad, 0x4d, 0x67, 0x0e, 0x97,
+	0xfa, 0x3d, 0x63, 0x91, 0x3b, 0xf0, 0xef, 0xd8, 0x16, 0x00, 0x95, 0xa0, 0xc5, 0x36, 0x84, 0x51,
+	0xe0, 0x85, 0x19, 0x11, 0xa6, 0xd5, 0x1a, 0xb5, 0xf5, 0xf9, 0xad, 0x6b, 0xe6, 0x90, 0xb8, 0xe6,
+	0x4e, 0x01, 0xd5, 0x5c, 0x7b, 0xd1, 0x33, 0xa6, 0xfa, 0x3d, 0xe3, 0x32, 0x0f, 0x5a, 0x8a, 0x80,
+	0xed, 0x72, 0x44, 0xfc, 0x39, 0x5a, 0x28, 0x3a, 0xab, 0x2a, 0x9a, 0x49, 0x6b, 0xc1, 0x93, 0xb1,
+	0xb3, 0xdf, 0xaa, 0x86, 0x2e, 0xb4, 0x3d, 0x16, 0xfb, 0xa4, 0xcb, 0x29, 0xdb, 0xf9, 0x52, 0x6d,
+	0xa0, 0xf9, 0x36, 0x30, 0x87, 0x7a, 0x71, 0xea, 0xac, 0xd5, 0x32, 0x6b, 0xf1, 0x13, 0xbe, 0x82,
+	0xde, 0x1c, 0x12, 0xcd, 0x06, 0x16, 0x47, 0x21, 0x03, 0xfc, 0x0c, 0x2d, 0xef, 0x32, 0x77, 0x07,
+	0x58, 0x42, 0xa3, 0xee, 0x7f, 0x22, 0x28, 0xd6, 0x91, 0x36, 0xbc, 0xa5, 0xa4, 0xf3, 0xd3, 0x74,
+	0xc6, 0xe7, 0x11, 0x25, 0x21, 0x3b, 0x00, 0x9a, 0xa9, 0x32, 0x11, 0x9f, 0xb7, 0xd0, 0xb9, 0x4c,
+	0x63, 0x41, 0x67, 0xb9, 0xdf, 0x33, 0x16, 0x0a, 0xa5, 0xc0, 0x36, 0x37, 0xab, 0xef, 0xa1, 0xf9,
+	0x03, 0x1a, 0x05, 0x2d, 0x41, 0x3e, 0x13, 0xaf, 0xb9, 0xda, 0xef, 0x19, 0x2a, 0x47, 0x17, 0x8c,
+	0xd8, 0x46, 0xe9, 0x4a, 0x88, 0xb4, 0x89, 0xe6, 0x92, 0x28, 0x77, 0x9b, 0x19, 0x26, 0x25, 0x4d,
+	0xd8, 0x9e, 0x4d, 0x22, 0xe1, 0xe2, 0x20, 0x9d, 0xc2, 0x51, 0xf4, 0x14, 0x5a, 0xbe, 0xf7, 0xac,
+	0xe3, 0xb5, 0xbd, 0xa4, 0xdb, 0x8a, 0x69, 0x74, 0xe4, 0xb5, 0x81, 0x32, 0xed, 0x5c, 0x43, 0x59,
+	0x9f, 0x6d, 0xde, 0xea, 0xf7, 0x8c, 0xeb, 0x3c, 0xc6, 0x68, 0x2c, 0xb6, 0x35, 0x6e, 0xfc, 0x34,
+	0xb7, 0xed, 0x49, 0x13, 0x57, 0xb7, 0x24, 0xa0, 0x54, 0xf7, 0x6b, 0xde, 0x3d, 0x0f, 0x21, 0x79,
+	0xd8, 0x89, 0x63, 0xbf, 0xbb, 0x4d, 0xe2, 0x89, 0xc4, 0xfd, 0x10, 0xd5, 0x1c, 0x12, 0x67, 0xd2,
+	0xce, 0x6f, 0x5d, 0x31, 0x79, 0xe3, 0x9b, 0xe9, 0x31, 0x35, 0x45, 0xdb, 0x9b, 0xdb, 0x91, 0x17,
+	0x36, 0x55, 0xd1, 0x04, 0x88, 0x07, 0x73, 0x48, 0x8c, 0xed, 0xd4, 0x53, 0x1c, 0xc8, 0x22, 0x0f,
+	0xc9, 0xf1, 0xa5, 0x82, 0xae, 0x72, 0xdb, 0x83, 0xbc, 0xf7, 0x77, 0x3c, 0x96, 0x50, 0x6f, 0xbf,
+	0x93, 0xfe, 0xfe, 0x57, 0x0f, 0xc3, 0x63, 0x74, 0x21, 0x21, 0xd4, 0x85, 0x24, 0x6f, 0xf2, 0x1b,
+	0x69, 0x93, 0x0f, 0xee, 0xa0, 0xb4, 0xc9, 0x0b, 0x5c, 0x1e, 0x65, 0xd8, 0xe6, 0xaa, 0xc8, 0xf2,
+	0xa2, 0x28, 0x3d, 0x8f, 0x80, 0xed, 0x3c, 0x16, 0xbe, 0x85, 0x6e, 0xfc, 0x45, 0x46, 0x32, 0xf3,
+	0x9f, 0x65, 0x75, 0x3e, 0x21, 0x6c, 0x8f, 0x7a, 0x0e, 0xb0, 0x89, 0xb2, 0xfd, 0x4a, 0x41, 0xc8,
+	0x25, 0xac, 0x15, 0x67, 0x21, 0xb4, 0xe9, 0x2c, 0x93, 0xb5, 0xca, 0x2a, 0xed, 0x80, 0x93, 0x15,
+	0xea, 0xbe, 0x48, 0xe1, 0x12, 0x8f, 0x3b, 0xf0, 0xc6, 0x3f, 0xbe, 0x32, 0x6e, 0xbb, 0x5e, 0x72,
+	0xd8, 0xd9, 0x37, 0x9d, 0x28, 0xb0, 0xc4, 0x1d, 0xcf, 0xff, 0xdc, 0x65, 0xed, 0xa7, 0x56, 0xd2,
+	0x8d, 0x81, 0xe5, 0x81, 0x98, 0x3d, 0xe7, 0xe6, 0xdc, 0x07, 0x45, 0x96, 0xe9, 0xc8, 0x54, 0xbf,
+	0x9c, 0x46, 0xab, 0xdc, 0xb6, 0x0b, 0x8c, 0x11, 0x17, 0xfe, 0x59, 0xc6, 0x26, 0x9a, 0x4d, 0x69,
+	0xb4, 0x3a, 0xd4, 0x17, 0x25, 0x5e, 0xe9, 0xf7, 0x8c, 0x25, 0x51, 0x0f, 0x61, 0x49, 0x0b, 0xd2,
+	0x8d, 0xe1, 0x31, 0xf5, 0x87, 0x15, 0xaa, 0xfd, 0x5f, 0x0a, 0x35, 0x50, 0xbd, 0x5a, 0x05, 0x29,
+	0xd4, 0x1f, 0x0a, 0x5a, 0xe1, 0x90, 0x8f, 0x01, 0xb6, 0xa3, 0xf0, 0x08, 0x28, 0x9b, 0xb4, 0x0b,
+	0xb6, 0xd1, 0x12, 0x85, 0x03, 0xa0, 0x10, 0x3a, 0xd0, 0x2a, 0xf6, 0x83, 0xde, 0xef, 0x19, 0xab,
+	0xf9, 0x9d, 0x53, 0x02, 0x60, 0xfb, 0xa2, 0xfc, 0xc2, 0xef, 0xe2, 0x16, 0x5a, 0x0c, 0xc8, 0x31,
+	0xcf, 0xbd, 0x45, 0x5c, 0xd0, 0x6a, 0xe2, 0x12, 0xe0, 0x2f, 0xbb, 0x99, 0xbf, 0xec, 0xe6, 0x8e,
+	0x78, 0xd9, 0x9b, 0x8d, 0xf2, 0x4b, 0x58, 0xf2, 0xc6, 0x3f, 0xbc, 0x32, 0x14, 0x7b, 0x3e, 0x20,
+	0xc7, 0x59, 0xde, 0xf7, 0x5c, 0xc0, 0xd7, 0xd0, 0xd5, 0x8a, 0x84, 0xa5, 0x20, 0xdf, 0x70, 0x41,
+	0x6c, 0x88, 0x7d, 0xe2, 0xc0, 0x3d, 0x99, 0xdc, 0x24, 0x82, 0x7c, 0x80, 0x16, 0x43, 0x78, 0xde,
+	0x1a, 0xf8, 0x71, 0x39, 0xb4, 0x01, 0xd9, 0x92, 0x19, 0xdb, 0x0b, 0x21, 0x3c, 0x97, 0x5b, 0x62,
+	0x86, 0xae, 0x56, 0x30, 0xc9, 0x99, 0xaa, 0x8f, 0xd0, 0x1b, 0x25, 0xf7, 0x16, 0x69, 0xb7, 0x29,
+	0x30, 0x26, 0xd8, 0x35, 0xfa, 0x3d, 0x63, 0xad, 0x62, 0x97, 0x1c, 0x86, 0xed, 0x95, 0xe2, 0x6e,
+	0xf7, 0xc4, 0xd7, 0xef, 0x14, 0xa4, 0xa6, 0xfa, 0x38, 0x87, 0xd0, 0xee, 0xf8, 0xf0, 0x98, 0x8f,
+	0x60, 0x13, 0xa5, 0xff, 0x11, 0x9a, 0x89, 0x7d, 0x12, 0x8a, 0x6b, 0x5c, 0x1e, 0xff, 0x7c, 0xaa,
+	0xcb, 0x3b, 0x60, 0xcf, 0x27, 0x61, 0x73, 0x45, 0x14, 0x71, 0x9e, 0x07, 0x4c, 0xfd, 0xb0, 0x9d,
+	0xb9, 0xe3, 0x35, 0xa4, 0x9f, 0x26, 0x24, 0xeb, 0xf5, 0xad, 0x92, 0x3d, 0xe8, 0x0f, 0x21, 0xd9,
+	0x23, 0x94, 0x04, 0x90, 0x00, 0x9d, 0xac, 0xc7, 0x9b, 0xe8, 0x82, 0x73, 0x48, 0x42, 0x57, 0xde,
+	0x68, 0x38, 0x27, 0x2c, 0x26, 0x4c, 0xc9, 0x37, 0x5d, 0x6e, 0x67, 0xd0, 0xe6, 0x4c, 0x4a, 0xdb,
+	0xce, 0x1d, 0xc5, 0xdb, 0x58, 0xe2, 0x92, 0x13, 0xdd, 0xfa, 0x6d, 0x16, 0xd5, 0x76, 0x99, 0xab,
+	0x3e, 0x41, 0x0b, 0xa5, 0xe9, 0xb2, 0x71, 0x6a, 0xce, 0x1b, 0x1a, 0xa5, 0xf4, 0xf5, 0x71, 0x08,
+	0x79, 0x24, 0x3e, 0x43, 0x8b, 0xe5, 0x49, 0xeb, 0x7a, 0x95, 0x6b, 0x09, 0xa2, 0x6f, 0x8c, 0x85,
+	0x14, 0xc3, 0x97, 0x07, 0xa7, 0xca, 0xf0, 0x25, 0x88, 0xbe, 0x31, 0x16, 0x22, 0xc3, 0x3f, 0x41,
+	0x0b, 0xa5, 0xc9, 0xa1, 0x52, 0x99, 0x22, 0x42, 0x5f, 0x1f, 0x87, 0x90, 0xb1, 0xbf, 0x40, 0xda,
+	0xc8, 0x17, 0xff, 0xce, 0x88, 0x28, 0x95, 0x68, 0xfd, 0xdd, 0xbf, 0x83, 0x1e, 0xca, 0x6d, 0xf0,
+	0x0a, 0x8d, 0xca, 0x4d, 0x22, 0xf4, 0xf5, 0x71, 0x08, 0x19, 0x3b, 0x42, 0x2b, 0x55, 0x0f, 0xdd,
+	0xdb, 0x23, 0x02, 0x0c, 0x03, 0x75, 0xeb, 0x8c, 0x40, 0xb9, 0xe1, 0x01, 0x5a, 0x3e, 0xf5, 0x60,
+	0xdc, 0x1c, 0x11, 0xa4, 0x84, 0xd2, 0xef, 0x9c, 0x05, 0x55, 0xdc, 0xe7, 0xd4, 0x3d, 0x5c, 0xb9,
+	0xcf, 0x30, 0x4a, 0xbf, 0x73, 0x16, 0x94, 0xdc, 0xc7, 0x41, 0x4b, 0xc3, 0xf7, 0xdd, 0x8d, 0x4a,
+	0xa2, 0x65, 0x90, 0x7e, 0xfb, 0x0c, 0xa0, 0x62, 0xf3, 0x94, 0x2f, 0xa9, 0xeb, 0x23, 0xb4, 0x18,
+	0x40, 0xf4, 0x8d, 0xb1, 0x90, 0x3c, 0x7c, 0xf3, 0xfe, 0x8b, 0xd7, 0x75, 0xe5, 0xe5, 0xeb, 0xba,
+	0xf2, 0xfb, 0xeb, 0xba, 0xf2, 0xfd, 0x49, 0x7d, 0xea, 0xe5, 0x49, 0x7d, 0xea, 0xd7, 0x93, 0xfa,
+	0xd4, 0x13, 0xb3, 0x30, 0x3c, 0xc0, 0xdd, 0x20, 0x0a, 0xa1, 0x6b, 0x41, 0x70, 0xd7, 0x87, 0xb6,
+	0x0b, 0xd4, 0x3a, 0x2e, 0xfc, 0x6b, 0x9f, 0x0d, 0x12, 0xfb, 0xe7, 0xb3, 0x27, 0xf6, 0x9d, 0x3f,
+	0x07, 0x00, 0xaa, 0x86, 0xbc, 0xa1, 0xf7, 0x0f, 0x00, 0x00,
 }
 
-func (m *MsgSetParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error)
+	DestroyIssuer(ctx context.Context, in *MsgDestroyIssuer, opts ...grpc.CallOption) (*MsgDestroyIssuerResponse, error)
+	TransferDenom(ctx context.Context, in *MsgTransferDenom, opts ...grpc.CallOption) (*MsgTransferDenomResponse, error)
+	SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error)
+	SetInflationDistribution(ctx context.Context, in *MsgSetInflationDistribution, opts ...grpc.CallOption) (*MsgSetInflationDistributionResponse, error)
+	SetGasPrices(ctx context.Context, in *MsgSetGasPrices, opts ...grpc.CallOption) (*MsgSetGasPricesResponse, error)
+	SetMessageGasPrices(ctx context.Context, in *MsgSetMessageGasPrices, opts ...grpc.CallOption) (*MsgSetMessageGasPricesResponse, error)
+	SetFeeConversion(ctx context.Context, in *MsgSetFeeConversion, opts ...grpc.CallOption) (*MsgSetFeeConversionResponse, error)
+	ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error)
+	ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error)
+	SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error)
 }
 
-func (m *MsgSetParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgSetParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgSetParametersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error) {
+	out := new(MsgCreateIssuerResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/CreateIssuer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetParametersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DestroyIssuer(ctx context.Context, in *MsgDestroyIssuer, opts ...grpc.CallOption) (*MsgDestroyIssuerResponse, error) {
+	out := new(MsgDestroyIssuerResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/DestroyIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetParametersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) TransferDenom(ctx context.Context, in *MsgTransferDenom, opts ...grpc.CallOption) (*MsgTransferDenomResponse, error) {
+	out := new(MsgTransferDenomResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/TransferDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error) {
+	out := new(MsgSetSupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetSupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateIssuer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Denominations) > 0 {
-		for _, e := range m.Denominations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) SetInflationDistribution(ctx context.Context, in *MsgSetInflationDistribution, opts ...grpc.CallOption) (*MsgSetInflationDistributionResponse, error) {
+	out := new(MsgSetInflationDistributionResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetInflationDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Denomination) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Display)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetGasPrices(ctx context.Context, in *MsgSetGasPrices, opts ...grpc.CallOption) (*MsgSetGasPricesResponse, error) {
+	out := new(MsgSetGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetMessageGasPrices(ctx context.Context, in *MsgSetMessageGasPrices, opts ...grpc.CallOption) (*MsgSetMessageGasPricesResponse, error) {
+	out := new(MsgSetMessageGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetMessageGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDestroyIssuer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetFeeConversion(ctx context.Context, in *MsgSetFeeConversion, opts ...grpc.CallOption) (*MsgSetFeeConversionResponse, error) {
+	out := new(MsgSetFeeConversionResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetFeeConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDestroyIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error) {
+	out := new(MsgReplaceAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/ReplaceAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSetGasPrices) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.GasPrices) > 0 {
-		for _, e := range m.GasPrices {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error) {
+	out := new(MsgScheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/ScheduleUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSetGasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error) {
+	out := new(MsgSetParametersResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgReplaceAuthority) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateIssuer(context.Context, *MsgCreateIssuer) (*MsgCreateIssuerResponse, error)
+	DestroyIssuer(context.Context, *MsgDestroyIssuer) (*MsgDestroyIssuerResponse, error)
+	TransferDenom(context.Context, *MsgTransferDenom) (*MsgTransferDenomResponse, error)
+	SetSupplyCap(context.Context, *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error)
+	SetInflationDistribution(context.Context, *MsgSetInflationDistribution) (*MsgSetInflationDistributionResponse, error)
+	SetGasPrices(context.Context, *MsgSetGasPrices) (*MsgSetGasPricesResponse, error)
+	SetMessageGasPrices(context.Context, *MsgSetMessageGasPrices) (*MsgSetMessageGasPricesResponse, error)
+	SetFeeConversion(context.Context, *MsgSetFeeConversion) (*MsgSetFeeConversionResponse, error)
+	ReplaceAuthority(context.Context, *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error)
+	ScheduleUpgrade(context.Context, *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error)
+	SetParameters(context.Context, *MsgSetParameters) (*MsgSetParametersResponse, error)
 }
 
-func (m *MsgReplaceAuthorityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewAuthorityAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgScheduleUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedMsgServer) CreateIssuer(ctx context.Context, req *MsgCreateIssuer) (*MsgCreateIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssuer not implemented")
+}
+func (*UnimplementedMsgServer) DestroyIssuer(ctx context.Context, req *MsgDestroyIssuer) (*MsgDestroyIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyIssuer not implemented")
+}
+func (*UnimplementedMsgServer) TransferDenom(ctx context.Context, req *MsgTransferDenom) (*MsgTransferDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDenom not implemented")
+}
+func (*UnimplementedMsgServer) SetSupplyCap(ctx context.Context, req *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplyCap not implemented")
+}
+func (*UnimplementedMsgServer) SetInflationDistribution(ctx context.Context, req *MsgSetInflationDistribution) (*MsgSetInflationDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInflationDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SetGasPrices(ctx context.Context, req *MsgSetGasPrices) (*MsgSetGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasPrices not implemented")
+}
+func (*UnimplementedMsgServer) SetMessageGasPrices(ctx context.Context, req *MsgSetMessageGasPrices) (*MsgSetMessageGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageGasPrices not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeConversion(ctx context.Context, req *MsgSetFeeConversion) (*MsgSetFeeConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeConversion not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceAuthority(ctx context.Context, req *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceAuthority not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleUpgrade(ctx context.Context, req *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) SetParameters(ctx context.Context, req *MsgSetParameters) (*MsgSetParametersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParameters not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIssuer(ctx, in)
 	}
-	l = m.Plan.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/CreateIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIssuer(ctx, req.(*MsgCreateIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgScheduleUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DestroyIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDestroyIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DestroyIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/DestroyIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DestroyIssuer(ctx, req.(*MsgDestroyIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDenom)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDenom(ctx, in)
 	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/TransferDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDenom(ctx, req.(*MsgTransferDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupplyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetSupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupplyCap(ctx, req.(*MsgSetSupplyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInflationDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInflationDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInflationDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetInflationDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInflationDistribution(ctx, req.(*MsgSetInflationDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasPrices(ctx, req.(*MsgSetGasPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMessageGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMessageGasPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMessageGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetMessageGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMessageGasPrices(ctx, req.(*MsgSetMessageGasPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetFeeConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeConversion(ctx, req.(*MsgSetFeeConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/ReplaceAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceAuthority(ctx, req.(*MsgReplaceAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/ScheduleUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleUpgrade(ctx, req.(*MsgScheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetParameters(ctx, req.(*MsgSetParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "em.authority.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIssuer",
+			Handler:    _Msg_CreateIssuer_Handler,
+		},
+		{
+			MethodName: "DestroyIssuer",
+			Handler:    _Msg_DestroyIssuer_Handler,
+		},
+		{
+			MethodName: "TransferDenom",
+			Handler:    _Msg_TransferDenom_Handler,
+		},
+		{
+			MethodName: "SetSupplyCap",
+			Handler:    _Msg_SetSupplyCap_Handler,
+		},
+		{
+			MethodName: "SetInflationDistribution",
+			Handler:    _Msg_SetInflationDistribution_Handler,
+		},
+		{
+			MethodName: "SetGasPrices",
+			Handler:    _Msg_SetGasPrices_Handler,
+		},
+		{
+			MethodName: "SetMessageGasPrices",
+			Handler:    _Msg_SetMessageGasPrices_Handler,
+		},
+		{
+			MethodName: "SetFeeConversion",
+			Handler:    _Msg_SetFeeConversion_Handler,
+		},
+		{
+			MethodName: "ReplaceAuthority",
+			Handler:    _Msg_ReplaceAuthority_Handler,
+		},
+		{
+			MethodName: "ScheduleUpgrade",
+			Handler:    _Msg_ScheduleUpgrade_Handler,
+		},
+		{
+			MethodName: "SetParameters",
+			Handler:    _Msg_SetParameters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "em/authority/v1/tx.proto",
+}
+
+func (m *MsgCreateIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denominations) > 0 {
+		for iNdEx := len(m.Denominations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denominations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Denomination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Denomination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Denomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDestroyIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDestroyIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDestroyIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDestroyIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDestroyIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDestroyIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevokeLiquidityProviders {
+		i--
+		if m.RevokeLiquidityProviders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToIssuer) > 0 {
+		i -= len(m.ToIssuer)
+		copy(dAtA[i:], m.ToIssuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToIssuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromIssuer) > 0 {
+		i -= len(m.FromIssuer)
+		copy(dAtA[i:], m.FromIssuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromIssuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupplyCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupplyCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupplyCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInflationDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInflationDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInflationDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInflationDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInflationDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessageGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessageGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthority) > 0 {
+		i -= len(m.NewAuthority)
+		copy(dAtA[i:], m.NewAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthorityAddress) > 0 {
+		i -= len(m.NewAuthorityAddress)
+		copy(dAtA[i:], m.NewAuthorityAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthorityAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParametersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParametersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParametersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denominations) > 0 {
+		for _, e := range m.Denominations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Denomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDestroyIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDestroyIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromIssuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToIssuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RevokeLiquidityProviders {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSupplyCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetInflationDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMessageGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMessageGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeeConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAuthorityAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetParametersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denominations = append(m.Denominations, Denomination{})
+			if err := m.Denominations[len(m.Denominations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denomination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denomination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denomination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIssuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromIssuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToIssuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToIssuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeLiquidityProviders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevokeLiquidityProviders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSupplyCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSupplyCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSupplyCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetParametersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetSupplyCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSupplyCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflationDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,10 +3806,10 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflationDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1681,7 +3846,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,11 +3874,11 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denominations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1740,8 +3905,8 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denominations = append(m.Denominations, Denomination{})
-			if err := m.Denominations[len(m.Denominations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Targets = append(m.Targets, types1.DistributionTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1766,7 +3931,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denomination) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflationDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,47 +3954,65 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denomination: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflationDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denomination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflationDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +4040,13 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1873,23 +4056,25 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1912,7 +4097,7 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,10 +4120,10 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1962,7 +4147,7 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMessageGasPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,10 +4170,10 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMessageGasPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMessageGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2025,7 +4210,7 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2053,7 +4238,41 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2076,7 +4295,7 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMessageGasPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,10 +4318,10 @@ func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyIssuerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMessageGasPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMessageGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2126,7 +4345,7 @@ func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2149,10 +4368,10 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetGasPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2189,7 +4408,39 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2216,8 +4467,7 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrices = append(m.GasPrices, types.DecCoin{})
-			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2242,7 +4492,7 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2265,10 +4515,10 @@ func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetGasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
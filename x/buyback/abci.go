@@ -19,6 +19,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper, bk types.BankKeeper) {
 	var (
 		stakingDenom = k.GetStakingTokenDenom(ctx)
 		account      = k.GetBuybackAccountAddr()
+		params       = k.GetParams(ctx)
 	)
 
 	for _, balance := range bk.GetAllBalances(ctx, account) {
@@ -32,28 +33,49 @@ func BeginBlocker(ctx sdk.Context, k Keeper, bk types.BankKeeper) {
 			continue
 		}
 
-		// Calculate the amount of staking tokens that can be purchased at that price
-		destinationAmount := balance.Amount.ToDec().Mul(*price).TruncateInt()
-		if destinationAmount.LT(sdk.OneInt()) {
-			continue
-		}
+		// Prices are in staking tokens per stablecoin, so a lower value means a more expensive staking token.
+		if params.MaxPricePremium.IsPositive() {
+			reference := k.GetLastPrice(ctx, balance.Denom, stakingDenom)
+			if reference == nil {
+				// Without a reference the premium cannot be bounded
+				continue
+			}
 
-		order, err := markettypes.NewOrder(
-			ctx.BlockTime(),
-			markettypes.TimeInForce_GoodTillCancel,
-			balance,
-			sdk.NewCoin(stakingDenom, destinationAmount),
-			account,
-			generateClientOrderId(ctx, balance),
-		)
-		if err != nil {
-			ctx.Logger().Error("Error creating buyback order", "err", err)
-			continue
+			minPrice := reference.Quo(sdk.OneDec().Add(params.MaxPricePremium))
+			if price.LT(minPrice) {
+				price = &minPrice
+			}
 		}
 
-		if err := k.SendOrderToMarket(ctx, order); err != nil {
-			ctx.Logger().Error("Error sending buyback order to market", "err", err)
-			continue
+		for i, p := range placements(params, balance, *price) {
+			// Calculate the amount of staking tokens that can be purchased at that price
+			destinationAmount := p.source.Amount.ToDec().Mul(p.price).TruncateInt()
+			if destinationAmount.LT(sdk.OneInt()) {
+				continue
+			}
+
+			clientOrderId := generateClientOrderId(ctx, balance)
+			if i > 0 {
+				clientOrderId = fmt.Sprintf("%v-%d", clientOrderId, i)
+			}
+
+			order, err := markettypes.NewOrder(
+				ctx.BlockTime(),
+				markettypes.TimeInForce_GoodTillCancel,
+				p.source,
+				sdk.NewCoin(stakingDenom, destinationAmount),
+				account,
+				clientOrderId,
+			)
+			if err != nil {
+				ctx.Logger().Error("Error creating buyback order", "err", err)
+				continue
+			}
+
+			if err := k.SendOrderToMarket(ctx, order); err != nil {
+				ctx.Logger().Error("Error sending buyback order to market", "err", err)
+				continue
+			}
 		}
 	}
 
@@ -63,6 +85,44 @@ func BeginBlocker(ctx sdk.Context, k Keeper, bk types.BankKeeper) {
 	}
 }
 
+type placement struct {
+	source sdk.Coin
+	price  sdk.Dec
+}
+
+// placements divides the part of the balance that may be spent in this interval into orders according to the strategy.
+func placements(params types.Params, balance sdk.Coin, bestPrice sdk.Dec) []placement {
+	budget := params.Spendable(balance)
+
+	switch params.Strategy {
+	case types.Strategy_Ladder:
+		var (
+			steps = int64(params.LadderSteps)
+			step  = budget.Amount.QuoRaw(steps)
+			res   = make([]placement, 0, steps)
+		)
+
+		for i := int64(0); i < steps; i++ {
+			amount := step
+			if i == steps-1 {
+				amount = budget.Amount.Sub(step.MulRaw(steps - 1))
+			}
+
+			// Each step asks for more staking tokens per stablecoin than the one before
+			price := bestPrice.Mul(sdk.OneDec().Add(params.LadderSpacing.MulInt64(i)))
+			res = append(res, placement{sdk.NewCoin(balance.Denom, amount), price})
+		}
+		return res
+
+	case types.Strategy_Twap:
+		slice := balance.Amount.ToDec().QuoInt64(int64(params.TwapSlices)).Ceil().TruncateInt()
+		return []placement{{sdk.NewCoin(balance.Denom, sdk.MinInt(slice, budget.Amount)), bestPrice}}
+
+	default:
+		return []placement{{budget, bestPrice}}
+	}
+}
+
 func generateClientOrderId(ctx sdk.Context, balance sdk.Coin) string {
 	return fmt.Sprintf("buyback-%v-%v", balance.Denom, ctx.BlockHeight())
 }
@@ -20,9 +20,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	embank "github.com/e-money/em-ledger/hooks/bank"
 	"github.com/e-money/em-ledger/x/buyback/internal/keeper"
+	"github.com/e-money/em-ledger/x/buyback/internal/types"
 	"github.com/e-money/em-ledger/x/market"
 	markettypes "github.com/e-money/em-ledger/x/market/types"
 	"github.com/stretchr/testify/require"
@@ -150,6 +152,177 @@ func TestBuyback3(t *testing.T) {
 	require.Empty(t, orders)
 }
 
+func TestBuybackStats(t *testing.T) {
+	ctx, k, market, accountKeeper, bankKeeper := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+
+	acc1 := createAccount(t, ctx, accountKeeper, bankKeeper, randomAddress(), "50000ungm")
+	require.NoError(t, market.NewOrderSingle(ctx, order(acc1, "5000ungm", "10000eur")))
+	require.NoError(t, market.NewOrderSingle(ctx, order(acc1, "5000ungm", "20000chf")))
+
+	buybackAccount := accountKeeper.GetModuleAccount(ctx, ModuleName).GetAddress()
+	setAccBalance(t, ctx, buybackAccount, bankKeeper, coins("1000ungm"))
+
+	BeginBlocker(ctx, k, bankKeeper)
+
+	stats := k.GetStats(ctx)
+	require.Equal(t, coins("6000ungm"), stats.Burned)
+	require.Len(t, stats.Purchases, 1)
+	require.Equal(t, coin("10000eur"), stats.Purchases[0].Spent)
+	require.Equal(t, coin("5000ungm"), stats.Purchases[0].Bought)
+	require.Equal(t, sdk.NewDec(2), stats.Purchases[0].AveragePrice)
+
+	// Purchases made by other accounts are not recorded
+	acc2 := createAccount(t, ctx, accountKeeper, bankKeeper, randomAddress(), "10000chf")
+	require.NoError(t, market.NewOrderSingle(ctx, order(acc2, "4000chf", "1000ungm")))
+	require.Empty(t, k.GetCurrentPeriod(ctx).Purchases)
+
+	setAccBalance(t, ctx, buybackAccount, bankKeeper, coins("8000chf"))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	BeginBlocker(ctx, k, bankKeeper)
+
+	stats = k.GetStats(ctx)
+	require.Equal(t, coins("8000ungm"), stats.Burned)
+	require.Len(t, stats.Purchases, 2)
+	require.Equal(t, uint64(2), stats.Periods)
+
+	history := k.GetHistory(ctx)
+	require.Len(t, history, 2)
+	require.Equal(t, coin("6000ungm"), history[0].Burned)
+	require.Equal(t, coin("2000ungm"), history[1].Burned)
+	require.Equal(t, history[0].EndTime, history[1].StartTime)
+	require.Equal(t, []types.Purchase{
+		{Spent: coin("8000chf"), Bought: coin("2000ungm"), AveragePrice: sdk.NewDec(4)},
+	}, history[1].Purchases)
+}
+
+func TestBuybackAllocation(t *testing.T) {
+	ctx, k, _, accountKeeper, bankKeeper := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+
+	params := k.GetParams(ctx)
+	params.BurnFraction = sdk.NewDecWithPrec(75, 2)
+	k.SetParams(ctx, params)
+
+	var (
+		buybackAccount = accountKeeper.GetModuleAddress(ModuleName)
+		communityPool  = accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+		feeCollector   = accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	)
+
+	setAccBalance(t, ctx, buybackAccount, bankKeeper, coins("1001ungm"))
+	BeginBlocker(ctx, k, bankKeeper)
+
+	require.Equal(t, coin("251ungm"), bankKeeper.GetBalance(ctx, communityPool, stakingDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, buybackAccount, stakingDenom).IsZero())
+
+	var allocated bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != EventTypeBuyback {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, a := range ev.Attributes {
+			attrs[string(a.Key)] = string(a.Value)
+		}
+		if attrs[AttributeKeyAction] == types.ActionAllocate {
+			allocated = true
+			require.Equal(t, "251ungm", attrs[AttributeKeyAmount])
+			require.Equal(t, types.Destination_CommunityPool.String(), attrs[AttributeKeyDestination])
+		}
+	}
+	require.True(t, allocated)
+
+	params.BurnFraction = sdk.ZeroDec()
+	params.Destination = types.Destination_StakingRewards
+	k.SetParams(ctx, params)
+
+	setAccBalance(t, ctx, buybackAccount, bankKeeper, coins("400ungm"))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	BeginBlocker(ctx, k, bankKeeper)
+
+	require.Equal(t, coin("400ungm"), bankKeeper.GetBalance(ctx, feeCollector, stakingDenom))
+
+	stats := k.GetStats(ctx)
+	require.Equal(t, coins("750ungm"), stats.Burned)
+	require.Equal(t, coins("251ungm"), stats.CommunityPool)
+	require.Equal(t, coins("400ungm"), stats.StakingRewards)
+
+	history := k.GetHistory(ctx)
+	require.Len(t, history, 2)
+	require.Equal(t, coin("750ungm"), history[0].Burned)
+	require.Equal(t, coin("251ungm"), history[0].Distributed)
+	require.Equal(t, types.Destination_CommunityPool, history[0].Destination)
+	require.True(t, history[1].Burned.IsZero())
+	require.Equal(t, coin("400ungm"), history[1].Distributed)
+	require.Equal(t, types.Destination_StakingRewards, history[1].Destination)
+}
+
+func TestBuybackStrategies(t *testing.T) {
+	ctx, k, market, accountKeeper, bankKeeper := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	acc1 := createAccount(t, ctx, accountKeeper, bankKeeper, randomAddress(), "50000ungm")
+	require.NoError(t, market.NewOrderSingle(ctx, order(acc1, "5000ungm", "10000eur")))
+
+	params := types.DefaultParams()
+	params.MaxSpend = coins("20000eur")
+	params.Strategy = types.Strategy_Ladder
+	params.LadderSteps = 2
+	params.LadderSpacing = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	BeginBlocker(ctx, k, bankKeeper)
+
+	// The first step is filled at the best price while the second rests at a lower price
+	buybackAccount := accountKeeper.GetModuleAccount(ctx, ModuleName).GetAddress()
+	require.Equal(t, sdk.NewInt(40000), bankKeeper.GetBalance(ctx, buybackAccount, "eur").Amount)
+
+	orders := market.GetOrdersByOwner(ctx, buybackAccount)
+	require.Len(t, orders, 1)
+	require.Equal(t, coin("10000eur"), orders[0].Source)
+	require.Equal(t, coin("7500ungm"), orders[0].Destination)
+	require.True(t, strings.HasSuffix(orders[0].ClientOrderID, "-1"))
+
+	// Sell orders far above the last traded price are not taken
+	acc2 := createAccount(t, ctx, accountKeeper, bankKeeper, randomAddress(), "1000ungm")
+	require.NoError(t, market.NewOrderSingle(ctx, order(acc2, "1000ungm", "4000eur")))
+
+	params.Strategy = types.Strategy_AllAtBestPrice
+	params.MaxPricePremium = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	BeginBlocker(ctx, k, bankKeeper)
+
+	require.Equal(t, coins("1000ungm"), bankKeeper.GetAllBalances(ctx, acc2.GetAddress()))
+	orders = market.GetOrdersByOwner(ctx, buybackAccount)
+	require.Len(t, orders, 1)
+	require.Equal(t, coin("20000eur"), orders[0].Source)
+	require.Equal(t, coin("9090ungm"), orders[0].Destination)
+}
+
+func TestPlacements(t *testing.T) {
+	params := types.DefaultParams()
+	price := sdk.NewDecWithPrec(5, 1)
+
+	params.Strategy = types.Strategy_Twap
+	params.TwapSlices = 4
+	require.Equal(t, []placement{{coin("2501eur"), price}}, placements(params, coin("10001eur"), price))
+
+	params.MaxSpend = coins("1000eur")
+	require.Equal(t, []placement{{coin("1000eur"), price}}, placements(params, coin("10001eur"), price))
+
+	params.Strategy = types.Strategy_Ladder
+	params.LadderSteps = 3
+	params.LadderSpacing = sdk.NewDecWithPrec(1, 1)
+	require.Equal(t, []placement{
+		{coin("333eur"), price},
+		{coin("333eur"), sdk.NewDecWithPrec(55, 2)},
+		{coin("334eur"), sdk.NewDecWithPrec(6, 1)},
+	}, placements(params, coin("10001eur"), price))
+}
+
 func order(account authtypes.AccountI, src, dst string) markettypes.Order {
 	s, _ := sdk.ParseCoinNormalized(src)
 	d, _ := sdk.ParseCoinNormalized(dst)
@@ -185,8 +358,10 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, *market.Kee
 
 		blockedAddr = make(map[string]bool)
 		maccPerms   = map[string][]string{
-			AccountName:          {authtypes.Burner},
-			authtypes.ModuleName: {authtypes.Minter},
+			AccountName:                {authtypes.Burner},
+			authtypes.ModuleName:       {authtypes.Minter},
+			authtypes.FeeCollectorName: nil,
+			distrtypes.ModuleName:      nil,
 		}
 	)
 
@@ -198,6 +373,8 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, *market.Kee
 	ms.MountStoreWithDB(keyIndices, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(buybackKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -217,7 +394,7 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, *market.Kee
 
 	marketKeeper := market.NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, bk)
 
-	k := NewKeeper(encConfig.Marshaler, buybackKey, marketKeeper, ak, mockStakingKeeper{}, bk)
+	k := NewKeeper(encConfig.Marshaler, buybackKey, pk.Subspace(ModuleName), marketKeeper, ak, mockStakingKeeper{}, bk, mockDistrKeeper{bk}, authtypes.FeeCollectorName)
 	k.SetUpdateInterval(ctx, time.Hour)
 
 	// Deposit a working balance on the buyback module account.
@@ -283,6 +460,16 @@ func (mockStakingKeeper) BondDenom(sdk.Context) string {
 	return stakingDenom
 }
 
+var _ keeper.DistributionKeeper = (*mockDistrKeeper)(nil)
+
+type mockDistrKeeper struct {
+	bk bankkeeper.Keeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
+
 func mintBalance(t *testing.T, ctx sdk.Context, bk bankkeeper.Keeper, supply sdk.Coins) {
 	err := bk.MintCoins(ctx, authtypes.ModuleName, supply)
 	require.NoError(t, err)
@@ -12,9 +12,10 @@ const (
 	StoreKey     = types.StoreKey
 	QueryBalance = types.QueryBalance
 
-	EventTypeBuyback   = types.EventTypeBuyback
-	AttributeKeyAction = types.AttributeKeyAction
-	AttributeKeyAmount = types.AttributeKeyAmount
+	EventTypeBuyback        = types.EventTypeBuyback
+	AttributeKeyAction      = types.AttributeKeyAction
+	AttributeKeyAmount      = types.AttributeKeyAmount
+	AttributeKeyDestination = types.AttributeKeyDestination
 )
 
 type (
@@ -15,17 +15,50 @@ func NewGenesisState(interval time.Duration) *types.GenesisState {
 }
 
 func defaultGenesisState() *types.GenesisState {
+	params := types.DefaultParams()
 	return &types.GenesisState{
 		Interval: time.Hour.String(),
+		Params:   &params,
 	}
 }
 
+func ValidateGenesis(state types.GenesisState) error {
+	params, err := genesisParams(state)
+	if err != nil {
+		return err
+	}
+	return params.Validate()
+}
+
 func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) error {
-	updateInterval, err := time.ParseDuration(state.Interval)
+	params, err := genesisParams(state)
 	if err != nil {
 		return err
 	}
+	keeper.SetParams(ctx, params)
 
-	keeper.SetUpdateInterval(ctx, updateInterval)
+	keeper.SetStats(ctx, state.Stats)
+	if len(state.Current.Purchases) > 0 {
+		keeper.SetCurrentPeriod(ctx, state.Current)
+	}
+	for _, period := range state.History {
+		keeper.SetHistoricPeriod(ctx, period)
+	}
 	return nil
 }
+
+// genesisParams returns the parameters of the genesis state. States without parameters use the defaults and the
+// interval of the state.
+func genesisParams(state types.GenesisState) (types.Params, error) {
+	if state.Params != nil {
+		return *state.Params, nil
+	}
+
+	params := types.DefaultParams()
+	updateInterval, err := time.ParseDuration(state.Interval)
+	if err != nil {
+		return params, err
+	}
+	params.UpdateInterval = updateInterval
+	return params, nil
+}
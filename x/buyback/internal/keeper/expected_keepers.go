@@ -10,7 +10,9 @@ type (
 		NewOrderSingle(ctx sdk.Context, order market.Order) error
 		GetOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) []*market.Order
 		GetBestPrice(ctx sdk.Context, src, dst string) *sdk.Dec
+		GetInstrument(ctx sdk.Context, src, dst string) *market.MarketData
 		CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
+		AddTradeListener(l func(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin))
 	}
 
 	AccountKeeper interface {
@@ -21,6 +23,11 @@ type (
 		GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 		GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	}
+
+	DistributionKeeper interface {
+		FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 	}
 
 	StakingKeeper interface {
@@ -29,15 +29,46 @@ func (k Keeper) BuybackTime(c context.Context, req *types.QueryBuybackTimeReques
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	updateInterval := k.GetUpdateInterval(ctx)
+	params := k.GetParams(ctx)
 	lastUpdated := k.GetLastUpdated(ctx)
 
-	nextRun := lastUpdated.Add(updateInterval)
+	nextRun := lastUpdated.Add(params.UpdateInterval)
 
 	response := types.QueryBuybackTimeResponse{
 		LastRunTime: lastUpdated,
 		NextRunTime: nextRun,
+		Params:      params,
 	}
 
 	return &response, nil
 }
+
+func (k Keeper) BuybackStats(c context.Context, req *types.QueryBuybackStatsRequest) (*types.QueryBuybackStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryBuybackStatsResponse{
+		Stats:   k.GetStats(ctx),
+		Current: k.GetCurrentPeriod(ctx),
+	}
+	return &response, nil
+}
+
+func (k Keeper) BuybackHistory(c context.Context, req *types.QueryBuybackHistoryRequest) (*types.QueryBuybackHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var periods []types.BuybackPeriod
+	pageRes, err := k.paginateHistory(ctx, req.Pagination, func(period types.BuybackPeriod) {
+		periods = append(periods, period)
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBuybackHistoryResponse{Periods: periods, Pagination: pageRes}, nil
+}
@@ -6,17 +6,12 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	ctypes "github.com/cosmos/cosmos-sdk/codec/types"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/e-money/em-ledger/x/buyback/internal/types"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/libs/log"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	dbm "github.com/tendermint/tm-db"
 )
 
 func TestQueryBalance(t *testing.T) {
@@ -63,23 +58,12 @@ func TestQueryBalance(t *testing.T) {
 }
 
 func TestQueryBuybackTime(t *testing.T) {
-	storeKey := sdk.NewKVStoreKey(types.ModuleName)
-	db := dbm.NewMemDB()
-	ms := store.NewCommitMultiStore(db)
-	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
-	ms.LoadLatestVersion()
-
 	now := time.Now().UTC()
 	updateInterval := 24 * time.Hour
 
-	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain"}, true, log.NewNopLogger())
+	ctx, keeper := setupKeeper(t)
 	ctx = ctx.WithBlockTime(now)
 
-	keeper := &Keeper{
-		cdc:      codec.NewProtoCodec(ctypes.NewInterfaceRegistry()),
-		storeKey: storeKey,
-	}
-
 	keeper.SetUpdateInterval(ctx, updateInterval)
 	keeper.UpdateBuybackMarket(ctx)
 
@@ -93,6 +77,23 @@ func TestQueryBuybackTime(t *testing.T) {
 	require.NotNil(t, response)
 	require.Equal(t, now, response.LastRunTime)
 	require.Equal(t, now.Add(updateInterval), response.NextRunTime)
+	require.Equal(t, updateInterval, response.Params.UpdateInterval)
+}
+
+func TestLegacyUpdateInterval(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	require.Equal(t, types.DefaultUpdateInterval, keeper.GetUpdateInterval(ctx))
+
+	// Intervals stored before they became a parameter remain in effect until replaced
+	bz := keeper.cdc.MustMarshal(ptypes.DurationProto(time.Minute))
+	ctx.KVStore(keeper.storeKey).Set(types.GetUpdateIntervalKey(), bz)
+	require.Equal(t, time.Minute, keeper.GetParams(ctx).UpdateInterval)
+
+	params := keeper.GetParams(ctx)
+	params.UpdateInterval = 2 * time.Hour
+	keeper.SetParams(ctx, params)
+	require.Equal(t, 2*time.Hour, keeper.GetUpdateInterval(ctx))
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetUpdateIntervalKey()))
 }
 
 type bankMock struct {
@@ -113,6 +114,10 @@ func (b bankMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) e
 	panic("not expected to be called")
 }
 
+func (b bankMock) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	panic("not expected to be called")
+}
+
 type accountKeeperMock struct {
 	addr sdk.AccAddress
 }
@@ -120,3 +125,12 @@ type accountKeeperMock struct {
 func (a accountKeeperMock) GetModuleAddress(name string) sdk.AccAddress {
 	return a.addr
 }
+
+type marketKeeperMock struct {
+	MarketKeeper
+	tradeListeners []func(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin)
+}
+
+func (m *marketKeeperMock) AddTradeListener(l func(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin)) {
+	m.tradeListeners = append(m.tradeListeners, l)
+}
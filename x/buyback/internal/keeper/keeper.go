@@ -6,30 +6,49 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/e-money/em-ledger/x/buyback/internal/types"
 	market "github.com/e-money/em-ledger/x/market/types"
 	ptypes "github.com/gogo/protobuf/types"
 )
 
+// updateInterval overrides the update interval parameter when set. See fast_update.go.
+var updateInterval time.Duration
+
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey sdk.StoreKey
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	marketKeeper   MarketKeeper
 	acccountKeeper AccountKeeper
 	stakingKeeper  StakingKeeper
 	bankKeeper     BankKeeper
+	distrKeeper    DistributionKeeper
+
+	feeCollectorName string
 }
 
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey, mk MarketKeeper, ak AccountKeeper, stakingKeeper StakingKeeper, bk BankKeeper) Keeper {
-	return Keeper{
+func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramSpace paramtypes.Subspace, mk MarketKeeper, ak AccountKeeper, stakingKeeper StakingKeeper, bk BankKeeper, dk DistributionKeeper, feeCollectorName string) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	k := Keeper{
 		cdc:            cdc,
 		storeKey:       key,
+		paramSpace:     paramSpace,
 		marketKeeper:   mk,
 		acccountKeeper: ak,
 		stakingKeeper:  stakingKeeper,
 		bankKeeper:     bk,
+		distrKeeper:    dk,
+
+		feeCollectorName: feeCollectorName,
 	}
+
+	mk.AddTradeListener(k.tradeExecuted)
+	return k
 }
 
 func (k Keeper) GetBuybackAccountAddr() sdk.AccAddress {
@@ -62,6 +81,28 @@ func (k Keeper) GetBestPrice(ctx sdk.Context, src, dst string) *sdk.Dec {
 	return k.marketKeeper.GetBestPrice(ctx, src, dst)
 }
 
+// GetLastPrice returns the price of the last trade from src to dst or nil if none has taken place.
+func (k Keeper) GetLastPrice(ctx sdk.Context, src, dst string) *sdk.Dec {
+	md := k.marketKeeper.GetInstrument(ctx, src, dst)
+	if md == nil {
+		return nil
+	}
+	return md.LastPrice
+}
+
+// GetParams returns the buyback parameters. Parameters that have not been set keep their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	params.UpdateInterval = k.GetUpdateInterval(ctx)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+	ctx.KVStore(k.storeKey).Delete(types.GetUpdateIntervalKey())
+}
+
 func (k Keeper) GetStakingTokenDenom(ctx sdk.Context) string {
 	return k.stakingKeeper.BondDenom(ctx)
 }
@@ -88,6 +129,7 @@ func (k Keeper) UpdateBuybackMarket(ctx sdk.Context) bool {
 	return true
 }
 
+// BurnStakingToken burns the configured fraction of the purchased staking tokens and sends the rest to the destination.
 func (k Keeper) BurnStakingToken(ctx sdk.Context) error {
 	moduleAccountAddr := k.GetBuybackAccountAddr()
 	stakingBalance := k.bankKeeper.GetBalance(ctx, moduleAccountAddr, k.stakingKeeper.BondDenom(ctx))
@@ -95,15 +137,50 @@ func (k Keeper) BurnStakingToken(ctx sdk.Context) error {
 		return nil
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeBuyback,
-			sdk.NewAttribute(types.AttributeKeyAction, "burn"),
-			sdk.NewAttribute(types.AttributeKeyAmount, stakingBalance.String()),
-		),
-	})
+	params := k.GetParams(ctx)
+	burned, distributed := params.Split(stakingBalance)
 
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{stakingBalance})
+	if burned.IsPositive() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeBuyback,
+				sdk.NewAttribute(types.AttributeKeyAction, types.ActionBurn),
+				sdk.NewAttribute(types.AttributeKeyAmount, burned.String()),
+			),
+		})
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{burned}); err != nil {
+			return err
+		}
+	}
+
+	if distributed.IsPositive() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeBuyback,
+				sdk.NewAttribute(types.AttributeKeyAction, types.ActionAllocate),
+				sdk.NewAttribute(types.AttributeKeyAmount, distributed.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, params.Destination.String()),
+			),
+		})
+
+		if err := k.allocate(ctx, params.Destination, distributed); err != nil {
+			return err
+		}
+	}
+
+	k.recordBurn(ctx, burned, distributed, params.Destination)
+	return nil
+}
+
+func (k Keeper) allocate(ctx sdk.Context, destination types.Destination, amount sdk.Coin) error {
+	switch destination {
+	case types.Destination_StakingRewards:
+		// Fees collected are distributed to validators and delegators in the next block
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.Coins{amount})
+	default:
+		return k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{amount}, k.GetBuybackAccountAddr())
+	}
 }
 
 func (k Keeper) GetLastUpdated(ctx sdk.Context) time.Time {
@@ -124,12 +201,26 @@ func (k Keeper) GetLastUpdated(ctx sdk.Context) time.Time {
 }
 
 func (k Keeper) GetUpdateInterval(ctx sdk.Context) time.Duration {
+	if updateInterval > 0 {
+		return updateInterval
+	}
+
+	if k.paramSpace.Has(ctx, types.KeyUpdateInterval) {
+		var ui time.Duration
+		k.paramSpace.Get(ctx, types.KeyUpdateInterval, &ui)
+		return ui
+	}
+
+	// Chains started before the interval became a parameter keep it in the module store
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetUpdateIntervalKey())
+	if bz == nil {
+		return types.DefaultUpdateInterval
+	}
 
-	var updateInterval ptypes.Duration
-	k.cdc.MustUnmarshal(bz, &updateInterval)
-	ui, err := ptypes.DurationFromProto(&updateInterval)
+	var legacyInterval ptypes.Duration
+	k.cdc.MustUnmarshal(bz, &legacyInterval)
+	ui, err := ptypes.DurationFromProto(&legacyInterval)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -137,8 +228,6 @@ func (k Keeper) GetUpdateInterval(ctx sdk.Context) time.Duration {
 }
 
 func (k Keeper) SetUpdateInterval(ctx sdk.Context, newVal time.Duration) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := k.cdc.MustMarshal(ptypes.DurationProto(newVal))
-	store.Set(types.GetUpdateIntervalKey(), bz)
+	k.paramSpace.Set(ctx, types.KeyUpdateInterval, newVal)
+	ctx.KVStore(k.storeKey).Delete(types.GetUpdateIntervalKey())
 }
@@ -8,6 +8,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/e-money/em-ledger/x/buyback/internal/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -29,10 +31,14 @@ func TestUpdateBuybackMarket(t *testing.T) {
 
 func setupKeeper(t *testing.T) (sdk.Context, Keeper) {
 	buybackKey := sdk.NewKVStoreKey("buyback")
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(buybackKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -41,6 +47,8 @@ func setupKeeper(t *testing.T) (sdk.Context, Keeper) {
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
 
-	keeper := NewKeeper(marshaler, buybackKey, nil, nil, nil, nil)
+	paramSpace := paramtypes.NewSubspace(marshaler, codec.NewLegacyAmino(), keyParams, tkeyParams, types.ModuleName)
+
+	keeper := NewKeeper(marshaler, buybackKey, paramSpace, &marketKeeperMock{}, nil, nil, nil, nil, "fee_collector")
 	return ctx, keeper
 }
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/buyback/internal/types"
+)
+
+// tradeExecuted records the staking tokens bought by the module in the current period.
+func (k Keeper) tradeExecuted(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin) {
+	if !owner.Equals(k.GetBuybackAccountAddr()) || bought.Denom != k.GetStakingTokenDenom(ctx) {
+		return
+	}
+
+	current := k.GetCurrentPeriod(ctx)
+	current.Purchases = addPurchase(current.Purchases, sold, bought)
+	k.SetCurrentPeriod(ctx, current)
+
+	stats := k.GetStats(ctx)
+	stats.Purchases = addPurchase(stats.Purchases, sold, bought)
+	k.SetStats(ctx, stats)
+}
+
+// recordBurn closes the current period and adds it to the history.
+func (k Keeper) recordBurn(ctx sdk.Context, burned, distributed sdk.Coin, destination types.Destination) {
+	stats := k.GetStats(ctx)
+
+	period := k.GetCurrentPeriod(ctx)
+	period.Number = stats.Periods
+	period.StartTime = stats.LastBurnTime
+	period.EndTime = ctx.BlockTime()
+	period.Height = ctx.BlockHeight()
+	period.Burned = burned
+	period.Distributed = distributed
+	period.Destination = destination
+	k.SetHistoricPeriod(ctx, period)
+
+	stats.Burned = stats.Burned.Add(burned)
+	switch destination {
+	case types.Destination_StakingRewards:
+		stats.StakingRewards = stats.StakingRewards.Add(distributed)
+	default:
+		stats.CommunityPool = stats.CommunityPool.Add(distributed)
+	}
+	stats.Periods++
+	stats.LastBurnTime = ctx.BlockTime()
+	k.SetStats(ctx, stats)
+
+	ctx.KVStore(k.storeKey).Delete(types.GetCurrentPeriodKey())
+}
+
+func (k Keeper) GetStats(ctx sdk.Context) (stats types.BuybackStats) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStatsKey())
+	if bz == nil {
+		return types.BuybackStats{Burned: sdk.NewCoins(), CommunityPool: sdk.NewCoins(), StakingRewards: sdk.NewCoins()}
+	}
+
+	k.cdc.MustUnmarshal(bz, &stats)
+	return
+}
+
+func (k Keeper) SetStats(ctx sdk.Context, stats types.BuybackStats) {
+	ctx.KVStore(k.storeKey).Set(types.GetStatsKey(), k.cdc.MustMarshal(&stats))
+}
+
+// GetCurrentPeriod returns the purchases made since the last burn.
+func (k Keeper) GetCurrentPeriod(ctx sdk.Context) (period types.BuybackPeriod) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCurrentPeriodKey())
+	if bz == nil {
+		zero := sdk.NewCoin(k.GetStakingTokenDenom(ctx), sdk.ZeroInt())
+		return types.BuybackPeriod{Burned: zero, Distributed: zero}
+	}
+
+	k.cdc.MustUnmarshal(bz, &period)
+	return
+}
+
+func (k Keeper) SetCurrentPeriod(ctx sdk.Context, period types.BuybackPeriod) {
+	ctx.KVStore(k.storeKey).Set(types.GetCurrentPeriodKey(), k.cdc.MustMarshal(&period))
+}
+
+func (k Keeper) SetHistoricPeriod(ctx sdk.Context, period types.BuybackPeriod) {
+	ctx.KVStore(k.storeKey).Set(types.GetHistoryKey(period.Number), k.cdc.MustMarshal(&period))
+}
+
+func (k Keeper) GetHistory(ctx sdk.Context) (history []types.BuybackPeriod) {
+	_, _ = k.paginateHistory(ctx, nil, func(period types.BuybackPeriod) {
+		history = append(history, period)
+	})
+	return
+}
+
+func (k Keeper) paginateHistory(ctx sdk.Context, pagination *query.PageRequest, cb func(types.BuybackPeriod)) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryKeyPrefix)
+	if pagination == nil {
+		pagination = &query.PageRequest{Limit: query.MaxLimit}
+	}
+
+	return query.Paginate(store, pagination, func(_, value []byte) error {
+		var period types.BuybackPeriod
+		if err := k.cdc.Unmarshal(value, &period); err != nil {
+			return err
+		}
+		cb(period)
+		return nil
+	})
+}
+
+// addPurchase adds the amounts to the purchase made with the same stablecoin denomination.
+func addPurchase(purchases []types.Purchase, spent, bought sdk.Coin) []types.Purchase {
+	for i, p := range purchases {
+		if p.Spent.Denom == spent.Denom && p.Bought.Denom == bought.Denom {
+			purchases[i] = newPurchase(p.Spent.Add(spent), p.Bought.Add(bought))
+			return purchases
+		}
+	}
+
+	return append(purchases, newPurchase(spent, bought))
+}
+
+func newPurchase(spent, bought sdk.Coin) types.Purchase {
+	price := sdk.ZeroDec()
+	if bought.IsPositive() {
+		price = spent.Amount.ToDec().QuoInt(bought.Amount)
+	}
+
+	return types.Purchase{Spent: spent, Bought: bought, AveragePrice: price}
+}
@@ -0,0 +1,2032 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/buyback/v1/buyback.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Strategy decides how the stablecoins of an interval are placed in the market.
+type Strategy int32
+
+const (
+	// A single order per denomination at the best available price.
+	Strategy_AllAtBestPrice Strategy = 0
+	// Limit orders at increasingly lower prices below the best price.
+	Strategy_Ladder Strategy = 1
+	// An equal slice of the balance in each interval.
+	Strategy_Twap Strategy = 2
+)
+
+var Strategy_name = map[int32]string{
+	0: "STRATEGY_ALL_AT_BEST_PRICE",
+	1: "STRATEGY_LADDER",
+	2: "STRATEGY_TWAP",
+}
+
+var Strategy_value = map[string]int32{
+	"STRATEGY_ALL_AT_BEST_PRICE": 0,
+	"STRATEGY_LADDER":            1,
+	"STRATEGY_TWAP":              2,
+}
+
+func (x Strategy) String() string {
+	return proto.EnumName(Strategy_name, int32(x))
+}
+
+func (Strategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{0}
+}
+
+// Destination receives the purchased staking tokens that are not burned.
+type Destination int32
+
+const (
+	// The community pool of the distribution module.
+	Destination_CommunityPool Destination = 0
+	// The fee collector, from where the tokens are distributed to validators
+	// and delegators.
+	Destination_StakingRewards Destination = 1
+)
+
+var Destination_name = map[int32]string{
+	0: "DESTINATION_COMMUNITY_POOL",
+	1: "DESTINATION_STAKING_REWARDS",
+}
+
+var Destination_value = map[string]int32{
+	"DESTINATION_COMMUNITY_POOL":  0,
+	"DESTINATION_STAKING_REWARDS": 1,
+}
+
+func (x Destination) String() string {
+	return proto.EnumName(Destination_name, int32(x))
+}
+
+func (Destination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{1}
+}
+
+// Purchase sums up the staking tokens bought with a stablecoin denomination.
+type Purchase struct {
+	Spent  types.Coin `protobuf:"bytes,1,opt,name=spent,proto3" json:"spent" yaml:"spent"`
+	Bought types.Coin `protobuf:"bytes,2,opt,name=bought,proto3" json:"bought" yaml:"bought"`
+	// Stablecoins spent per staking token bought.
+	AveragePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=average_price,json=averagePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_price" yaml:"average_price"`
+}
+
+func (m *Purchase) Reset()         { *m = Purchase{} }
+func (m *Purchase) String() string { return proto.CompactTextString(m) }
+func (*Purchase) ProtoMessage()    {}
+func (*Purchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{0}
+}
+func (m *Purchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Purchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Purchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Purchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Purchase.Merge(m, src)
+}
+func (m *Purchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Purchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Purchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Purchase proto.InternalMessageInfo
+
+func (m *Purchase) GetSpent() types.Coin {
+	if m != nil {
+		return m.Spent
+	}
+	return types.Coin{}
+}
+
+func (m *Purchase) GetBought() types.Coin {
+	if m != nil {
+		return m.Bought
+	}
+	return types.Coin{}
+}
+
+// BuybackPeriod covers the purchases made since the previous burn of staking
+// tokens.
+type BuybackPeriod struct {
+	Number    uint64     `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty" yaml:"number"`
+	StartTime time.Time  `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time  `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Height    int64      `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Burned    types.Coin `protobuf:"bytes,5,opt,name=burned,proto3" json:"burned" yaml:"burned"`
+	Purchases []Purchase `protobuf:"bytes,6,rep,name=purchases,proto3" json:"purchases" yaml:"purchases"`
+	// Staking tokens that were not burned but sent to the destination.
+	Distributed types.Coin  `protobuf:"bytes,7,opt,name=distributed,proto3" json:"distributed" yaml:"distributed"`
+	Destination Destination `protobuf:"varint,8,opt,name=destination,proto3,enum=em.buyback.v1.Destination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *BuybackPeriod) Reset()         { *m = BuybackPeriod{} }
+func (m *BuybackPeriod) String() string { return proto.CompactTextString(m) }
+func (*BuybackPeriod) ProtoMessage()    {}
+func (*BuybackPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{1}
+}
+func (m *BuybackPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuybackPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuybackPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuybackPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuybackPeriod.Merge(m, src)
+}
+func (m *BuybackPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuybackPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuybackPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuybackPeriod proto.InternalMessageInfo
+
+func (m *BuybackPeriod) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *BuybackPeriod) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *BuybackPeriod) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *BuybackPeriod) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BuybackPeriod) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
+func (m *BuybackPeriod) GetPurchases() []Purchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *BuybackPeriod) GetDistributed() types.Coin {
+	if m != nil {
+		return m.Distributed
+	}
+	return types.Coin{}
+}
+
+func (m *BuybackPeriod) GetDestination() Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return Destination_CommunityPool
+}
+
+// BuybackStats holds the cumulative totals of the buyback module.
+type BuybackStats struct {
+	Burned    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned" yaml:"burned"`
+	Purchases []Purchase                               `protobuf:"bytes,2,rep,name=purchases,proto3" json:"purchases" yaml:"purchases"`
+	// Number of periods recorded in the history.
+	Periods      uint64    `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty" yaml:"periods"`
+	LastBurnTime time.Time `protobuf:"bytes,4,opt,name=last_burn,json=lastBurn,proto3,stdtime" json:"last_burn" yaml:"last_burn"`
+	// Staking tokens sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool" yaml:"community_pool"`
+	// Staking tokens paid out as staking rewards.
+	StakingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=staking_rewards,json=stakingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_rewards" yaml:"staking_rewards"`
+}
+
+func (m *BuybackStats) Reset()         { *m = BuybackStats{} }
+func (m *BuybackStats) String() string { return proto.CompactTextString(m) }
+func (*BuybackStats) ProtoMessage()    {}
+func (*BuybackStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{2}
+}
+func (m *BuybackStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuybackStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuybackStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuybackStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuybackStats.Merge(m, src)
+}
+func (m *BuybackStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuybackStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuybackStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuybackStats proto.InternalMessageInfo
+
+func (m *BuybackStats) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *BuybackStats) GetPurchases() []Purchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *BuybackStats) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *BuybackStats) GetLastBurnTime() time.Time {
+	if m != nil {
+		return m.LastBurnTime
+	}
+	return time.Time{}
+}
+
+func (m *BuybackStats) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *BuybackStats) GetStakingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StakingRewards
+	}
+	return nil
+}
+
+type Params struct {
+	// Maximum amount of each stablecoin spent per interval. Denominations that
+	// are not listed are not capped.
+	MaxSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_spend,json=maxSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_spend" yaml:"max_spend"`
+	// Maximum premium paid over the last traded price of the instrument, e.g.
+	// 0.05 for 5%. Zero disables the limit.
+	MaxPricePremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_price_premium,json=maxPricePremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_premium" yaml:"max_price_premium"`
+	Strategy        Strategy                               `protobuf:"varint,3,opt,name=strategy,proto3,enum=em.buyback.v1.Strategy" json:"strategy,omitempty" yaml:"strategy"`
+	// Number of limit orders placed by the ladder strategy.
+	LadderSteps uint32 `protobuf:"varint,4,opt,name=ladder_steps,json=ladderSteps,proto3" json:"ladder_steps,omitempty" yaml:"ladder_steps"`
+	// Price distance between consecutive ladder orders relative to the best
+	// price.
+	LadderSpacing github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=ladder_spacing,json=ladderSpacing,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ladder_spacing" yaml:"ladder_spacing"`
+	// Number of intervals the TWAP strategy spreads a balance over.
+	TwapSlices uint32 `protobuf:"varint,6,opt,name=twap_slices,json=twapSlices,proto3" json:"twap_slices,omitempty" yaml:"twap_slices"`
+	// Time between updates of the buyback orders.
+	UpdateInterval time.Duration `protobuf:"bytes,7,opt,name=update_interval,json=updateInterval,proto3,stdduration" json:"update_interval" yaml:"update_interval"`
+	// Fraction of the purchased staking tokens that is burned. The remainder is
+	// sent to the destination.
+	BurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=burn_fraction,json=burnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_fraction" yaml:"burn_fraction"`
+	Destination  Destination                            `protobuf:"varint,9,opt,name=destination,proto3,enum=em.buyback.v1.Destination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23586c3c9a84b352, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSpend
+	}
+	return nil
+}
+
+func (m *Params) GetStrategy() Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return Strategy_AllAtBestPrice
+}
+
+func (m *Params) GetLadderSteps() uint32 {
+	if m != nil {
+		return m.LadderSteps
+	}
+	return 0
+}
+
+func (m *Params) GetTwapSlices() uint32 {
+	if m != nil {
+		return m.TwapSlices
+	}
+	return 0
+}
+
+func (m *Params) GetUpdateInterval() time.Duration {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+func (m *Params) GetDestination() Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return Destination_CommunityPool
+}
+
+func init() {
+	proto.RegisterEnum("em.buyback.v1.Strategy", Strategy_name, Strategy_value)
+	proto.RegisterEnum("em.buyback.v1.Destination", Destination_name, Destination_value)
+	proto.RegisterType((*Purchase)(nil), "em.buyback.v1.Purchase")
+	proto.RegisterType((*BuybackPeriod)(nil), "em.buyback.v1.BuybackPeriod")
+	proto.RegisterType((*BuybackStats)(nil), "em.buyback.v1.BuybackStats")
+	proto.RegisterType((*Params)(nil), "em.buyback.v1.Params")
+}
+
+func init() { proto.RegisterFile("em/buyback/v1/buyback.proto", fileDescriptor_23586c3c9a84b352) }
+
+var fileDescriptor_23586c3c9a84b352 = []byte{
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x35, 0x6d, 0x59, 0x91, 0x46, 0x96, 0x6c, 0x4f, 0x5e, 0x2a, 0x8d, 0x4a, 0x02, 0x17, 0x85,
+	0x13, 0x24, 0x24, 0xec, 0xa0, 0x28, 0x90, 0x9d, 0x68, 0x29, 0x89, 0x52, 0xc7, 0x16, 0x28, 0x16,
+	0x69, 0xba, 0x21, 0x46, 0xe2, 0x44, 0x26, 0xcc, 0x17, 0x38, 0x43, 0xc7, 0x42, 0xbb, 0xea, 0xaa,
+	0x75, 0x81, 0x22, 0xcb, 0x6e, 0xbc, 0xeb, 0xa2, 0xe8, 0x97, 0x64, 0x99, 0x65, 0x50, 0xa0, 0x4a,
+	0xe1, 0xfc, 0x81, 0xbf, 0xa0, 0xe0, 0xcc, 0x90, 0x92, 0xdc, 0x20, 0x8e, 0xd0, 0xac, 0xcc, 0x3b,
+	0xf7, 0x9e, 0x33, 0x77, 0xee, 0xe3, 0x58, 0x60, 0x03, 0x7b, 0x5a, 0x3f, 0x1e, 0xf5, 0xd1, 0xe0,
+	0x50, 0x3b, 0xda, 0x4a, 0x3f, 0xd5, 0x30, 0x0a, 0x68, 0x00, 0xcb, 0xd8, 0x53, 0xd3, 0x93, 0xa3,
+	0x2d, 0xf9, 0xda, 0x30, 0x18, 0x06, 0xcc, 0xa3, 0x25, 0x5f, 0x3c, 0x48, 0xae, 0x0d, 0x02, 0xe2,
+	0x05, 0x44, 0xeb, 0x23, 0x82, 0xb5, 0xa3, 0xad, 0x3e, 0xa6, 0x68, 0x4b, 0x1b, 0x04, 0x8e, 0x9f,
+	0xfa, 0x87, 0x41, 0x30, 0x74, 0xb1, 0xc6, 0xac, 0x7e, 0xfc, 0x5c, 0xb3, 0xe3, 0x08, 0x51, 0x27,
+	0x48, 0xfd, 0xf5, 0x8b, 0x7e, 0xea, 0x78, 0x98, 0x50, 0xe4, 0x85, 0x3c, 0x40, 0xf9, 0x69, 0x11,
+	0x14, 0xba, 0x71, 0x34, 0x38, 0x40, 0x04, 0xc3, 0x36, 0x58, 0x26, 0x21, 0xf6, 0x69, 0x55, 0x6a,
+	0x48, 0x9b, 0xa5, 0xed, 0xcf, 0x54, 0x7e, 0xbb, 0x9a, 0xdc, 0xae, 0x8a, 0xdb, 0xd5, 0x9d, 0xc0,
+	0xf1, 0xf5, 0x6b, 0xaf, 0xc6, 0xf5, 0x85, 0xf3, 0x71, 0x7d, 0x65, 0x84, 0x3c, 0xf7, 0xbe, 0xc2,
+	0x50, 0x8a, 0xc1, 0xd1, 0xf0, 0x11, 0xc8, 0xf7, 0x83, 0x78, 0x78, 0x40, 0xab, 0x8b, 0x97, 0xf1,
+	0x5c, 0x17, 0x3c, 0x65, 0xce, 0xc3, 0x61, 0x8a, 0x21, 0xf0, 0xf0, 0x10, 0x94, 0xd1, 0x11, 0x8e,
+	0xd0, 0x10, 0x5b, 0x61, 0xe4, 0x0c, 0x70, 0x75, 0xa9, 0x21, 0x6d, 0x16, 0xf5, 0x07, 0x09, 0xea,
+	0xaf, 0x71, 0xfd, 0x8b, 0xa1, 0x43, 0x0f, 0xe2, 0xbe, 0x3a, 0x08, 0x3c, 0x4d, 0x14, 0x8a, 0xff,
+	0xb9, 0x4b, 0xec, 0x43, 0x8d, 0x8e, 0x42, 0x4c, 0xd4, 0x16, 0x1e, 0x9c, 0x8f, 0xeb, 0xd7, 0x38,
+	0xff, 0x0c, 0x99, 0x62, 0xac, 0x08, 0xbb, 0xcb, 0xcc, 0xbf, 0x73, 0xa0, 0xac, 0xf3, 0x86, 0x74,
+	0x71, 0xe4, 0x04, 0x36, 0xbc, 0x05, 0xf2, 0x7e, 0xec, 0xf5, 0x71, 0xc4, 0x0a, 0x92, 0xd3, 0xd7,
+	0x27, 0x99, 0xf2, 0x73, 0xc5, 0x10, 0x01, 0xf0, 0x5b, 0x00, 0x08, 0x45, 0x11, 0xb5, 0x92, 0x02,
+	0x8b, 0x77, 0xcb, 0x2a, 0xaf, 0xbe, 0x9a, 0x56, 0x5f, 0x35, 0xd3, 0xea, 0xeb, 0x9f, 0x8b, 0x87,
+	0xaf, 0x8b, 0x02, 0x66, 0x58, 0xe5, 0xe5, 0xdb, 0xba, 0x64, 0x14, 0xd9, 0x41, 0x12, 0x0e, 0x0d,
+	0x50, 0xc0, 0xbe, 0xcd, 0x79, 0x97, 0x2e, 0xe5, 0xdd, 0x10, 0xbc, 0xab, 0x9c, 0x37, 0x45, 0x72,
+	0xd6, 0x2b, 0xd8, 0xb7, 0x19, 0xe7, 0x2d, 0x90, 0x3f, 0xc0, 0x4e, 0xd2, 0xa1, 0x5c, 0x43, 0xda,
+	0x5c, 0x9a, 0x7e, 0x18, 0x3f, 0x57, 0x0c, 0x11, 0xc0, 0x9a, 0x19, 0x47, 0x3e, 0xb6, 0xab, 0xcb,
+	0xf3, 0x36, 0x93, 0xc1, 0x92, 0x66, 0xb2, 0x0f, 0xb8, 0x0f, 0x8a, 0xa1, 0x98, 0x34, 0x52, 0xcd,
+	0x37, 0x96, 0x36, 0x4b, 0xdb, 0x37, 0xd5, 0x99, 0x25, 0x50, 0xd3, 0x49, 0xd4, 0xab, 0x82, 0x6a,
+	0x8d, 0x53, 0x65, 0x38, 0xc5, 0x98, 0x70, 0xc0, 0xa7, 0xa0, 0x64, 0x3b, 0x84, 0x46, 0x4e, 0x3f,
+	0xa6, 0xd8, 0xae, 0x5e, 0xb9, 0x2c, 0x3f, 0x59, 0x90, 0x42, 0x4e, 0x3a, 0x85, 0x55, 0x8c, 0x69,
+	0x26, 0x68, 0x82, 0x92, 0x8d, 0x09, 0x75, 0x7c, 0xb6, 0x4a, 0xd5, 0x42, 0x43, 0xda, 0xac, 0x6c,
+	0xcb, 0x17, 0x72, 0x6d, 0x4d, 0x22, 0xf4, 0x1b, 0x53, 0xac, 0x93, 0xe3, 0x84, 0x75, 0xca, 0xfa,
+	0x79, 0x19, 0xac, 0x88, 0xf9, 0xea, 0x51, 0x44, 0x09, 0xa4, 0x59, 0x69, 0xa5, 0xc6, 0xd2, 0x87,
+	0x53, 0x6f, 0xbe, 0xb7, 0xb4, 0x7f, 0xbe, 0xad, 0x6f, 0x7e, 0xc4, 0x0a, 0x24, 0x0c, 0xe4, 0xfd,
+	0x6d, 0x58, 0xfc, 0x04, 0x6d, 0xb8, 0x03, 0xae, 0x84, 0x6c, 0x5f, 0x08, 0x9b, 0xcf, 0x9c, 0x0e,
+	0xcf, 0xc7, 0xf5, 0x8a, 0x40, 0x70, 0x87, 0x62, 0xa4, 0x21, 0x10, 0x83, 0xa2, 0x8b, 0x08, 0xb5,
+	0x92, 0x6c, 0xaa, 0xb9, 0x4b, 0xe7, 0xf9, 0x4e, 0x92, 0xc1, 0xd9, 0xb8, 0xbe, 0xb2, 0x8b, 0x08,
+	0xd5, 0xe3, 0xc8, 0x4f, 0x5c, 0x93, 0x8c, 0x32, 0x2a, 0x3e, 0xe0, 0x05, 0x57, 0x44, 0xc1, 0x5f,
+	0x24, 0x50, 0x19, 0x04, 0x9e, 0x17, 0xfb, 0x0e, 0x1d, 0x59, 0x61, 0x10, 0xb8, 0xd5, 0xe5, 0xcb,
+	0x8a, 0xdc, 0x11, 0xaf, 0xbd, 0xce, 0xb9, 0x67, 0xe1, 0xf3, 0x15, 0xbb, 0x9c, 0x81, 0xbb, 0x41,
+	0xe0, 0xc2, 0x5f, 0x25, 0xb0, 0x4a, 0x28, 0x3a, 0x74, 0xfc, 0xa1, 0x15, 0xe1, 0x17, 0x28, 0xb2,
+	0xd3, 0x0d, 0xf8, 0x40, 0x3a, 0x8f, 0x45, 0x3a, 0x37, 0x32, 0x89, 0x98, 0xc6, 0xcf, 0x97, 0x4f,
+	0x45, 0xa0, 0x0d, 0x01, 0x7e, 0x93, 0x07, 0xf9, 0x2e, 0x8a, 0x90, 0x47, 0xe0, 0x0f, 0xa0, 0xe8,
+	0xa1, 0x63, 0x2b, 0x91, 0xee, 0x8f, 0x18, 0xc4, 0xd6, 0xec, 0x44, 0x64, 0xc8, 0xf9, 0xd2, 0x29,
+	0x78, 0xe8, 0xb8, 0x97, 0xc0, 0xe0, 0x11, 0x58, 0x4f, 0x38, 0x98, 0x20, 0x5b, 0x61, 0x84, 0x3d,
+	0x27, 0xf6, 0x98, 0x7c, 0x16, 0xf5, 0xc7, 0x73, 0xab, 0x7c, 0x75, 0x92, 0xd4, 0x0c, 0xa1, 0x62,
+	0xac, 0x7a, 0xe8, 0x98, 0xa9, 0x7c, 0x97, 0x9f, 0xc0, 0x47, 0xa0, 0x40, 0x68, 0x84, 0x28, 0x1e,
+	0x8e, 0xd8, 0xd4, 0x56, 0xfe, 0xb3, 0x04, 0x3d, 0xe1, 0xd6, 0xaf, 0x4e, 0xe4, 0x34, 0x85, 0x28,
+	0x46, 0x86, 0x86, 0xf7, 0xc1, 0x8a, 0x8b, 0x6c, 0x1b, 0x47, 0x16, 0xa1, 0x38, 0x24, 0x6c, 0xa6,
+	0xcb, 0xfa, 0xcd, 0xf3, 0x71, 0xfd, 0x6a, 0x3a, 0xa3, 0x13, 0xaf, 0x62, 0x94, 0xb8, 0xd9, 0x4b,
+	0x2c, 0xe8, 0x83, 0x4a, 0xea, 0x0d, 0xd1, 0xc0, 0xf1, 0x87, 0x4c, 0x64, 0x8b, 0xfa, 0xc3, 0xb9,
+	0x9f, 0x7e, 0x7d, 0xf6, 0x2e, 0xce, 0xa6, 0x18, 0x65, 0x71, 0x1b, 0xb7, 0xe1, 0x57, 0xa0, 0x44,
+	0x5f, 0xa0, 0xd0, 0x22, 0xae, 0x33, 0x60, 0x22, 0x9c, 0xa4, 0x3a, 0x25, 0x5e, 0x53, 0x4e, 0xc5,
+	0x00, 0x89, 0xd5, 0x63, 0x06, 0x7c, 0x0e, 0x56, 0xe3, 0xd0, 0x46, 0x14, 0x5b, 0x8e, 0x4f, 0x71,
+	0x74, 0x84, 0xdc, 0x4c, 0x6e, 0x2f, 0xee, 0x6e, 0x4b, 0xfc, 0x02, 0xd1, 0x95, 0xd9, 0xf9, 0xbd,
+	0x80, 0x57, 0x7e, 0x4b, 0x16, 0xb6, 0xc2, 0x4f, 0x3b, 0xe2, 0x30, 0xf9, 0x87, 0x9f, 0x6c, 0xb3,
+	0xf5, 0x3c, 0x42, 0x83, 0x4c, 0x7b, 0xff, 0xc7, 0x3f, 0xfc, 0x19, 0x32, 0xc5, 0x58, 0x49, 0xec,
+	0x07, 0xc2, 0xbc, 0x28, 0xf3, 0xc5, 0x4f, 0x22, 0xf3, 0xb7, 0x7f, 0x94, 0x40, 0x21, 0x9d, 0x1d,
+	0xb8, 0x0d, 0xe4, 0x9e, 0x69, 0x34, 0xcd, 0xf6, 0xc3, 0x67, 0x56, 0x73, 0x77, 0xd7, 0x6a, 0x9a,
+	0x96, 0xde, 0xee, 0x99, 0x56, 0xd7, 0xe8, 0xec, 0xb4, 0xd7, 0x16, 0x64, 0x78, 0x72, 0xda, 0xa8,
+	0x34, 0x5d, 0xb7, 0x49, 0x75, 0x4c, 0x28, 0x9b, 0x50, 0x58, 0x07, 0xab, 0x19, 0x66, 0xb7, 0xd9,
+	0x6a, 0xb5, 0x8d, 0x35, 0x49, 0x06, 0x27, 0xa7, 0x8d, 0xfc, 0x2e, 0x6b, 0x26, 0xdc, 0x00, 0xe5,
+	0x2c, 0xc0, 0x7c, 0xda, 0xec, 0xae, 0x2d, 0xca, 0x85, 0x93, 0xd3, 0x46, 0xce, 0x7c, 0x81, 0x42,
+	0x39, 0xf7, 0xc7, 0xef, 0x35, 0xe9, 0xf6, 0xf7, 0xa0, 0x34, 0x95, 0x38, 0xdc, 0x02, 0x72, 0xab,
+	0xdd, 0x33, 0x3b, 0x7b, 0x4d, 0xb3, 0xb3, 0xbf, 0x67, 0xed, 0xec, 0x3f, 0x79, 0xf2, 0xcd, 0x5e,
+	0xc7, 0x7c, 0x66, 0x75, 0xf7, 0xf7, 0x77, 0xd7, 0x16, 0xe4, 0xf5, 0x93, 0xd3, 0x46, 0x79, 0x67,
+	0x46, 0xb2, 0xee, 0x81, 0x8d, 0x69, 0x48, 0xcf, 0x6c, 0x7e, 0xdd, 0xd9, 0x7b, 0x68, 0x19, 0xed,
+	0xa7, 0x4d, 0xa3, 0xd5, 0x5b, 0x93, 0x78, 0xea, 0xbd, 0x19, 0x59, 0xe1, 0x97, 0xeb, 0xfb, 0xaf,
+	0xce, 0x6a, 0xd2, 0xeb, 0xb3, 0x9a, 0xf4, 0xcf, 0x59, 0x4d, 0x7a, 0xf9, 0xae, 0xb6, 0xf0, 0xfa,
+	0x5d, 0x6d, 0xe1, 0xcd, 0xbb, 0xda, 0xc2, 0x77, 0x5f, 0x4e, 0xf5, 0x0f, 0xdf, 0xf5, 0x02, 0x1f,
+	0x8f, 0x34, 0xec, 0xdd, 0x75, 0xb1, 0x3d, 0xc4, 0x91, 0x76, 0x9c, 0xfd, 0x58, 0x66, 0x43, 0xe2,
+	0x23, 0x97, 0xb7, 0xb4, 0x9f, 0x67, 0xc3, 0x75, 0xef, 0xdf, 0x01, 0x00, 0x5d, 0x98, 0xff, 0x47,
+	0x50, 0x0b, 0x00, 0x00,
+}
+
+func (m *Purchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Purchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AveragePrice.Size()
+		i -= size
+		if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Bought.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Spent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BuybackPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuybackPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuybackPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Distributed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBuyback(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBuyback(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.Number != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuybackStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuybackStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuybackStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingRewards) > 0 {
+		for iNdEx := len(m.StakingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBurnTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBurnTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintBuyback(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.Periods != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.BurnFraction.Size()
+		i -= size
+		if _, err := m.BurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UpdateInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateInterval):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBuyback(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	if m.TwapSlices != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.TwapSlices))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LadderSpacing.Size()
+		i -= size
+		if _, err := m.LadderSpacing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LadderSteps != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.LadderSteps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxPricePremium.Size()
+		i -= size
+		if _, err := m.MaxPricePremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MaxSpend) > 0 {
+		for iNdEx := len(m.MaxSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBuyback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBuyback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Purchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spent.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	l = m.Bought.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	l = m.AveragePrice.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	return n
+}
+
+func (m *BuybackPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovBuyback(uint64(m.Number))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBuyback(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovBuyback(uint64(m.Height))
+	}
+	l = m.Burned.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	l = m.Distributed.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.Destination != 0 {
+		n += 1 + sovBuyback(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *BuybackStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	if m.Periods != 0 {
+		n += 1 + sovBuyback(uint64(m.Periods))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBurnTime)
+	n += 1 + l + sovBuyback(uint64(l))
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	if len(m.StakingRewards) > 0 {
+		for _, e := range m.StakingRewards {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxSpend) > 0 {
+		for _, e := range m.MaxSpend {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	l = m.MaxPricePremium.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.Strategy != 0 {
+		n += 1 + sovBuyback(uint64(m.Strategy))
+	}
+	if m.LadderSteps != 0 {
+		n += 1 + sovBuyback(uint64(m.LadderSteps))
+	}
+	l = m.LadderSpacing.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.TwapSlices != 0 {
+		n += 1 + sovBuyback(uint64(m.TwapSlices))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateInterval)
+	n += 1 + l + sovBuyback(uint64(l))
+	l = m.BurnFraction.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.Destination != 0 {
+		n += 1 + sovBuyback(uint64(m.Destination))
+	}
+	return n
+}
+
+func sovBuyback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBuyback(x uint64) (n int) {
+	return sovBuyback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Purchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bought", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bought.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuybackPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuybackPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuybackPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= Destination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuybackStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuybackStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuybackStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBurnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastBurnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingRewards = append(m.StakingRewards, types.Coin{})
+			if err := m.StakingRewards[len(m.StakingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSpend = append(m.MaxSpend, types.Coin{})
+			if err := m.MaxSpend[len(m.MaxSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPricePremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= Strategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LadderSteps", wireType)
+			}
+			m.LadderSteps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LadderSteps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LadderSpacing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LadderSpacing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapSlices", wireType)
+			}
+			m.TwapSlices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapSlices |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UpdateInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= Destination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBuyback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBuyback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBuyback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBuyback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBuyback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBuyback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBuyback = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,10 @@ package types
 const (
 	EventTypeBuyback = ModuleName
 
-	AttributeKeyAction = "action"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction      = "action"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyDestination = "destination"
+
+	ActionBurn     = "burn"
+	ActionAllocate = "allocate"
 )
@@ -24,7 +24,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Interval string `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// Deprecated: use params.update_interval. Only applied when params are not
+	// set.
+	Interval string          `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	Stats    BuybackStats    `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+	Current  BuybackPeriod   `protobuf:"bytes,3,opt,name=current,proto3" json:"current" yaml:"current"`
+	History  []BuybackPeriod `protobuf:"bytes,4,rep,name=history,proto3" json:"history" yaml:"history"`
+	// Defaults apply when not set.
+	Params *Params `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty" yaml:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +74,34 @@ func (m *GenesisState) GetInterval() string {
 	return ""
 }
 
+func (m *GenesisState) GetStats() BuybackStats {
+	if m != nil {
+		return m.Stats
+	}
+	return BuybackStats{}
+}
+
+func (m *GenesisState) GetCurrent() BuybackPeriod {
+	if m != nil {
+		return m.Current
+	}
+	return BuybackPeriod{}
+}
+
+func (m *GenesisState) GetHistory() []BuybackPeriod {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.buyback.v1.GenesisState")
 }
@@ -74,21 +109,29 @@ func init() {
 func init() { proto.RegisterFile("em/buyback/v1/genesis.proto", fileDescriptor_a3427c0e2ca82e47) }
 
 var fileDescriptor_a3427c0e2ca82e47 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xcd, 0xd5, 0x4f,
-	0x2a, 0xad, 0x4c, 0x4a, 0x4c, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4d, 0xcd, 0xd5, 0x83, 0x4a, 0xea, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf4, 0x41, 0x2c, 0x88, 0x22, 0x25, 0x6f,
-	0x2e, 0x1e, 0x77, 0x88, 0xae, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x6b, 0x2e, 0x8e, 0xcc, 0xbc,
-	0x92, 0xd4, 0xa2, 0xb2, 0xc4, 0x1c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0xf9, 0x47, 0xf7,
-	0xe4, 0x39, 0x3c, 0xa1, 0x62, 0x9f, 0xee, 0xc9, 0xf3, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xc1,
-	0x54, 0x29, 0x05, 0xc1, 0x35, 0x38, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0xaa, 0x6e,
-	0x6e, 0x7e, 0x5e, 0x6a, 0xa5, 0x7e, 0x6a, 0xae, 0x6e, 0x4e, 0x6a, 0x4a, 0x7a, 0x6a, 0x91, 0x7e,
-	0x05, 0xdc, 0x13, 0x60, 0xa3, 0xf2, 0x12, 0x73, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x8e, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x69, 0x34, 0xaf, 0x68, 0xe8, 0x00, 0x00,
-	0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4f, 0xf2, 0x40,
+	0x18, 0xc7, 0x5b, 0x78, 0xe1, 0xc5, 0x0a, 0x1a, 0x1b, 0x34, 0x0d, 0x98, 0x96, 0x74, 0x62, 0xa1,
+	0x17, 0x34, 0x2e, 0xba, 0x98, 0x2e, 0xc4, 0x45, 0x49, 0xdd, 0xdc, 0xae, 0xf0, 0xa4, 0x34, 0xb6,
+	0x3d, 0x72, 0x77, 0x10, 0xfb, 0x2d, 0xfc, 0x58, 0x8c, 0x8c, 0x4e, 0x8d, 0x29, 0xdf, 0x80, 0xc1,
+	0xd9, 0x70, 0xd7, 0x92, 0x48, 0x5c, 0xdc, 0x9e, 0xf4, 0xff, 0xff, 0xfd, 0xfa, 0xe4, 0x1e, 0xad,
+	0x0b, 0x31, 0xf2, 0x17, 0xa9, 0x8f, 0x27, 0xaf, 0x68, 0x39, 0x44, 0x01, 0x24, 0xc0, 0x42, 0xe6,
+	0xcc, 0x29, 0xe1, 0x44, 0x6f, 0x41, 0xec, 0x14, 0xa1, 0xb3, 0x1c, 0x76, 0xda, 0x01, 0x09, 0x88,
+	0x48, 0xd0, 0x6e, 0x92, 0xa5, 0xce, 0x81, 0xa1, 0xec, 0x8b, 0xd0, 0xfe, 0xaa, 0x68, 0xcd, 0x91,
+	0x74, 0x3e, 0x73, 0xcc, 0x41, 0xbf, 0xd3, 0x1a, 0x61, 0xc2, 0x81, 0x2e, 0x71, 0x64, 0xa8, 0x3d,
+	0xb5, 0x7f, 0xe4, 0x5a, 0x79, 0x66, 0x35, 0x1e, 0x8a, 0x6f, 0xdb, 0xcc, 0x3a, 0x4d, 0x71, 0x1c,
+	0xdd, 0xda, 0x65, 0xcb, 0xf6, 0xf6, 0x80, 0x3e, 0xd2, 0x6a, 0x8c, 0x63, 0xce, 0x8c, 0x4a, 0x4f,
+	0xed, 0x1f, 0x5f, 0x75, 0x9d, 0x1f, 0xfb, 0x39, 0xae, 0x1c, 0x77, 0x3f, 0x62, 0x6e, 0x7b, 0x95,
+	0x59, 0xca, 0x36, 0xb3, 0x9a, 0x52, 0x27, 0x38, 0xdb, 0x93, 0xbc, 0xfe, 0xa8, 0xfd, 0x9f, 0x2c,
+	0x28, 0x85, 0x84, 0x1b, 0x55, 0xa1, 0xba, 0xfc, 0x5d, 0x35, 0x06, 0x1a, 0x92, 0xa9, 0x7b, 0x51,
+	0xb8, 0x4e, 0xa4, 0xab, 0x40, 0x6d, 0xaf, 0x94, 0xec, 0x7c, 0xb3, 0x90, 0x71, 0x42, 0x53, 0xe3,
+	0x5f, 0xaf, 0xfa, 0x57, 0x5f, 0x81, 0xda, 0x5e, 0x29, 0xd1, 0xef, 0xb5, 0xfa, 0x1c, 0x53, 0x1c,
+	0x33, 0xa3, 0x26, 0xd6, 0x3b, 0x3f, 0xd0, 0x8d, 0x45, 0xe8, 0x9e, 0x6d, 0x33, 0xab, 0x25, 0x1d,
+	0xb2, 0x6e, 0x7b, 0x05, 0xe7, 0x3e, 0xad, 0x72, 0x53, 0x5d, 0xe7, 0xa6, 0xfa, 0x99, 0x9b, 0xea,
+	0xfb, 0xc6, 0x54, 0xd6, 0x1b, 0x53, 0xf9, 0xd8, 0x98, 0xca, 0xcb, 0x4d, 0x10, 0xf2, 0xd9, 0xc2,
+	0x77, 0x26, 0x24, 0x46, 0x30, 0x88, 0x49, 0x02, 0x29, 0x82, 0x78, 0x10, 0xc1, 0x34, 0x00, 0x8a,
+	0xde, 0xf6, 0xb7, 0x14, 0xaf, 0x9e, 0xe0, 0x08, 0xf1, 0x74, 0x0e, 0xcc, 0xaf, 0x8b, 0x83, 0x5e,
+	0x7f, 0x0f, 0x00, 0xe1, 0x99, 0x91, 0x03, 0x31, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +154,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Interval) > 0 {
 		i -= len(m.Interval)
 		copy(dAtA[i:], m.Interval)
@@ -142,6 +231,20 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Current.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -212,6 +315,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BuybackPeriod{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
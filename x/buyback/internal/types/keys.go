@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName = "buyback"
 
@@ -18,6 +20,10 @@ var (
 	keysPrefix     = []byte{0x01}
 	lastUpdatedKey = []byte("lastUpdated")
 	updateInterval = []byte("UpdateInterval")
+	statsKey       = []byte("Stats")
+	currentPeriod  = []byte("CurrentPeriod")
+
+	HistoryKeyPrefix = []byte{0x02}
 )
 
 func GetUpdateIntervalKey() []byte {
@@ -27,3 +33,15 @@ func GetUpdateIntervalKey() []byte {
 func GetLastUpdatedKey() []byte {
 	return append(keysPrefix, lastUpdatedKey...)
 }
+
+func GetStatsKey() []byte {
+	return append(keysPrefix, statsKey...)
+}
+
+func GetCurrentPeriodKey() []byte {
+	return append(keysPrefix, currentPeriod...)
+}
+
+func GetHistoryKey(number uint64) []byte {
+	return append(HistoryKeyPrefix, sdk.Uint64ToBigEndian(number)...)
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	DefaultParamspace     = ModuleName
+	DefaultUpdateInterval = time.Hour
+)
+
+// Parameter store keys
+var (
+	KeyMaxSpend        = []byte("MaxSpend")
+	KeyMaxPricePremium = []byte("MaxPricePremium")
+	KeyStrategy        = []byte("Strategy")
+	KeyLadderSteps     = []byte("LadderSteps")
+	KeyLadderSpacing   = []byte("LadderSpacing")
+	KeyTwapSlices      = []byte("TwapSlices")
+	KeyUpdateInterval  = []byte("UpdateInterval")
+	KeyBurnFraction    = []byte("BurnFraction")
+	KeyDestination     = []byte("Destination")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func DefaultParams() Params {
+	return Params{
+		MaxSpend:        sdk.NewCoins(),
+		MaxPricePremium: sdk.ZeroDec(),
+		Strategy:        Strategy_AllAtBestPrice,
+		LadderSteps:     5,
+		LadderSpacing:   sdk.NewDecWithPrec(1, 2),
+		TwapSlices:      24,
+		UpdateInterval:  DefaultUpdateInterval,
+		BurnFraction:    sdk.OneDec(),
+		Destination:     Destination_CommunityPool,
+	}
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxSpend, &p.MaxSpend, validateMaxSpend),
+		paramtypes.NewParamSetPair(KeyMaxPricePremium, &p.MaxPricePremium, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyStrategy, &p.Strategy, validateStrategy),
+		paramtypes.NewParamSetPair(KeyLadderSteps, &p.LadderSteps, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyLadderSpacing, &p.LadderSpacing, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyTwapSlices, &p.TwapSlices, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyUpdateInterval, &p.UpdateInterval, validateUpdateInterval),
+		paramtypes.NewParamSetPair(KeyBurnFraction, &p.BurnFraction, validateBurnFraction),
+		paramtypes.NewParamSetPair(KeyDestination, &p.Destination, validateDestination),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateMaxSpend(p.MaxSpend); err != nil {
+		return fmt.Errorf("max spend: %w", err)
+	}
+	if err := validateNonNegativeDec(p.MaxPricePremium); err != nil {
+		return fmt.Errorf("max price premium: %w", err)
+	}
+	if err := validateStrategy(p.Strategy); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32(p.LadderSteps); err != nil {
+		return fmt.Errorf("ladder steps: %w", err)
+	}
+	if err := validateNonNegativeDec(p.LadderSpacing); err != nil {
+		return fmt.Errorf("ladder spacing: %w", err)
+	}
+	if err := validatePositiveUint32(p.TwapSlices); err != nil {
+		return fmt.Errorf("twap slices: %w", err)
+	}
+	if err := validateUpdateInterval(p.UpdateInterval); err != nil {
+		return err
+	}
+	if err := validateBurnFraction(p.BurnFraction); err != nil {
+		return err
+	}
+	return validateDestination(p.Destination)
+}
+
+// Split divides the purchased staking tokens into the amount that is burned and the amount sent to the destination.
+func (p Params) Split(bought sdk.Coin) (burned, distributed sdk.Coin) {
+	burned = sdk.NewCoin(bought.Denom, bought.Amount.ToDec().Mul(p.BurnFraction).TruncateInt())
+	return burned, bought.Sub(burned)
+}
+
+// Spendable returns the amount of the balance that may be spent in an interval.
+func (p Params) Spendable(balance sdk.Coin) sdk.Coin {
+	if limit := p.MaxSpend.AmountOf(balance.Denom); limit.IsPositive() && limit.LT(balance.Amount) {
+		return sdk.NewCoin(balance.Denom, limit)
+	}
+	return balance
+}
+
+func validateMaxSpend(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateNonNegativeDec(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("value must not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateStrategy(i interface{}) error {
+	v, ok := i.(Strategy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, known := Strategy_name[int32(v)]; !known {
+		return fmt.Errorf("unknown strategy: %d", v)
+	}
+	return nil
+}
+
+func validatePositiveUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	return nil
+}
+
+func validateUpdateInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("update interval must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateBurnFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateDestination(i interface{}) error {
+	v, ok := i.(Destination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, known := Destination_name[int32(v)]; !known {
+		return fmt.Errorf("unknown destination: %d", v)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -154,6 +155,7 @@ var xxx_messageInfo_QueryBuybackTimeRequest proto.InternalMessageInfo
 type QueryBuybackTimeResponse struct {
 	LastRunTime time.Time `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3,stdtime" json:"last_run" yaml:"last_run"`
 	NextRunTime time.Time `protobuf:"bytes,2,opt,name=next_run,json=nextRun,proto3,stdtime" json:"next_run" yaml:"next_run"`
+	Params      Params    `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
 func (m *QueryBuybackTimeResponse) Reset()         { *m = QueryBuybackTimeResponse{} }
@@ -203,48 +205,260 @@ func (m *QueryBuybackTimeResponse) GetNextRunTime() time.Time {
 	return time.Time{}
 }
 
+func (m *QueryBuybackTimeResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryBuybackStatsRequest struct {
+}
+
+func (m *QueryBuybackStatsRequest) Reset()         { *m = QueryBuybackStatsRequest{} }
+func (m *QueryBuybackStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackStatsRequest) ProtoMessage()    {}
+func (*QueryBuybackStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848a71e982cb34d3, []int{4}
+}
+func (m *QueryBuybackStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackStatsRequest.Merge(m, src)
+}
+func (m *QueryBuybackStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackStatsRequest proto.InternalMessageInfo
+
+type QueryBuybackStatsResponse struct {
+	Stats BuybackStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+	// Purchases made since the last burn.
+	Current BuybackPeriod `protobuf:"bytes,2,opt,name=current,proto3" json:"current" yaml:"current"`
+}
+
+func (m *QueryBuybackStatsResponse) Reset()         { *m = QueryBuybackStatsResponse{} }
+func (m *QueryBuybackStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackStatsResponse) ProtoMessage()    {}
+func (*QueryBuybackStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848a71e982cb34d3, []int{5}
+}
+func (m *QueryBuybackStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackStatsResponse.Merge(m, src)
+}
+func (m *QueryBuybackStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackStatsResponse proto.InternalMessageInfo
+
+func (m *QueryBuybackStatsResponse) GetStats() BuybackStats {
+	if m != nil {
+		return m.Stats
+	}
+	return BuybackStats{}
+}
+
+func (m *QueryBuybackStatsResponse) GetCurrent() BuybackPeriod {
+	if m != nil {
+		return m.Current
+	}
+	return BuybackPeriod{}
+}
+
+type QueryBuybackHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBuybackHistoryRequest) Reset()         { *m = QueryBuybackHistoryRequest{} }
+func (m *QueryBuybackHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackHistoryRequest) ProtoMessage()    {}
+func (*QueryBuybackHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848a71e982cb34d3, []int{6}
+}
+func (m *QueryBuybackHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackHistoryRequest.Merge(m, src)
+}
+func (m *QueryBuybackHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBuybackHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBuybackHistoryResponse struct {
+	Periods    []BuybackPeriod     `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods" yaml:"periods"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBuybackHistoryResponse) Reset()         { *m = QueryBuybackHistoryResponse{} }
+func (m *QueryBuybackHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackHistoryResponse) ProtoMessage()    {}
+func (*QueryBuybackHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848a71e982cb34d3, []int{7}
+}
+func (m *QueryBuybackHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackHistoryResponse.Merge(m, src)
+}
+func (m *QueryBuybackHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBuybackHistoryResponse) GetPeriods() []BuybackPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *QueryBuybackHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "em.buyback.v1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "em.buyback.v1.QueryBalanceResponse")
 	proto.RegisterType((*QueryBuybackTimeRequest)(nil), "em.buyback.v1.QueryBuybackTimeRequest")
 	proto.RegisterType((*QueryBuybackTimeResponse)(nil), "em.buyback.v1.QueryBuybackTimeResponse")
+	proto.RegisterType((*QueryBuybackStatsRequest)(nil), "em.buyback.v1.QueryBuybackStatsRequest")
+	proto.RegisterType((*QueryBuybackStatsResponse)(nil), "em.buyback.v1.QueryBuybackStatsResponse")
+	proto.RegisterType((*QueryBuybackHistoryRequest)(nil), "em.buyback.v1.QueryBuybackHistoryRequest")
+	proto.RegisterType((*QueryBuybackHistoryResponse)(nil), "em.buyback.v1.QueryBuybackHistoryResponse")
 }
 
 func init() { proto.RegisterFile("em/buyback/v1/query.proto", fileDescriptor_848a71e982cb34d3) }
 
 var fileDescriptor_848a71e982cb34d3 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0xad, 0x8b, 0x60, 0xc8, 0x15, 0x20, 0x85, 0x21, 0xda, 0x00, 0x49, 0x95, 0x49, 0x50, 0x21,
-	0xd5, 0x56, 0x8b, 0xb8, 0x70, 0x2c, 0x57, 0x04, 0xa2, 0xda, 0x89, 0x0b, 0x72, 0xb2, 0x8f, 0x10,
-	0x2d, 0xb6, 0xb3, 0xd8, 0x29, 0xcd, 0x0d, 0xc1, 0x0f, 0x60, 0x12, 0xff, 0x82, 0x5f, 0xb2, 0xe3,
-	0x24, 0x2e, 0x9c, 0xba, 0xa9, 0xe5, 0x17, 0xec, 0x17, 0xa0, 0xc4, 0xce, 0xd4, 0xa9, 0x15, 0x70,
-	0x6a, 0xe3, 0xf7, 0xfc, 0xbd, 0xe7, 0xf7, 0x3e, 0xdc, 0x03, 0x4e, 0xc3, 0xa2, 0x0c, 0x59, 0x74,
-	0x48, 0x67, 0x23, 0x7a, 0x54, 0x40, 0x5e, 0x92, 0x2c, 0x97, 0x5a, 0x3a, 0xb7, 0x80, 0x13, 0x0b,
-	0x91, 0xd9, 0xc8, 0xdd, 0x8d, 0x65, 0x2c, 0x6b, 0x84, 0x56, 0xff, 0x0c, 0xc9, 0xf5, 0x22, 0xa9,
-	0xb8, 0x54, 0x34, 0x64, 0x0a, 0xe8, 0x6c, 0x14, 0x82, 0x66, 0x23, 0x1a, 0xc9, 0x44, 0x58, 0xfc,
-	0x61, 0x2c, 0x65, 0x9c, 0x02, 0x65, 0x59, 0x42, 0x99, 0x10, 0x52, 0x33, 0x9d, 0x48, 0xa1, 0x2c,
-	0xea, 0x5b, 0xb4, 0xfe, 0x0a, 0x8b, 0x0f, 0x54, 0x27, 0x1c, 0x94, 0x66, 0x3c, 0x33, 0x84, 0xe0,
-	0x1e, 0xbe, 0xfb, 0xb6, 0xb2, 0x34, 0x61, 0x29, 0x13, 0x11, 0x4c, 0xe1, 0xa8, 0x00, 0xa5, 0x83,
-	0x6f, 0x08, 0xef, 0x5e, 0x3d, 0x57, 0x99, 0x14, 0x0a, 0x9c, 0x4f, 0x78, 0x27, 0x34, 0x47, 0x5d,
-	0xd4, 0xbf, 0x36, 0xe8, 0x8c, 0x7b, 0xc4, 0x18, 0x24, 0x95, 0x41, 0x62, 0x0d, 0x92, 0x97, 0x32,
-	0x11, 0x93, 0xc9, 0xc9, 0xc2, 0x6f, 0x5d, 0x2c, 0xfc, 0xdb, 0x25, 0xe3, 0xe9, 0x8b, 0xc0, 0xde,
-	0x0b, 0x7e, 0x9c, 0xf9, 0x83, 0x38, 0xd1, 0x1f, 0x8b, 0x90, 0x44, 0x92, 0x53, 0xfb, 0x3e, 0xf3,
-	0x33, 0x54, 0x07, 0x87, 0x54, 0x97, 0x19, 0xa8, 0x7a, 0x84, 0x9a, 0x36, 0x6a, 0x41, 0x0f, 0xdf,
-	0x37, 0x86, 0x4c, 0x60, 0xfb, 0x09, 0xbf, 0x34, 0x7b, 0x8e, 0x70, 0x77, 0x13, 0xb3, 0x86, 0x19,
-	0xbe, 0x99, 0x32, 0xa5, 0xdf, 0xe7, 0x85, 0xe8, 0xa2, 0x3e, 0x1a, 0x74, 0xc6, 0x2e, 0x31, 0xa1,
-	0x90, 0x26, 0x14, 0xb2, 0xdf, 0x84, 0x32, 0x79, 0x5a, 0x59, 0x5e, 0x2e, 0xfc, 0xce, 0x2b, 0xa6,
-	0xf4, 0xb4, 0x10, 0x15, 0x72, 0xb1, 0xf0, 0xef, 0x98, 0x17, 0x34, 0x83, 0x82, 0xe3, 0x33, 0x1f,
-	0x4d, 0x77, 0x52, 0xc3, 0xa9, 0x24, 0x04, 0xcc, 0x8d, 0x44, 0xfb, 0xff, 0x25, 0x5e, 0xc3, 0x7c,
-	0x53, 0xa2, 0x19, 0x64, 0x25, 0x84, 0xe1, 0x8c, 0xbf, 0xb6, 0xf1, 0xf5, 0xfa, 0x89, 0x55, 0x01,
-	0xb6, 0x13, 0x27, 0x20, 0x57, 0x16, 0x88, 0x6c, 0x29, 0xd2, 0xdd, 0xfb, 0x2b, 0xc7, 0x64, 0x14,
-	0xec, 0x7d, 0xf9, 0xf9, 0xfb, 0x7b, 0xfb, 0x91, 0xf3, 0x80, 0xc2, 0x90, 0x4b, 0x01, 0xe5, 0xfa,
-	0xc6, 0xda, 0x02, 0x9c, 0xcf, 0x08, 0x77, 0xd6, 0x02, 0x76, 0x1e, 0x6f, 0x9d, 0xbc, 0xd1, 0x8e,
-	0xfb, 0xe4, 0x9f, 0x3c, 0xeb, 0xa2, 0x5f, 0xbb, 0x70, 0x9d, 0xee, 0x36, 0x17, 0xd5, 0xde, 0x4e,
-	0xde, 0x9c, 0x2c, 0x3d, 0x74, 0xba, 0xf4, 0xd0, 0xf9, 0xd2, 0x43, 0xc7, 0x2b, 0xaf, 0x75, 0xba,
-	0xf2, 0x5a, 0xbf, 0x56, 0x5e, 0xeb, 0xdd, 0xf3, 0xb5, 0x85, 0x6a, 0x6e, 0x03, 0x1f, 0xa6, 0x70,
-	0x10, 0x43, 0x4e, 0xe7, 0x97, 0x93, 0x12, 0xa1, 0x21, 0x17, 0x2c, 0x35, 0x3b, 0x16, 0xde, 0xa8,
-	0xfb, 0x79, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x86, 0x6f, 0xc7, 0x8a, 0xa5, 0x03, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0xbb, 0xf0, 0x42, 0xdf, 0x4c, 0x81, 0x37, 0xd9, 0x17, 0xb4, 0xdd, 0x62, 0x8b, 0x43,
+	0x02, 0x95, 0x84, 0x9d, 0x14, 0xe3, 0xc5, 0xe3, 0x6a, 0xc4, 0x83, 0x41, 0x5c, 0x39, 0x79, 0x31,
+	0xb3, 0xed, 0xb8, 0x6c, 0xe8, 0xce, 0x2c, 0x3b, 0xb3, 0x48, 0x6f, 0xc6, 0x78, 0x34, 0x91, 0xc4,
+	0x93, 0x5f, 0xc0, 0x83, 0x67, 0xe3, 0x67, 0xe0, 0x48, 0xe2, 0xc5, 0x53, 0x31, 0xc5, 0x4f, 0xc0,
+	0x27, 0x30, 0x3b, 0x33, 0x8b, 0xbb, 0xd0, 0x00, 0x9e, 0xda, 0xce, 0xf3, 0x3c, 0xff, 0xff, 0x6f,
+	0x9e, 0x3e, 0xcf, 0x80, 0x1a, 0x09, 0x91, 0x97, 0xf4, 0x3d, 0xdc, 0xd9, 0x41, 0x7b, 0x6d, 0xb4,
+	0x9b, 0x90, 0xb8, 0x6f, 0x47, 0x31, 0x13, 0xcc, 0x9c, 0x26, 0xa1, 0xad, 0x43, 0xf6, 0x5e, 0xdb,
+	0x9a, 0xf5, 0x99, 0xcf, 0x64, 0x04, 0xa5, 0xdf, 0x54, 0x92, 0xd5, 0xe8, 0x30, 0x1e, 0x32, 0x8e,
+	0x3c, 0xcc, 0x09, 0xda, 0x6b, 0x7b, 0x44, 0xe0, 0x36, 0xea, 0xb0, 0x80, 0xea, 0xf8, 0xbc, 0xcf,
+	0x98, 0xdf, 0x23, 0x08, 0x47, 0x01, 0xc2, 0x94, 0x32, 0x81, 0x45, 0xc0, 0x28, 0xd7, 0xd1, 0xa6,
+	0x8e, 0xca, 0x5f, 0x5e, 0xf2, 0x0a, 0x89, 0x20, 0x24, 0x5c, 0xe0, 0x30, 0xd2, 0x09, 0x2b, 0x79,
+	0x79, 0x09, 0x77, 0x66, 0x12, 0x61, 0x3f, 0xa0, 0x52, 0x4d, 0xe7, 0xd6, 0x8b, 0x57, 0xc9, 0xd0,
+	0x65, 0x10, 0xce, 0x81, 0xff, 0x9f, 0xa5, 0xe5, 0x0e, 0xee, 0x61, 0xda, 0x21, 0x2e, 0xd9, 0x4d,
+	0x08, 0x17, 0xf0, 0x83, 0x01, 0x66, 0x8b, 0xe7, 0x3c, 0x62, 0x94, 0x13, 0xf3, 0x35, 0x28, 0x7b,
+	0xea, 0xa8, 0x6a, 0x2c, 0x8c, 0xb7, 0x2a, 0x6b, 0x35, 0x5b, 0xa1, 0xd8, 0x29, 0x8a, 0xad, 0x21,
+	0xec, 0x07, 0x2c, 0xa0, 0x8e, 0x73, 0x38, 0x68, 0x96, 0x4e, 0x07, 0xcd, 0x99, 0x3e, 0x0e, 0x7b,
+	0xf7, 0xa1, 0xae, 0x83, 0x5f, 0x8e, 0x9b, 0x2d, 0x3f, 0x10, 0xdb, 0x89, 0x67, 0x77, 0x58, 0x88,
+	0xf4, 0x4d, 0xd4, 0xc7, 0x2a, 0xef, 0xee, 0x20, 0xd1, 0x8f, 0x08, 0x97, 0x12, 0xdc, 0xcd, 0xdc,
+	0x60, 0x0d, 0xdc, 0x54, 0x40, 0x0a, 0x7f, 0x2b, 0x08, 0xcf, 0x60, 0x3f, 0x8f, 0x81, 0xea, 0xc5,
+	0x98, 0x06, 0xc6, 0xe0, 0xdf, 0x1e, 0xe6, 0xe2, 0x65, 0x9c, 0xd0, 0xaa, 0xb1, 0x60, 0xb4, 0x2a,
+	0x6b, 0x96, 0xad, 0xba, 0x6b, 0x67, 0xdd, 0xb5, 0xb7, 0xb2, 0xee, 0x3a, 0x2b, 0x29, 0xf2, 0x70,
+	0xd0, 0xac, 0x3c, 0xc1, 0x5c, 0xb8, 0x09, 0x4d, 0x23, 0xa7, 0x83, 0xe6, 0x7f, 0xea, 0x06, 0x99,
+	0x10, 0x3c, 0x38, 0x6e, 0x1a, 0x6e, 0xb9, 0xa7, 0x72, 0x52, 0x0b, 0x4a, 0xf6, 0x95, 0xc5, 0xd8,
+	0xf5, 0x2d, 0x36, 0xc8, 0xfe, 0x45, 0x8b, 0x4c, 0x48, 0x5b, 0x50, 0x95, 0x63, 0x3e, 0x04, 0x93,
+	0x11, 0x8e, 0x71, 0xc8, 0xab, 0xe3, 0xd2, 0x60, 0xce, 0x2e, 0x0c, 0xa1, 0xbd, 0x29, 0x83, 0xce,
+	0x9c, 0xee, 0xf8, 0xb4, 0x12, 0x53, 0x25, 0xd0, 0xd5, 0xb5, 0xd0, 0x2a, 0xf6, 0xe9, 0xb9, 0xc0,
+	0x82, 0x67, 0x4d, 0xfc, 0x6a, 0x80, 0xda, 0x88, 0xa0, 0xee, 0xe2, 0x3a, 0x98, 0xe0, 0xe9, 0x81,
+	0x6e, 0x61, 0xfd, 0x9c, 0x7d, 0xbe, 0xc6, 0x99, 0xd5, 0x10, 0x53, 0x0a, 0x42, 0xd6, 0x41, 0x57,
+	0xd5, 0x9b, 0x1b, 0xa0, 0xdc, 0x49, 0xe2, 0x98, 0x50, 0xa1, 0x5b, 0x35, 0x3f, 0x5a, 0x6a, 0x93,
+	0xc4, 0x01, 0xeb, 0x3a, 0x37, 0x8a, 0x23, 0xa4, 0x4b, 0xa1, 0x9b, 0x89, 0xc0, 0x2e, 0xb0, 0xf2,
+	0xd4, 0x8f, 0x03, 0x2e, 0x58, 0xdc, 0xd7, 0x97, 0x32, 0x1f, 0x01, 0xf0, 0x67, 0x1d, 0x34, 0xfb,
+	0x52, 0x61, 0x60, 0xd5, 0x62, 0x67, 0x63, 0xbb, 0x89, 0xfd, 0x6c, 0xaa, 0xdc, 0x5c, 0x25, 0xfc,
+	0x66, 0x80, 0xfa, 0x48, 0x1b, 0xdd, 0x9e, 0x0d, 0x50, 0x8e, 0x24, 0x30, 0xd7, 0x5b, 0xf1, 0x57,
+	0xb7, 0xd2, 0xa5, 0xd0, 0xcd, 0x44, 0xcc, 0xf5, 0x02, 0xb7, 0x6a, 0xd4, 0xf2, 0x95, 0xdc, 0x0a,
+	0x26, 0x0f, 0xbe, 0xf6, 0xe9, 0x1f, 0x30, 0x21, 0xc1, 0xd3, 0xc5, 0xd5, 0xbb, 0x6c, 0xc2, 0x73,
+	0x70, 0x23, 0x1e, 0x00, 0x6b, 0xf1, 0xd2, 0x1c, 0xe5, 0x04, 0x17, 0xdf, 0x7e, 0xff, 0xf5, 0x71,
+	0xec, 0x96, 0x59, 0x47, 0x64, 0x35, 0x64, 0x94, 0xf4, 0x0b, 0xef, 0x8c, 0x76, 0x7b, 0x63, 0x80,
+	0x4a, 0x6e, 0x31, 0xcd, 0xa5, 0x91, 0xca, 0x17, 0xb6, 0xda, 0x5a, 0xbe, 0x32, 0x4f, 0x53, 0x2c,
+	0x48, 0x0a, 0xcb, 0xac, 0x8e, 0xa2, 0x48, 0x1f, 0x4e, 0xf3, 0x9d, 0x01, 0xa6, 0xf2, 0x23, 0x6a,
+	0x5e, 0xa6, 0x9d, 0xdf, 0x0a, 0xab, 0x75, 0x75, 0xa2, 0xa6, 0xb8, 0x2d, 0x29, 0xea, 0x66, 0x6d,
+	0x14, 0x85, 0x9a, 0xfd, 0xf7, 0x06, 0x98, 0x29, 0x0e, 0x90, 0x79, 0xe7, 0x12, 0xfd, 0xe2, 0x2c,
+	0x5b, 0x2b, 0xd7, 0x49, 0xbd, 0xce, 0x1f, 0xb3, 0xad, 0x92, 0x9d, 0xa7, 0x87, 0xc3, 0x86, 0x71,
+	0x34, 0x6c, 0x18, 0x3f, 0x87, 0x0d, 0xe3, 0xe0, 0xa4, 0x51, 0x3a, 0x3a, 0x69, 0x94, 0x7e, 0x9c,
+	0x34, 0x4a, 0x2f, 0xee, 0xe5, 0x9e, 0xe7, 0x4c, 0x80, 0x84, 0xab, 0x3d, 0xd2, 0xf5, 0x49, 0x8c,
+	0xf6, 0xcf, 0xc4, 0x02, 0x2a, 0x48, 0x4c, 0x71, 0x4f, 0xbd, 0xd8, 0xde, 0xa4, 0x7c, 0xed, 0xee,
+	0xfe, 0x1e, 0x00, 0xb4, 0x75, 0x87, 0x2e, 0x3c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +477,10 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Query for buyback time periods
 	BuybackTime(ctx context.Context, in *QueryBuybackTimeRequest, opts ...grpc.CallOption) (*QueryBuybackTimeResponse, error)
+	// Query for the cumulative staking tokens burned and stablecoins spent
+	BuybackStats(ctx context.Context, in *QueryBuybackStatsRequest, opts ...grpc.CallOption) (*QueryBuybackStatsResponse, error)
+	// Query for the purchases and burns of past buyback periods
+	BuybackHistory(ctx context.Context, in *QueryBuybackHistoryRequest, opts ...grpc.CallOption) (*QueryBuybackHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -291,12 +509,34 @@ func (c *queryClient) BuybackTime(ctx context.Context, in *QueryBuybackTimeReque
 	return out, nil
 }
 
+func (c *queryClient) BuybackStats(ctx context.Context, in *QueryBuybackStatsRequest, opts ...grpc.CallOption) (*QueryBuybackStatsResponse, error) {
+	out := new(QueryBuybackStatsResponse)
+	err := c.cc.Invoke(ctx, "/em.buyback.v1.Query/BuybackStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuybackHistory(ctx context.Context, in *QueryBuybackHistoryRequest, opts ...grpc.CallOption) (*QueryBuybackHistoryResponse, error) {
+	out := new(QueryBuybackHistoryResponse)
+	err := c.cc.Invoke(ctx, "/em.buyback.v1.Query/BuybackHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query for the current buyback balance
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Query for buyback time periods
 	BuybackTime(context.Context, *QueryBuybackTimeRequest) (*QueryBuybackTimeResponse, error)
+	// Query for the cumulative staking tokens burned and stablecoins spent
+	BuybackStats(context.Context, *QueryBuybackStatsRequest) (*QueryBuybackStatsResponse, error)
+	// Query for the purchases and burns of past buyback periods
+	BuybackHistory(context.Context, *QueryBuybackHistoryRequest) (*QueryBuybackHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -309,6 +549,12 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) BuybackTime(ctx context.Context, req *QueryBuybackTimeRequest) (*QueryBuybackTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuybackTime not implemented")
 }
+func (*UnimplementedQueryServer) BuybackStats(ctx context.Context, req *QueryBuybackStatsRequest) (*QueryBuybackStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuybackStats not implemented")
+}
+func (*UnimplementedQueryServer) BuybackHistory(ctx context.Context, req *QueryBuybackHistoryRequest) (*QueryBuybackHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuybackHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -350,6 +596,42 @@ func _Query_BuybackTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BuybackStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuybackStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuybackStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.buyback.v1.Query/BuybackStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuybackStats(ctx, req.(*QueryBuybackStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuybackHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuybackHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuybackHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.buyback.v1.Query/BuybackHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuybackHistory(ctx, req.(*QueryBuybackHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.buyback.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -362,6 +644,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuybackTime",
 			Handler:    _Query_BuybackTime_Handler,
 		},
+		{
+			MethodName: "BuybackStats",
+			Handler:    _Query_BuybackStats_Handler,
+		},
+		{
+			MethodName: "BuybackHistory",
+			Handler:    _Query_BuybackHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/buyback/v1/query.proto",
@@ -470,85 +760,301 @@ func (m *QueryBuybackTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextRunTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextRunTime):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x12
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRunTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRunTime):])
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextRunTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextRunTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintQuery(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRunTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRunTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBuybackStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBuybackStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBuybackStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBuybackTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBuybackHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBuybackTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBuybackHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRunTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextRunTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QueryBuybackHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBuybackTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBuybackTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRunTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextRunTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBuybackStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBuybackStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBuybackHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuybackHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -831,6 +1337,411 @@ func (m *QueryBuybackTimeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuybackStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuybackStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuybackStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuybackStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuybackStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuybackStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuybackHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuybackHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuybackHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuybackHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuybackHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuybackHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, BuybackPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -63,7 +63,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
-	return nil
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
@@ -99,8 +99,14 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	gs := types.GenesisState{
 		Interval: am.keeper.GetUpdateInterval(ctx).String(),
+		Stats:    am.keeper.GetStats(ctx),
+		Current:  am.keeper.GetCurrentPeriod(ctx),
+		History:  am.keeper.GetHistory(ctx),
 	}
 
+	params := am.keeper.GetParams(ctx)
+	gs.Params = &params
+
 	return cdc.MustMarshalJSON(&gs)
 }
 
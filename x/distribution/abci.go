@@ -30,14 +30,18 @@ type DistributionKeeper interface {
 		ctx sdk.Context, sumPreviousPrecommitPower, totalPreviousPower int64,
 		previousProposer sdk.ConsAddress, previousVotes []abci.VoteInfo,
 	)
+	GetPreviousProposerConsAddr(ctx sdk.Context) sdk.ConsAddress
+	SetPreviousProposerConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress)
 }
 
 // Adapted from cosmos-sdk/x/distribution/abci.go
 // A custom version was needed to keep the address of the previousProposer out of the consensus-state.
+// Once migrated with MigrateProposerToState, the previousProposer is kept in the consensus-state like the SDK does,
+// so that it is part of state-sync snapshots.
 
 // set the proposer for determining distribution during endblock
 // and distribute rewards for the previous block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) {
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, key sdk.StoreKey, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) {
 	defer telemetry.ModuleMeasureSince(ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	batch := apptypes.GetCurrentBatch(ctx)
@@ -56,8 +60,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKee
 
 	// TODO this is Tendermint-dependent
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
+	inState := IsProposerInState(ctx, key)
 	if ctx.BlockHeight() > 1 {
-		previousProposer, err := db.Get(previousProposerKey)
+		previousProposer, err := getPreviousProposer(ctx, inState, k, db)
 		if err != nil {
 			panic(err)
 		}
@@ -71,5 +76,16 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKee
 		}
 	}
 
+	if inState {
+		k.SetPreviousProposerConsAddr(ctx, req.Header.ProposerAddress)
+		return
+	}
 	batch.Set(previousProposerKey, req.Header.ProposerAddress)
 }
+
+func getPreviousProposer(ctx sdk.Context, inState bool, k DistributionKeeper, db db.DB) (sdk.ConsAddress, error) {
+	if inState {
+		return k.GetPreviousProposerConsAddr(ctx), nil
+	}
+	return db.Get(previousProposerKey)
+}
@@ -0,0 +1,112 @@
+package distribution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateProposerToState(t *testing.T) {
+	ctx, key, database := createTestComponents(t)
+	var (
+		k         = &distrKeeperMock{}
+		proposer1 = sdk.ConsAddress("proposer1")
+		proposer2 = sdk.ConsAddress("proposer2")
+		proposer3 = sdk.ConsAddress("proposer3")
+	)
+
+	// Before the migration the previous proposer is kept in the side database
+	beginBlock(ctx.WithBlockHeight(2), key, k, database, proposer1)
+	require.Nil(t, k.previousProposer)
+
+	beginBlock(ctx.WithBlockHeight(3), key, k, database, proposer2)
+	require.Equal(t, proposer1, k.allocatedTo)
+
+	require.NoError(t, MigrateProposerToState(ctx, key, k, database))
+	require.True(t, IsProposerInState(ctx, key))
+	require.Equal(t, proposer2, k.previousProposer)
+
+	// Afterwards the consensus-state is used, even if the side database is lost
+	require.NoError(t, database.Delete(previousProposerKey))
+
+	beginBlock(ctx.WithBlockHeight(4), key, k, database, proposer3)
+	require.Equal(t, proposer2, k.allocatedTo)
+	require.Equal(t, proposer3, k.previousProposer)
+
+	bz, err := database.Get(previousProposerKey)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// Migrating again does not overwrite the state
+	require.NoError(t, MigrateProposerToState(ctx, key, k, database))
+	require.Equal(t, proposer3, k.previousProposer)
+}
+
+func beginBlock(ctx sdk.Context, key sdk.StoreKey, k *distrKeeperMock, database dbm.DB, proposer sdk.ConsAddress) {
+	batch := database.NewBatch()
+	defer batch.Close()
+
+	req := abci.RequestBeginBlock{Header: tmproto.Header{ProposerAddress: proposer}}
+	BeginBlocker(apptypes.WithCurrentBatch(ctx, batch), req, key, k, accountKeeperMock{}, bankKeeperMock{}, database)
+
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+}
+
+func createTestComponents(t *testing.T) (sdk.Context, sdk.StoreKey, dbm.DB) {
+	t.Helper()
+
+	var (
+		key = sdk.NewKVStoreKey(distrtypes.StoreKey)
+		db  = dbm.NewMemDB()
+		ms  = store.NewCommitMultiStore(db)
+	)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain", Time: time.Now()}, false, log.NewNopLogger())
+	return ctx, key, dbm.NewMemDB()
+}
+
+type distrKeeperMock struct {
+	previousProposer sdk.ConsAddress
+	allocatedTo      sdk.ConsAddress
+}
+
+func (d *distrKeeperMock) AllocateTokens(_ sdk.Context, _, _ int64, previousProposer sdk.ConsAddress, _ []abci.VoteInfo) {
+	d.allocatedTo = previousProposer
+}
+
+func (d *distrKeeperMock) GetPreviousProposerConsAddr(sdk.Context) sdk.ConsAddress {
+	return d.previousProposer
+}
+
+func (d *distrKeeperMock) SetPreviousProposerConsAddr(_ sdk.Context, consAddr sdk.ConsAddress) {
+	d.previousProposer = consAddr
+}
+
+type accountKeeperMock struct{}
+
+func (accountKeeperMock) GetModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(name)
+}
+
+type bankKeeperMock struct {
+	bankkeeper.ViewKeeper
+}
+
+// Fees are always available for allocation
+func (bankKeeperMock) GetAllBalances(sdk.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("ungm", 1))
+}
@@ -0,0 +1,35 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// Marks that the previous proposer is kept in the distribution store instead of the side database.
+var proposerInStateKey = []byte("emdistr/proposerinstate")
+
+// IsProposerInState returns whether the previous proposer has been migrated to the consensus-state.
+func IsProposerInState(ctx sdk.Context, key sdk.StoreKey) bool {
+	return ctx.KVStore(key).Has(proposerInStateKey)
+}
+
+func setProposerInState(ctx sdk.Context, key sdk.StoreKey) {
+	ctx.KVStore(key).Set(proposerInStateKey, []byte{1})
+}
+
+// MigrateProposerToState copies the previous proposer from the side database into the distribution store, which is
+// used for it from then on.
+func MigrateProposerToState(ctx sdk.Context, key sdk.StoreKey, k DistributionKeeper, db db.DB) error {
+	if IsProposerInState(ctx, key) {
+		return nil
+	}
+
+	previousProposer, err := db.Get(previousProposerKey)
+	if err != nil {
+		return err
+	}
+
+	k.SetPreviousProposerConsAddr(ctx, previousProposer)
+	setProposerInState(ctx, key)
+	return nil
+}
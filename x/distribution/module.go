@@ -27,15 +27,17 @@ type AppModuleBasic struct {
 
 type AppModule struct {
 	distr.AppModule
-	k  DistributionKeeper
-	ak AccountKeeper
-	bk bankkeeper.ViewKeeper
-	db db.DB
+	key sdk.StoreKey
+	k   DistributionKeeper
+	ak  AccountKeeper
+	bk  bankkeeper.ViewKeeper
+	db  db.DB
 }
 
-func NewAppModule(nested distr.AppModule, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) AppModule {
+func NewAppModule(nested distr.AppModule, key sdk.StoreKey, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) AppModule {
 	return AppModule{
 		AppModule: nested,
+		key:       key,
 		k:         k,
 		ak:        ak,
 		bk:        bk,
@@ -43,8 +45,50 @@ func NewAppModule(nested distr.AppModule, k DistributionKeeper, ak AccountKeeper
 	}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+// Version 3 keeps the previous proposer in the consensus-state.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(cfg)
+
+	err := cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return MigrateProposerToState(ctx, am.key, am.k, am.db)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// InitGenesis initializes the distribution state. Chains started from genesis keep the previous proposer in the
+// consensus-state.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	updates := am.AppModule.InitGenesis(ctx, cdc, data)
+	setProposerInState(ctx, am.key)
+	return updates
+}
+
+// ExportGenesis exports the distribution state including the previous proposer of the side database, if not migrated.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	bz := am.AppModule.ExportGenesis(ctx, cdc)
+	if IsProposerInState(ctx, am.key) {
+		return bz
+	}
+
+	previousProposer, err := am.db.Get(previousProposerKey)
+	if err != nil {
+		panic(err)
+	}
+
+	var gs distrtypes.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+	gs.PreviousProposer = sdk.ConsAddress(previousProposer).String()
+	return cdc.MustMarshalJSON(&gs)
+}
+
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.k, am.ak, am.bk, am.db)
+	BeginBlocker(ctx, req, am.key, am.k, am.ak, am.bk, am.db)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the distribution module.
@@ -13,18 +13,13 @@ import (
 	"github.com/e-money/em-ledger/x/inflation/types"
 )
 
-const (
-	// Do not apply inflation if less than this period has elapsed since last accrual
-	minimumMintingPeriod = 10 * time.Second
-)
-
 // BeginBlocker mints new tokens for the previous block.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	state := k.GetState(ctx)
 	blockTime := ctx.BlockTime()
 
 	// Gate-keep this functionality based on time since last block to prevent a cascade of blocks
-	if blockTime.Sub(state.LastAppliedTime) < minimumMintingPeriod {
+	if blockTime.Sub(state.LastAppliedTime) < types.MinimumMintingPeriod {
 		return
 	}
 
@@ -46,6 +41,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	mintedCoins := applyInflation(&state, totalTokenSupply, blockTime)
 	state.LastAppliedHeight = sdk.NewInt(ctx.BlockHeight())
 
+	applyDemurrage(ctx, k, &state)
+
 	k.SetState(ctx, state)
 
 	if mintedCoins.IsZero() {
@@ -59,9 +56,28 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		panic(err)
 	}
 
-	// Divide into two pools: Staking tokens and Stablecoin tokens
+	// Denominations with configured targets are distributed accordingly
+	undirected := sdk.NewCoins()
+	for _, coin := range mintedCoins {
+		asset := state.FindByDenom(coin.Denom)
+		if len(asset.Distribution) == 0 {
+			undirected = undirected.Add(coin)
+			continue
+		}
+
+		// A target that cannot receive funds must not halt the chain. Fall back to the default destination instead.
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DistributeToTargets(cacheCtx, coin, asset.Distribution); err != nil {
+			k.Logger(ctx).Error("Inflation distribution failed", "denom", coin.Denom, "err", err)
+			undirected = undirected.Add(coin)
+			continue
+		}
+		write()
+	}
+
+	// Divide the rest into two pools: Staking tokens and Stablecoin tokens
 	stakingDenom := k.GetStakingDenomination(ctx)
-	stakingTokens, coinTokens := util.SplitCoinsByDenom(mintedCoins, stakingDenom)
+	stakingTokens, coinTokens := util.SplitCoinsByDenom(undirected, stakingDenom)
 
 	err = k.DistributeMintedCoins(ctx, coinTokens)
 	if err != nil {
@@ -89,31 +105,30 @@ func applyInflation(state *InflationState, totalTokenSupply sdk.Coins, currentTi
 	state.LastAppliedTime = currentTime
 
 	for i, asset := range state.InflationAssets {
-		supply := totalTokenSupply.AmountOf(asset.Denom)
-
-		accum, minted := calculateInflation(asset.Accum, supply, asset.Inflation, lastAccrual, currentTime)
+		minted := asset.Accrue(totalTokenSupply.AmountOf(asset.Denom), lastAccrual, currentTime)
 
 		if minted.IsPositive() { // Coins.IsValid() considers any coin of amount 0 to be invalid, so filter 0 coins.
 			mintedCoins = append(mintedCoins, sdk.NewCoin(asset.Denom, minted))
 		}
 
-		asset.Accum = accum
 		state.InflationAssets[i] = asset
 	}
 
 	return mintedCoins.Sort()
 }
 
-func calculateInflation(prevAccum sdk.Dec, supply sdk.Int, annualInflation sdk.Dec, lastAccrual, currentTime time.Time) (accum sdk.Dec, minted sdk.Int) {
-	annualNS := 365 * 24 * time.Hour.Nanoseconds()
-
-	periodNS := sdk.NewDec(currentTime.Sub(lastAccrual).Nanoseconds())
-	accum = annualInflation.MulInt(supply).Mul(periodNS).Add(prevAccum)
-
-	minted = accum.Quo(sdk.NewDec(annualNS)).TruncateInt()
-	accum = accum.Sub(minted.MulRaw(annualNS).ToDec())
+// applyDemurrage moves the decay accrued at negative rates into the demurrage index of each denomination. The decay is
+// burned from the holders as their balances are settled.
+func applyDemurrage(ctx sdk.Context, k Keeper, state *InflationState) {
+	for i := range state.InflationAssets {
+		asset := &state.InflationAssets[i]
+		if !asset.GetDecay().IsPositive() {
+			continue
+		}
 
-	return
+		k.ApplyDecay(ctx, asset.Denom, asset.Decay)
+		asset.Decay = sdk.ZeroDec()
+	}
 }
 
 // For use in logging
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/inflation/types"
 )
 
 func TestYearHourlyAccrual(t *testing.T) {
@@ -24,7 +25,7 @@ func TestYearHourlyAccrual(t *testing.T) {
 
 	totalMinted := sdk.ZeroInt()
 	for i := 0; i < 365*24; i++ {
-		accum, minted = calculateInflation(accum, supply, annualInflation, lastAccrual, lastAccrual.Add(time.Hour))
+		accum, minted = types.CalculateInflation(accum, supply, annualInflation, lastAccrual, lastAccrual.Add(time.Hour))
 		lastAccrual = lastAccrual.Add(time.Hour)
 		totalMinted = totalMinted.Add(minted)
 	}
@@ -59,7 +60,7 @@ func TestRandomBlockTimes(t *testing.T) {
 
 		totalDuration = totalDuration + d
 
-		accum, minted = calculateInflation(accum, supply, annualInterest, lastAccrual, lastAccrual.Add(d))
+		accum, minted = types.CalculateInflation(accum, supply, annualInterest, lastAccrual, lastAccrual.Add(d))
 		lastAccrual = lastAccrual.Add(d)
 		totalMinted = totalMinted.Add(minted)
 
@@ -98,3 +99,32 @@ func TestMultipleCoinsAccrual(t *testing.T) {
 	assert.Equal(t, sdk.NewInt(1001), supply.AmountOf("credit"))
 	assert.Equal(t, sdk.NewInt(1030454533), supply.AmountOf("buck"))
 }
+
+func TestScheduledInflationStep(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := NewInflationState(startTime, "eeur", "0.10", "echf", "0.10")
+
+	// A supply minting 10 tokens per second at 10% annual inflation
+	supply := sdk.NewCoins(
+		sdk.NewCoin("eeur", sdk.NewInt(3153600000)),
+		sdk.NewCoin("echf", sdk.NewInt(3153600000)),
+	)
+
+	state.FindByDenom("eeur").Schedule = []types.InflationStep{
+		{Time: startTime.Add(30 * time.Second), Inflation: sdk.NewDecWithPrec(20, 2)},
+		{Time: startTime.Add(90 * time.Second), Inflation: sdk.ZeroDec()},
+	}
+
+	minted := applyInflation(&state, supply, startTime.Add(time.Minute))
+	assert.Equal(t, sdk.NewInt(300+600), minted.AmountOf("eeur"))
+	assert.Equal(t, sdk.NewInt(600), minted.AmountOf("echf"))
+
+	eeur := state.FindByDenom("eeur")
+	assert.Equal(t, sdk.NewDecWithPrec(20, 2), eeur.Inflation)
+	assert.Len(t, eeur.Schedule, 1)
+
+	minted = applyInflation(&state, supply, startTime.Add(2*time.Minute))
+	assert.Equal(t, sdk.NewInt(600), minted.AmountOf("eeur"))
+	assert.True(t, state.FindByDenom("eeur").Inflation.IsZero())
+	assert.Empty(t, state.FindByDenom("eeur").Schedule)
+}
@@ -5,6 +5,8 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/e-money/em-ledger/x/inflation/types"
@@ -32,5 +34,39 @@ func GetQueryCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.AddCommand(GetProjectionCmd())
+	return cmd
+}
+
+func GetProjectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection [period]",
+		Short: "Project the inflation minted over the given period, e.g. 720h",
+		Long: `Project the inflation minted over the given period, e.g. 720h.
+The projection is a simple-interest estimate at the current supply. Tokens minted during the period are not
+compounded, so the amount actually minted is slightly higher.`,
+		Example: "emd query inflation projection 8760h",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			period, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Projection(cmd.Context(), &types.QueryProjectionRequest{Period: period})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
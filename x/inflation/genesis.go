@@ -25,12 +25,33 @@ func DefaultGenesisState() *types.GenesisState {
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetState(ctx, data.InflationState)
+
+	for _, index := range data.DemurrageIndices {
+		keeper.SetDemurrageIndex(ctx, index.Denom, index.Index)
+	}
+	for _, checkpoint := range data.DemurrageCheckpoints {
+		account, err := sdk.AccAddressFromBech32(checkpoint.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetDemurrageCheckpoint(ctx, checkpoint.Denom, account, checkpoint.Index)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	state := keeper.GetState(ctx)
-	return NewGenesisState(state)
+	genesis := NewGenesisState(keeper.GetState(ctx))
+
+	keeper.IterateDemurrageIndices(ctx, func(index types.DemurrageIndex) bool {
+		genesis.DemurrageIndices = append(genesis.DemurrageIndices, index)
+		keeper.IterateDemurrageCheckpoints(ctx, index.Denom, func(checkpoint types.DemurrageCheckpoint) bool {
+			genesis.DemurrageCheckpoints = append(genesis.DemurrageCheckpoints, checkpoint)
+			return false
+		})
+		return false
+	})
+
+	return genesis
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
@@ -41,5 +62,5 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
-	return nil
+	return types.ValidateDemurrage(data.DemurrageIndices, data.DemurrageCheckpoints)
 }
@@ -0,0 +1,175 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/x/inflation/types"
+)
+
+// Demurrage is applied lazily. Each denomination with a negative rate has an index that decays with every accrual,
+// and each account records the index its balance was last settled at. Before the balance of an account changes, the
+// decay between the two indices is burned from it. Balances are therefore decayed by the time they were actually
+// held, without touching the accounts that do not transact. Until then, balances and the total supply include the
+// decay that has not been settled.
+
+// GetDemurrageIndex returns the demurrage index of the denomination and whether it has ever decayed.
+func (k Keeper) GetDemurrageIndex(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDemurrageIndexKey(denom))
+	if bz == nil {
+		return sdk.OneDec(), false
+	}
+
+	var index sdk.Dec
+	if err := index.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return index, true
+}
+
+// SetDemurrageIndex sets the demurrage index of the denomination.
+func (k Keeper) SetDemurrageIndex(ctx sdk.Context, denom string, index sdk.Dec) {
+	bz, err := index.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetDemurrageIndexKey(denom), bz)
+}
+
+// ApplyDecay decays the demurrage index of the denomination by the given fraction.
+func (k Keeper) ApplyDecay(ctx sdk.Context, denom string, decay sdk.Dec) {
+	if !decay.IsPositive() {
+		return
+	}
+
+	index, _ := k.GetDemurrageIndex(ctx, denom)
+	k.SetDemurrageIndex(ctx, denom, index.Mul(sdk.OneDec().Sub(decay)))
+}
+
+// IterateDemurrageIndices calls cb for the index of every denomination that has decayed.
+func (k Keeper) IterateDemurrageIndices(ctx sdk.Context, cb func(index types.DemurrageIndex) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DemurrageIndexKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var index sdk.Dec
+		if err := index.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		denom := string(iterator.Key()[len(types.DemurrageIndexKeyPrefix):])
+		if cb(types.DemurrageIndex{Denom: denom, Index: index}) {
+			return
+		}
+	}
+}
+
+func (k Keeper) getDemurrageCheckpoint(ctx sdk.Context, denom string, account sdk.AccAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDemurrageCheckpointKey(denom, account))
+	if bz == nil {
+		// Balances held since before the denomination started decaying
+		return sdk.OneDec()
+	}
+
+	var index sdk.Dec
+	if err := index.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return index
+}
+
+// SetDemurrageCheckpoint sets the demurrage index the balance of the account was last settled at.
+func (k Keeper) SetDemurrageCheckpoint(ctx sdk.Context, denom string, account sdk.AccAddress, index sdk.Dec) {
+	bz, err := index.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetDemurrageCheckpointKey(denom, account), bz)
+}
+
+// IterateDemurrageCheckpoints calls cb for the checkpoint of every account in the denomination.
+func (k Keeper) IterateDemurrageCheckpoints(ctx sdk.Context, denom string, cb func(checkpoint types.DemurrageCheckpoint) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDemurrageCheckpointsKey(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var index sdk.Dec
+		if err := index.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		checkpoint := types.DemurrageCheckpoint{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Denom:   denom,
+			Index:   index,
+		}
+		if cb(checkpoint) {
+			return
+		}
+	}
+}
+
+// SettleBalance burns the demurrage accrued on the balances of the account in the given denominations since they
+// were last settled. Module accounts are exempt, and only spendable coins are burned from accounts with locked
+// balances. It must be called before the balances change.
+func (k Keeper) SettleBalance(ctx sdk.Context, account sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		index, found := k.GetDemurrageIndex(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		if err := k.settle(ctx, account, coin.Denom, index); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) settle(ctx sdk.Context, account sdk.AccAddress, denom string, index sdk.Dec) error {
+	checkpoint := k.getDemurrageCheckpoint(ctx, denom, account)
+	if !index.LT(checkpoint) {
+		return nil
+	}
+
+	if _, isModule := k.accountKeeper.GetAccount(ctx, account).(authtypes.ModuleAccountI); isModule {
+		return nil
+	}
+
+	// Update the checkpoint first, as burning changes the balance as well
+	k.SetDemurrageCheckpoint(ctx, denom, account, index)
+
+	balance := k.supplyKeeper.GetBalance(ctx, account, denom).Amount
+	amount := sdk.OneDec().Sub(index.Quo(checkpoint)).MulInt(balance).TruncateInt()
+	amount = sdk.MinInt(amount, k.supplyKeeper.SpendableCoins(ctx, account).AmountOf(denom))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(apptypes.WithoutTransferRestrictions(ctx), account, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	k.grantBurner(ctx)
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInflation,
+			sdk.NewAttribute(types.AttributeKeyAction, "demurrage"),
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	return nil
+}
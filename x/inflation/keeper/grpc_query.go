@@ -21,3 +21,66 @@ func (k Keeper) Inflation(c context.Context, req *types.QueryInflationRequest) (
 	}
 	return &response, nil
 }
+
+func (k Keeper) Projection(c context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Period < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative period")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	supply, err := k.TotalTokenSupply(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	state := k.GetState(ctx)
+	now := ctx.BlockTime()
+	if now.Before(state.LastAppliedTime) {
+		// Inflation is set to start in the future
+		now = state.LastAppliedTime
+	}
+	until := now.Add(req.Period)
+
+	// Minting requires the minimum period to have passed and never happens in the block following the last mint
+	nextMintTime := state.LastAppliedTime.Add(types.MinimumMintingPeriod)
+	if nextMintTime.Before(now) {
+		nextMintTime = now
+	}
+	nextMintHeight := ctx.BlockHeight() + 1
+	if nextMintHeight == state.LastAppliedHeight.Int64()+1 {
+		nextMintHeight++
+	}
+
+	response := types.QueryProjectionResponse{
+		LastApplied:    state.LastAppliedTime,
+		NextMintTime:   nextMintTime,
+		NextMintHeight: nextMintHeight,
+		ProjectedUntil: until,
+		Assets:         make([]types.AssetProjection, 0, len(state.InflationAssets)),
+	}
+
+	for _, asset := range state.InflationAssets {
+		amount := supply.AmountOf(asset.Denom)
+		accum := asset.Accum
+
+		// Accrue on a copy of the asset so scheduled rate changes are applied in order without touching the state
+		pending := asset.Accrue(amount, state.LastAppliedTime, now)
+		decay := asset.GetDecay()
+		projected := asset.Accrue(amount, now, until)
+
+		response.Assets = append(response.Assets, types.AssetProjection{
+			Denom:     asset.Denom,
+			Supply:    sdk.NewCoin(asset.Denom, amount),
+			Pending:   sdk.NewCoin(asset.Denom, pending),
+			Projected: sdk.NewCoin(asset.Denom, projected),
+			Accum:     accum,
+
+			ProjectedDecay: asset.GetDecay().Sub(decay),
+		})
+	}
+
+	return &response, nil
+}
@@ -41,3 +41,60 @@ func TestQueryInflation(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryProjection(t *testing.T) {
+	const year = 365 * 24 * time.Hour
+
+	input := newTestInput(t)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := input.ctx.WithBlockTime(now).WithBlockHeight(100)
+
+	myState := types.NewInflationState(now.Add(-year), "echf", "0.10", "eeur", "0.01")
+	myState.FindByDenom("eeur").Schedule = []types.InflationStep{
+		{Time: now.Add(year / 2), Inflation: sdk.MustNewDecFromStr("0.03")},
+	}
+	input.mintKeeper.SetState(ctx, myState)
+	require.NoError(t, input.mintKeeper.MintCoins(ctx, sdk.NewCoins(
+		sdk.NewCoin("echf", sdk.NewInt(1_000_000_000)),
+		sdk.NewCoin("eeur", sdk.NewInt(1_000_000_000)),
+	)))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, input.encConfig.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, input.mintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	gotRsp, gotErr := queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Period: year})
+	require.NoError(t, gotErr)
+
+	// Minting is overdue and happens with the next block
+	assert.Equal(t, now, gotRsp.NextMintTime)
+	assert.Equal(t, int64(101), gotRsp.NextMintHeight)
+	assert.Equal(t, now.Add(year), gotRsp.ProjectedUntil)
+	require.Len(t, gotRsp.Assets, 2)
+
+	assert.Equal(t, "echf", gotRsp.Assets[0].Denom)
+	assert.Equal(t, sdk.NewInt(100_000_000), gotRsp.Assets[0].Pending.Amount)
+	assert.Equal(t, sdk.NewInt(100_000_000), gotRsp.Assets[0].Projected.Amount)
+
+	// Half a year at 1% followed by half a year at 3%
+	assert.Equal(t, "eeur", gotRsp.Assets[1].Denom)
+	assert.Equal(t, sdk.NewInt(10_000_000), gotRsp.Assets[1].Pending.Amount)
+	assert.Equal(t, sdk.NewInt(20_000_000), gotRsp.Assets[1].Projected.Amount)
+
+	// The stored state is left untouched
+	assert.Equal(t, myState, input.mintKeeper.GetState(ctx))
+
+	_, gotErr = queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Period: -time.Hour})
+	require.Error(t, gotErr)
+
+	// Minted in the current block, so neither the next block nor the minimum minting period qualify
+	minted := myState
+	minted.LastAppliedTime = now
+	minted.LastAppliedHeight = sdk.NewInt(100)
+	input.mintKeeper.SetState(ctx, minted)
+
+	gotRsp, gotErr = queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Period: year})
+	require.NoError(t, gotErr)
+	assert.Equal(t, now.Add(types.MinimumMintingPeriod), gotRsp.NextMintTime)
+	assert.Equal(t, int64(102), gotRsp.NextMintHeight)
+}
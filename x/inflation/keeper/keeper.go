@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -23,6 +24,7 @@ type Keeper struct {
 	supplyKeeper  types.BankKeeper
 	stakingKeeper types.StakingKeeper
 	accountKeeper types.AccountKeeper
+	distrKeeper   types.DistributionKeeper
 
 	cointokenDestination,
 	stakingtokenDestination string
@@ -30,7 +32,7 @@ type Keeper struct {
 
 func NewKeeper(
 	cdc codec.Codec, key sdk.StoreKey, bankKeeper types.BankKeeper,
-	accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper,
+	accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	coinTokenDestination, stakingTokenDestination string,
 ) Keeper {
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -42,6 +44,8 @@ func NewKeeper(
 		storeKey:      key,
 		supplyKeeper:  bankKeeper,
 		stakingKeeper: stakingKeeper,
+		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
 
 		cointokenDestination:    coinTokenDestination,
 		stakingtokenDestination: stakingTokenDestination,
@@ -80,12 +84,61 @@ func (k Keeper) SetInflation(ctx sdk.Context, newInflation sdk.Dec, denom string
 		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "Unrecognized asset denomination: %v", denom)
 	}
 
+	if err := types.ValidateRate(newInflation); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
 	asset.Inflation = newInflation
 	k.SetState(ctx, state)
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// SetInflationSchedule replaces the announced rate changes of a denomination. All steps must lie in the future.
+func (k Keeper) SetInflationSchedule(ctx sdk.Context, denom string, schedule []types.InflationStep) (*sdk.Result, error) {
+	state := k.GetState(ctx)
+	asset := state.FindByDenom(denom)
+	if asset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "Unrecognized asset denomination: %v", denom)
+	}
+
+	if err := types.ValidateSchedule(schedule); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+	if len(schedule) > 0 && !schedule[0].Time.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "step at %v is not in the future", schedule[0].Time)
+	}
+
+	asset.Schedule = schedule
+	k.SetState(ctx, state)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// SetDistribution replaces the recipients of the tokens minted for a denomination. Empty targets restore the default
+// destination.
+func (k Keeper) SetDistribution(ctx sdk.Context, denom string, targets []types.DistributionTarget) (*sdk.Result, error) {
+	state := k.GetState(ctx)
+	asset := state.FindByDenom(denom)
+	if asset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "Unrecognized asset denomination: %v", denom)
+	}
+
+	if err := types.ValidateDistribution(targets); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+	for _, target := range targets {
+		if target.ModuleAccount != "" && k.accountKeeper.GetModuleAddress(target.ModuleAccount) == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown module account %v", target.ModuleAccount)
+		}
+	}
+
+	asset.Distribution = targets
+	k.SetState(ctx, state)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func (k Keeper) GetStakingDenomination(ctx sdk.Context) string {
 	return k.stakingKeeper.GetParams(ctx).BondDenom
 }
@@ -102,6 +155,7 @@ func (k Keeper) AddDenoms(ctx sdk.Context, denoms []string) (*sdk.Result, error)
 			Denom:     denom,
 			Inflation: sdk.ZeroDec(),
 			Accum:     sdk.ZeroDec(),
+			Decay:     sdk.ZeroDec(),
 		}
 
 		state.InflationAssets = append(state.InflationAssets, asset)
@@ -134,3 +188,61 @@ func (k Keeper) DistributeMintedCoins(ctx sdk.Context, fees sdk.Coins) error {
 func (k Keeper) DistributeStakingCoins(ctx sdk.Context, fees sdk.Coins) error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.stakingtokenDestination, fees)
 }
+
+// DistributeToTargets splits the minted coin between the targets according to their weights. Rounding remainders go
+// to the last target.
+func (k Keeper) DistributeToTargets(ctx sdk.Context, minted sdk.Coin, targets []types.DistributionTarget) error {
+	totalWeight := sdk.ZeroDec()
+	for _, target := range targets {
+		totalWeight = totalWeight.Add(target.Weight)
+	}
+
+	remaining := minted.Amount
+	for i, target := range targets {
+		share := remaining
+		if i < len(targets)-1 {
+			share = target.Weight.MulInt(minted.Amount).Quo(totalWeight).TruncateInt()
+		}
+		remaining = remaining.Sub(share)
+
+		if !share.IsPositive() {
+			continue
+		}
+
+		amount := sdk.NewCoins(sdk.NewCoin(minted.Denom, share))
+		if target.CommunityPool {
+			if err := k.distrKeeper.FundCommunityPool(ctx, amount, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if target.ModuleAccount != "" {
+			if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, target.ModuleAccount, amount); err != nil {
+				return err
+			}
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(target.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// grantBurner adds the burner permission to module accounts created before demurrage was supported.
+func (k Keeper) grantBurner(ctx sdk.Context) {
+	acc, ok := k.accountKeeper.GetAccount(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName)).(*authtypes.ModuleAccount)
+	if !ok || acc.HasPermission(authtypes.Burner) {
+		return
+	}
+
+	acc.Permissions = append(acc.Permissions, authtypes.Burner)
+	k.accountKeeper.SetModuleAccount(ctx, acc)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/e-money/em-ledger/x/inflation/types"
@@ -66,11 +67,12 @@ func newTestInput(t *testing.T) testInput {
 	require.NoError(t, err)
 
 	maccPerms := map[string][]string{
-		types.ModuleName:               {authtypes.Minter},
+		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
 		authtypes.FeeCollectorName:     nil,
 		"buyback":                      {authtypes.Burner},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		distrtypes.ModuleName:          nil,
 	}
 
 	pk := paramskeeper.NewKeeper(encConfig.Marshaler, encConfig.Amino, keyParams, tkeyParams)
@@ -88,7 +90,7 @@ func newTestInput(t *testing.T) testInput {
 	stakingKeeper := mockStakingKeeper{}
 
 	inflationKeeper := NewKeeper(
-		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, "buyback", authtypes.FeeCollectorName,
+		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, mockDistrKeeper{bankKeeper}, "buyback", authtypes.FeeCollectorName,
 	)
 	inflationKeeper.SetState(ctx, types.NewInflationState(time.Now(), "ejpy", "0.05", "echf", "0.10", "eeur", "0.01"))
 
@@ -140,3 +142,12 @@ type mockStakingKeeper struct{}
 func (m mockStakingKeeper) GetParams(_ sdk.Context) stakingtypes.Params {
 	return stakingtypes.NewParams(5*time.Minute, 40, 50, 0, "ungm")
 }
+
+// mockDistrKeeper moves community pool funds to the distribution module account without tracking the pool.
+type mockDistrKeeper struct {
+	bk bankkeeper.Keeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
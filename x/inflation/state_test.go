@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/e-money/em-ledger/x/inflation/keeper"
@@ -101,6 +102,144 @@ func TestModuleDestinations(t *testing.T) {
 	require.False(t, balances.AmountOf("ungm").IsZero())
 }
 
+func TestDistributionTargets(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	mintBalance(t, ctx, bankKeeper, coins("400000000eur,400000000chf"))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur", "chf"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "eur")
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "chf")
+
+	interestAcc := sdk.AccAddress("interest____________")
+	targets := []types.DistributionTarget{
+		{ModuleAccount: authtypes.FeeCollectorName, Weight: sdk.NewDec(1)},
+		{Address: interestAcc.String(), Weight: sdk.NewDec(3)},
+	}
+
+	_, err := keeper.SetDistribution(ctx, "eur", []types.DistributionTarget{{ModuleAccount: "unknown", Weight: sdk.NewDec(1)}})
+	require.Error(t, err)
+	_, err = keeper.SetDistribution(ctx, "eur", targets)
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(60)
+	BeginBlocker(ctx, keeper)
+
+	// Minted eur is split 1:3, chf keeps the default destination
+	feeCollector := bankKeeper.GetAllBalances(ctx, accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	interest := bankKeeper.GetAllBalances(ctx, interestAcc)
+	buyback := bankKeeper.GetAllBalances(ctx, accountKeeper.GetModuleAddress("buyback"))
+
+	require.True(t, feeCollector.AmountOf("eur").IsPositive())
+	require.True(t, feeCollector.AmountOf("eur").MulRaw(3).Sub(interest.AmountOf("eur")).Abs().LTE(sdk.NewInt(3)))
+	require.True(t, buyback.AmountOf("eur").IsZero())
+	require.True(t, buyback.AmountOf("chf").IsPositive())
+	require.Equal(t, buyback.AmountOf("chf"), feeCollector.AmountOf("eur").Add(interest.AmountOf("eur")))
+}
+
+func TestCommunityPoolTarget(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	mintBalance(t, ctx, bankKeeper, coins("400000000eur"))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "eur")
+
+	// Module accounts tracked by their own module cannot receive minted tokens directly
+	for _, name := range []string{distrtypes.ModuleName, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName} {
+		_, err := keeper.SetDistribution(ctx, "eur", []types.DistributionTarget{{ModuleAccount: name, Weight: sdk.NewDec(1)}})
+		require.Error(t, err)
+	}
+	_, err := keeper.SetDistribution(ctx, "eur", []types.DistributionTarget{{ModuleAccount: "buyback", CommunityPool: true, Weight: sdk.NewDec(1)}})
+	require.Error(t, err)
+
+	_, err = keeper.SetDistribution(ctx, "eur", []types.DistributionTarget{{CommunityPool: true, Weight: sdk.NewDec(1)}})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(60)
+	BeginBlocker(ctx, keeper)
+
+	pool := bankKeeper.GetAllBalances(ctx, accountKeeper.GetModuleAddress(distrtypes.ModuleName))
+	require.True(t, pool.AmountOf("eur").IsPositive())
+	require.True(t, bankKeeper.GetAllBalances(ctx, accountKeeper.GetModuleAddress("buyback")).IsZero())
+}
+
+func TestDemurrage(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	mintBalance(t, ctx, bankKeeper, coins("150000000eur"))
+	holder := sdk.AccAddress("holder______________")
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, holder, coins("100000000eur")))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur"})
+	_, err := keeper.SetInflation(ctx, sdk.NewDec(-1), "eur")
+	require.Error(t, err)
+	// A decay of 0.01% per day
+	_, err = keeper.SetInflation(ctx, sdk.NewDecWithPrec(-365, 4), "eur")
+	require.NoError(t, err)
+
+	advance := func(d time.Duration) {
+		currentTime = currentTime.Add(d)
+		ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(ctx.BlockHeight() + 5)
+		BeginBlocker(ctx, keeper)
+	}
+
+	settle := func(account sdk.AccAddress) {
+		require.NoError(t, keeper.SettleBalance(ctx, account, coins("1eur")))
+	}
+
+	// Balances are only decayed when settled
+	advance(24 * time.Hour)
+	require.Equal(t, sdk.NewInt(100_000_000), bankKeeper.GetBalance(ctx, holder, "eur").Amount)
+	state := keeper.GetState(ctx)
+	require.True(t, state.FindByDenom("eur").Decay.IsZero())
+	index, found := keeper.GetDemurrageIndex(ctx, "eur")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.9999"), index)
+
+	// Funds received later are only decayed from the time they were received
+	newcomer := sdk.AccAddress("newcomer____________")
+	settle(newcomer)
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, newcomer, coins("10000000eur")))
+
+	advance(24 * time.Hour)
+	settle(holder)
+	settle(newcomer)
+	require.Equal(t, sdk.NewInt(99_980_001), bankKeeper.GetBalance(ctx, holder, "eur").Amount)
+	require.Equal(t, sdk.NewInt(9_999_000), bankKeeper.GetBalance(ctx, newcomer, "eur").Amount)
+
+	// Settling twice does not burn again
+	settle(holder)
+	require.Equal(t, sdk.NewInt(99_980_001), bankKeeper.GetBalance(ctx, holder, "eur").Amount)
+
+	// Module accounts are exempt and nothing is minted at negative rates
+	settle(accountKeeper.GetModuleAddress(ModuleName))
+	require.Equal(t, sdk.NewInt(40_000_000), bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress(ModuleName), "eur").Amount)
+	require.Equal(t, coins("149979001eur"), getTotalSupply(t, ctx, bankKeeper))
+
+	// Checkpoints survive an export and import
+	genesis := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Len(t, genesis.DemurrageIndices, 1)
+	require.Len(t, genesis.DemurrageCheckpoints, 2)
+}
+
 func TestStartTimeInFuture(t *testing.T) {
 	ctx, keeper, bankKeeper, _ := createTestComponents(t)
 
@@ -166,11 +305,12 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, bankkeeper.
 	require.NoError(t, err)
 
 	maccPerms := map[string][]string{
-		ModuleName:                     {authtypes.Minter},
+		ModuleName:                     {authtypes.Minter, authtypes.Burner},
 		authtypes.FeeCollectorName:     nil,
 		"buyback":                      {authtypes.Burner},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		distrtypes.ModuleName:          nil,
 	}
 
 	pk := paramskeeper.NewKeeper(encConfig.Marshaler, encConfig.Amino, keyParams, tkeyParams)
@@ -188,7 +328,7 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, bankkeeper.
 	stakingKeeper := mockStakingKeeper{}
 
 	inflationKeeper := NewKeeper(
-		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, "buyback", authtypes.FeeCollectorName)
+		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, mockDistrKeeper{bankKeeper}, "buyback", authtypes.FeeCollectorName)
 
 	lastAppliedTime := time.Now().Add(-2400 * time.Hour)
 
@@ -239,6 +379,15 @@ func (m mockStakingKeeper) GetParams(_ sdk.Context) stakingtypes.Params {
 	return stakingtypes.NewParams(5*time.Minute, 40, 50, 0, "ungm")
 }
 
+// mockDistrKeeper moves community pool funds to the distribution module account without tracking the pool.
+type mockDistrKeeper struct {
+	bk bankkeeper.Keeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
+
 func getTotalSupply(t *testing.T, ctx sdk.Context, bk bankkeeper.Keeper) sdk.Coins {
 	totalSupply, _, err := bk.GetPaginatedTotalSupply(
 		ctx, &query.PageRequest{Limit: math.MaxUint64},
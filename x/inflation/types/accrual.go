@@ -0,0 +1,75 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Do not apply inflation if less than this period has elapsed since last accrual
+	MinimumMintingPeriod = 10 * time.Second
+)
+
+var annualNS = 365 * 24 * time.Hour.Nanoseconds()
+
+// Accrue calculates the amount minted from the supply between the two points in time. Scheduled rate changes that
+// have been reached are applied and the fractional remainder is carried in the accumulator. Periods with a negative
+// rate mint nothing and add to the decay of balances instead.
+func (asset *InflationAsset) Accrue(supply sdk.Int, lastAccrual, currentTime time.Time) sdk.Int {
+	minted := sdk.ZeroInt()
+	periodStart := lastAccrual
+
+	// Accrue at the previous rate up to each scheduled rate change that has been reached
+	for len(asset.Schedule) > 0 && !asset.Schedule[0].Time.After(currentTime) {
+		step := asset.Schedule[0]
+		if step.Time.After(periodStart) {
+			minted = minted.Add(asset.accrue(supply, periodStart, step.Time))
+			periodStart = step.Time
+		}
+
+		asset.Inflation = step.Inflation
+		asset.Schedule = asset.Schedule[1:]
+	}
+
+	return minted.Add(asset.accrue(supply, periodStart, currentTime))
+}
+
+func (asset *InflationAsset) accrue(supply sdk.Int, lastAccrual, currentTime time.Time) (minted sdk.Int) {
+	if asset.Inflation.IsNegative() {
+		asset.Decay = asset.GetDecay().Add(CalculateDecay(asset.Inflation, lastAccrual, currentTime))
+		return sdk.ZeroInt()
+	}
+
+	asset.Accum, minted = CalculateInflation(asset.Accum, supply, asset.Inflation, lastAccrual, currentTime)
+	return
+}
+
+// GetDecay returns the decay accrued since it was last applied to the demurrage index. It is nil in states exported
+// before demurrage was supported.
+func (asset InflationAsset) GetDecay() sdk.Dec {
+	if asset.Decay.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return asset.Decay
+}
+
+func CalculateInflation(prevAccum sdk.Dec, supply sdk.Int, annualInflation sdk.Dec, lastAccrual, currentTime time.Time) (accum sdk.Dec, minted sdk.Int) {
+	periodNS := sdk.NewDec(currentTime.Sub(lastAccrual).Nanoseconds())
+	accum = annualInflation.MulInt(supply).Mul(periodNS).Add(prevAccum)
+
+	minted = accum.Quo(sdk.NewDec(annualNS)).TruncateInt()
+	accum = accum.Sub(minted.MulRaw(annualNS).ToDec())
+
+	return
+}
+
+// CalculateDecay returns the fraction of a balance lost to the negative annual rate between the two points in time.
+func CalculateDecay(annualRate sdk.Dec, lastAccrual, currentTime time.Time) sdk.Dec {
+	periodNS := sdk.NewDec(currentTime.Sub(lastAccrual).Nanoseconds())
+	return annualRate.Neg().Mul(periodNS).QuoInt64(annualNS)
+}
@@ -8,6 +8,7 @@ package types
 const (
 	EventTypeInflation = ModuleName
 
-	AttributeKeyAction = "action"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction  = "action"
+	AttributeKeyAmount  = "amount"
+	AttributeKeyAccount = "account"
 )
@@ -26,7 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	// todo (reviewer): yaml naming is a bit inconsistent. state contains assets
-	InflationState InflationState `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets" yaml:"assets"`
+	InflationState       InflationState        `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets" yaml:"assets"`
+	DemurrageIndices     []DemurrageIndex      `protobuf:"bytes,2,rep,name=demurrage_indices,json=demurrageIndices,proto3" json:"demurrage_indices" yaml:"demurrage_indices"`
+	DemurrageCheckpoints []DemurrageCheckpoint `protobuf:"bytes,3,rep,name=demurrage_checkpoints,json=demurrageCheckpoints,proto3" json:"demurrage_checkpoints" yaml:"demurrage_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,20 @@ func (m *GenesisState) GetInflationState() InflationState {
 	return InflationState{}
 }
 
+func (m *GenesisState) GetDemurrageIndices() []DemurrageIndex {
+	if m != nil {
+		return m.DemurrageIndices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDemurrageCheckpoints() []DemurrageCheckpoint {
+	if m != nil {
+		return m.DemurrageCheckpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.inflation.v1.GenesisState")
 }
@@ -76,23 +92,29 @@ func init() {
 func init() { proto.RegisterFile("em/inflation/v1/genesis.proto", fileDescriptor_8d206018450f821a) }
 
 var fileDescriptor_8d206018450f821a = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0xe3, 0xa5, 0x43, 0xf8, 0x93, 0x2a, 0x06, 0x54, 0x09, 0x1b, 0x65, 0x81, 0xa5, 0xbe,
-	0x0a, 0x6c, 0x8c, 0x5d, 0x80, 0x15, 0x36, 0x26, 0x9c, 0x70, 0x31, 0x96, 0x62, 0xdf, 0xa8, 0x36,
-	0x11, 0x79, 0x0b, 0x1e, 0xab, 0x63, 0x47, 0xa6, 0x08, 0x25, 0x6f, 0xc0, 0x13, 0x20, 0x92, 0xf2,
-	0xd3, 0x6c, 0xf6, 0x3d, 0xe7, 0x7e, 0xe7, 0x9e, 0xf8, 0x18, 0x2d, 0x18, 0xf7, 0x54, 0xa8, 0x60,
-	0xc8, 0x41, 0x95, 0x82, 0x46, 0x87, 0xde, 0x78, 0x59, 0x2e, 0x29, 0xd0, 0xf4, 0x00, 0xad, 0xfc,
-	0x95, 0x65, 0x95, 0xce, 0x0e, 0x35, 0x69, 0xea, 0x35, 0xf8, 0x7e, 0x0d, 0xb6, 0x19, 0xcf, 0xc9,
-	0x5b, 0xf2, 0x90, 0x29, 0x8f, 0x50, 0xa5, 0x19, 0x06, 0x95, 0x42, 0x4e, 0xc6, 0x6d, 0x74, 0x31,
-	0x4e, 0xf9, 0x63, 0xf6, 0x86, 0xa4, 0x8c, 0x77, 0xaf, 0x86, 0xe0, 0xbb, 0xa0, 0x02, 0x4e, 0x1f,
-	0xe2, 0x89, 0xf2, 0x1e, 0x83, 0x3f, 0x62, 0x27, 0xec, 0x6c, 0xe7, 0x5c, 0xc8, 0xd1, 0x21, 0xf2,
-	0xe6, 0xe7, 0xd3, 0x2f, 0x2c, 0x4e, 0x57, 0x8d, 0x88, 0xda, 0x46, 0xec, 0x6f, 0xcf, 0x3f, 0x1b,
-	0xb1, 0x57, 0x2b, 0x5b, 0x5c, 0x26, 0x03, 0x2e, 0xb9, 0xdd, 0x70, 0x17, 0xd7, 0xab, 0x96, 0xb3,
-	0x75, 0xcb, 0xd9, 0x47, 0xcb, 0xd9, 0x5b, 0xc7, 0xa3, 0x75, 0xc7, 0xa3, 0xf7, 0x8e, 0x47, 0xf7,
-	0x52, 0x9b, 0xf0, 0xfc, 0x92, 0xc9, 0x9c, 0x2c, 0xe0, 0xdc, 0x92, 0xc3, 0x1a, 0xd0, 0xce, 0x0b,
-	0x7c, 0xd4, 0xb8, 0x84, 0xd7, 0x7f, 0x45, 0x42, 0x5d, 0xa2, 0xcf, 0x26, 0x7d, 0x85, 0x8b, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xf5, 0x69, 0xdd, 0x4b, 0x01, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcb, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x5b, 0x48, 0x58, 0xd4, 0x7b, 0x83, 0x49, 0x43, 0xb4, 0x25, 0x0d, 0x89, 0x6e, 0x98,
+	0x49, 0x75, 0xe7, 0xb2, 0x9a, 0x28, 0x5b, 0xdc, 0xb9, 0xd1, 0x69, 0x7b, 0x2c, 0x13, 0x99, 0x19,
+	0xd2, 0x19, 0x08, 0xac, 0x7c, 0x05, 0x1f, 0x8b, 0x25, 0x4b, 0x57, 0x8d, 0x96, 0x37, 0xe0, 0x09,
+	0x0c, 0x2d, 0x37, 0x41, 0x77, 0xed, 0x39, 0xff, 0xff, 0x7d, 0x93, 0x1c, 0xe3, 0x1c, 0x18, 0xa6,
+	0xfc, 0xb5, 0x4b, 0x14, 0x15, 0x1c, 0x0f, 0x3c, 0x1c, 0x03, 0x07, 0x49, 0x25, 0xea, 0x25, 0x42,
+	0x09, 0xf3, 0x08, 0x18, 0x5a, 0xad, 0xd1, 0xc0, 0xab, 0x55, 0x63, 0x11, 0x8b, 0x7c, 0x87, 0xe7,
+	0x5f, 0x45, 0xac, 0x66, 0x87, 0x42, 0x32, 0x21, 0x71, 0x40, 0x24, 0xe0, 0x81, 0x17, 0x80, 0x22,
+	0x1e, 0x0e, 0x05, 0xe5, 0x8b, 0xbd, 0xb3, 0x6d, 0x59, 0x33, 0xf3, 0x80, 0xfb, 0x5d, 0x32, 0xf6,
+	0xef, 0x0b, 0xf3, 0xa3, 0x22, 0x0a, 0xcc, 0x17, 0xa3, 0x42, 0xa4, 0x04, 0x25, 0x2d, 0xbd, 0xae,
+	0x5f, 0xee, 0x5d, 0x39, 0x68, 0xeb, 0x25, 0xa8, 0xb5, 0xfc, 0xc9, 0x0b, 0xfe, 0xc5, 0x38, 0x75,
+	0xb4, 0x2c, 0x75, 0x0e, 0x7f, 0xcf, 0x67, 0xa9, 0x73, 0x30, 0x22, 0xac, 0x7b, 0xe3, 0x16, 0x38,
+	0xb7, 0xbd, 0xe0, 0x9a, 0xdc, 0x38, 0x89, 0x80, 0xf5, 0x93, 0x84, 0xc4, 0xf0, 0x4c, 0x79, 0x44,
+	0x43, 0x90, 0x56, 0xa9, 0x5e, 0xfe, 0x53, 0x76, 0xb7, 0x4c, 0xb6, 0x78, 0x04, 0x43, 0xbf, 0x3e,
+	0x97, 0xcd, 0x52, 0xc7, 0x2a, 0xd0, 0x3b, 0x1c, 0xb7, 0x7d, 0x1c, 0x6d, 0x34, 0xe6, 0x23, 0xf3,
+	0xdd, 0x38, 0x5d, 0xe7, 0xc2, 0x0e, 0x84, 0x6f, 0x3d, 0x41, 0xb9, 0x92, 0x56, 0x39, 0x77, 0x36,
+	0xfe, 0x77, 0xde, 0xae, 0xc2, 0x7e, 0x63, 0x21, 0x3e, 0xdb, 0x16, 0x6f, 0x00, 0xdd, 0x76, 0x35,
+	0xda, 0xad, 0x4a, 0xff, 0x61, 0x9c, 0xd9, 0xfa, 0x24, 0xb3, 0xf5, 0xaf, 0xcc, 0xd6, 0x3f, 0xa6,
+	0xb6, 0x36, 0x99, 0xda, 0xda, 0xe7, 0xd4, 0xd6, 0x9e, 0x50, 0x4c, 0x55, 0xa7, 0x1f, 0xa0, 0x50,
+	0x30, 0x0c, 0x4d, 0x26, 0x38, 0x8c, 0x30, 0xb0, 0x66, 0x17, 0xa2, 0x18, 0x12, 0x3c, 0xdc, 0x38,
+	0x9d, 0x1a, 0xf5, 0x40, 0x06, 0x95, 0xfc, 0x68, 0xd7, 0x3f, 0x03, 0x00, 0x4c, 0x29, 0xe3, 0x25,
+	0x3d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +137,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DemurrageCheckpoints) > 0 {
+		for iNdEx := len(m.DemurrageCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemurrageCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DemurrageIndices) > 0 {
+		for iNdEx := len(m.DemurrageIndices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemurrageIndices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.InflationState.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +197,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.InflationState.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DemurrageIndices) > 0 {
+		for _, e := range m.DemurrageIndices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DemurrageCheckpoints) > 0 {
+		for _, e := range m.DemurrageCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +280,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemurrageIndices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemurrageIndices = append(m.DemurrageIndices, DemurrageIndex{})
+			if err := m.DemurrageIndices[len(m.DemurrageIndices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemurrageCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemurrageCheckpoints = append(m.DemurrageCheckpoints, DemurrageCheckpoint{})
+			if err := m.DemurrageCheckpoints[len(m.DemurrageCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -33,6 +33,15 @@ type InflationAsset struct {
 	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation" yaml:"inflation"`
 	Accum     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=accum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accum" yaml:"accum"`
+	// Announced rate changes in chronological order. Each step replaces the
+	// inflation rate from its time onwards and is removed once applied.
+	Schedule []InflationStep `protobuf:"bytes,4,rep,name=schedule,proto3" json:"schedule" yaml:"schedule"`
+	// Recipients of the minted tokens, weighted. When empty, minted tokens go
+	// to the default destination of stablecoins or staking tokens.
+	Distribution []DistributionTarget `protobuf:"bytes,5,rep,name=distribution,proto3" json:"distribution" yaml:"distribution"`
+	// Fraction of each balance accrued at negative rates and not yet applied to
+	// the demurrage index of the denomination.
+	Decay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=decay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay" yaml:"decay"`
 }
 
 func (m *InflationAsset) Reset()         { *m = InflationAsset{} }
@@ -75,6 +84,130 @@ func (m *InflationAsset) GetDenom() string {
 	return ""
 }
 
+func (m *InflationAsset) GetSchedule() []InflationStep {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *InflationAsset) GetDistribution() []DistributionTarget {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+// DistributionTarget receives a weighted share of the tokens minted for a
+// denomination. Exactly one of module_account, address and community_pool is
+// set.
+type DistributionTarget struct {
+	ModuleAccount string                                 `protobuf:"bytes,1,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account,omitempty"`
+	Address       string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address,omitempty"`
+	Weight        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+	// Funds the community pool of the distribution module.
+	CommunityPool bool `protobuf:"varint,4,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty" yaml:"community_pool,omitempty"`
+}
+
+func (m *DistributionTarget) Reset()         { *m = DistributionTarget{} }
+func (m *DistributionTarget) String() string { return proto.CompactTextString(m) }
+func (*DistributionTarget) ProtoMessage()    {}
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{1}
+}
+func (m *DistributionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTarget.Merge(m, src)
+}
+func (m *DistributionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionTarget proto.InternalMessageInfo
+
+func (m *DistributionTarget) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *DistributionTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DistributionTarget) GetCommunityPool() bool {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return false
+}
+
+type InflationStep struct {
+	Time      time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation" yaml:"inflation"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{2}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 type InflationState struct {
 	LastAppliedTime   time.Time                              `protobuf:"bytes,1,opt,name=last_applied,json=lastApplied,proto3,stdtime" json:"last_applied" yaml:"last_applied"`
 	LastAppliedHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_applied_height,json=lastAppliedHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_applied_height" yaml:"last_applied_height"`
@@ -84,7 +217,7 @@ type InflationState struct {
 func (m *InflationState) Reset()      { *m = InflationState{} }
 func (*InflationState) ProtoMessage() {}
 func (*InflationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25d8d858c54688c8, []int{1}
+	return fileDescriptor_25d8d858c54688c8, []int{3}
 }
 func (m *InflationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,45 +260,170 @@ func (m *InflationState) GetInflationAssets() []InflationAsset {
 	return nil
 }
 
+// DemurrageIndex is the cumulative factor that balances of a denomination have
+// decayed to at negative rates. It starts at one.
+type DemurrageIndex struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Index github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index" yaml:"index"`
+}
+
+func (m *DemurrageIndex) Reset()         { *m = DemurrageIndex{} }
+func (m *DemurrageIndex) String() string { return proto.CompactTextString(m) }
+func (*DemurrageIndex) ProtoMessage()    {}
+func (*DemurrageIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{4}
+}
+func (m *DemurrageIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemurrageIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemurrageIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemurrageIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemurrageIndex.Merge(m, src)
+}
+func (m *DemurrageIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemurrageIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemurrageIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemurrageIndex proto.InternalMessageInfo
+
+func (m *DemurrageIndex) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DemurrageCheckpoint is the demurrage index at which the balance of an
+// account was last settled.
+type DemurrageCheckpoint struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Denom   string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Index   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index" yaml:"index"`
+}
+
+func (m *DemurrageCheckpoint) Reset()         { *m = DemurrageCheckpoint{} }
+func (m *DemurrageCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*DemurrageCheckpoint) ProtoMessage()    {}
+func (*DemurrageCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{5}
+}
+func (m *DemurrageCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemurrageCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemurrageCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemurrageCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemurrageCheckpoint.Merge(m, src)
+}
+func (m *DemurrageCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemurrageCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemurrageCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemurrageCheckpoint proto.InternalMessageInfo
+
+func (m *DemurrageCheckpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DemurrageCheckpoint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InflationAsset)(nil), "em.inflation.v1.InflationAsset")
+	proto.RegisterType((*DistributionTarget)(nil), "em.inflation.v1.DistributionTarget")
+	proto.RegisterType((*InflationStep)(nil), "em.inflation.v1.InflationStep")
 	proto.RegisterType((*InflationState)(nil), "em.inflation.v1.InflationState")
+	proto.RegisterType((*DemurrageIndex)(nil), "em.inflation.v1.DemurrageIndex")
+	proto.RegisterType((*DemurrageCheckpoint)(nil), "em.inflation.v1.DemurrageCheckpoint")
 }
 
 func init() { proto.RegisterFile("em/inflation/v1/inflation.proto", fileDescriptor_25d8d858c54688c8) }
 
 var fileDescriptor_25d8d858c54688c8 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xed, 0x86, 0x56, 0xaa, 0x53, 0x68, 0x71, 0x19, 0x22, 0x0f, 0xbe, 0xea, 0x86, 0x2a,
-	0x4b, 0xee, 0x94, 0xb0, 0x75, 0x40, 0xaa, 0xc5, 0xd0, 0x4a, 0x9d, 0x4c, 0x27, 0x96, 0x72, 0x76,
-	0xde, 0x3a, 0x16, 0x3e, 0x9f, 0xe9, 0x5d, 0x22, 0x22, 0xf1, 0x21, 0x3a, 0x32, 0xf2, 0x71, 0x3a,
-	0x76, 0x44, 0x0c, 0x07, 0x4a, 0x36, 0x26, 0xe4, 0x4f, 0x80, 0x72, 0xe7, 0x34, 0x2e, 0x4c, 0x9d,
-	0xec, 0xf7, 0x8f, 0x7f, 0xef, 0xfb, 0x3c, 0x77, 0xf6, 0x10, 0x70, 0x9a, 0x97, 0xd7, 0x05, 0x53,
-	0xb9, 0x28, 0xe9, 0x6c, 0xb8, 0x09, 0x48, 0x75, 0x23, 0x94, 0xf0, 0xf7, 0x81, 0x93, 0x4d, 0x6e,
-	0x36, 0x0c, 0x5e, 0x65, 0x22, 0x13, 0xa6, 0x46, 0x57, 0x6f, 0xb6, 0x2d, 0x08, 0x53, 0x21, 0xb9,
-	0x90, 0x34, 0x61, 0x12, 0xe8, 0x6c, 0x98, 0x80, 0x62, 0x43, 0x9a, 0x8a, 0xbc, 0xc1, 0x04, 0x28,
-	0x13, 0x22, 0x2b, 0x80, 0x9a, 0x28, 0x99, 0x5e, 0x53, 0x95, 0x73, 0x90, 0x8a, 0xf1, 0xca, 0x36,
-	0xe0, 0x3f, 0xae, 0xf7, 0xe2, 0x7c, 0x3d, 0xe7, 0x54, 0x4a, 0x50, 0xfe, 0xb1, 0xb7, 0x3d, 0x86,
-	0x52, 0xf0, 0x9e, 0x7b, 0xe4, 0xf6, 0x77, 0xa3, 0x83, 0x5a, 0xa3, 0xbd, 0x39, 0xe3, 0xc5, 0x09,
-	0x36, 0x69, 0x1c, 0xdb, 0xb2, 0xff, 0xc1, 0xdb, 0x7d, 0xd8, 0xb0, 0xb7, 0x65, 0x7a, 0xa3, 0x3b,
-	0x8d, 0x9c, 0x1f, 0x1a, 0x1d, 0x67, 0xb9, 0x9a, 0x4c, 0x13, 0x92, 0x0a, 0x4e, 0x9b, 0x0d, 0xed,
-	0x63, 0x20, 0xc7, 0x1f, 0xa9, 0x9a, 0x57, 0x20, 0xc9, 0x5b, 0x48, 0x6b, 0x8d, 0x0e, 0x2c, 0xf9,
-	0x01, 0x84, 0xe3, 0x0d, 0xd4, 0xbf, 0xf4, 0xb6, 0x59, 0x9a, 0x4e, 0x79, 0xaf, 0x63, 0xe8, 0x6f,
-	0x9e, 0x4c, 0x6f, 0xf6, 0x36, 0x10, 0x1c, 0x5b, 0x18, 0xfe, 0xbd, 0xd5, 0x92, 0xfc, 0x4e, 0x31,
-	0x05, 0xfe, 0x27, 0x6f, 0xaf, 0x60, 0x52, 0x5d, 0xb1, 0xaa, 0x2a, 0x72, 0x18, 0x1b, 0xe5, 0xdd,
-	0x51, 0x40, 0xac, 0x7b, 0x64, 0xed, 0x1e, 0xb9, 0x5c, 0xbb, 0x17, 0x8d, 0x56, 0xbb, 0x2c, 0x34,
-	0xda, 0xbf, 0x60, 0x52, 0x9d, 0xda, 0xcf, 0x56, 0xd5, 0x5a, 0xa3, 0x43, 0x3b, 0xb4, 0x0d, 0xc4,
-	0xb7, 0x3f, 0x91, 0x1b, 0x77, 0x8b, 0x4d, 0xaf, 0xff, 0xc5, 0x3b, 0x6c, 0x77, 0x5c, 0x4d, 0x20,
-	0xcf, 0x26, 0xaa, 0xf1, 0xf1, 0xe2, 0x09, 0x4a, 0xcf, 0x4b, 0x55, 0x6b, 0x14, 0xfc, 0x3f, 0xb4,
-	0x41, 0xe2, 0xf8, 0x65, 0x6b, 0xee, 0x99, 0xc9, 0xf9, 0xcc, 0xdb, 0x61, 0xab, 0xc3, 0x96, 0xbd,
-	0xce, 0x51, 0xa7, 0xdf, 0x1d, 0x21, 0xf2, 0xcf, 0x7d, 0x23, 0x8f, 0x2f, 0x45, 0xd4, 0x5f, 0xeb,
-	0x7d, 0x9c, 0x97, 0xb5, 0x46, 0xcf, 0x1b, 0x93, 0x4d, 0x8c, 0xe3, 0x06, 0x7c, 0xf2, 0xec, 0xeb,
-	0x37, 0xe4, 0x44, 0x67, 0x77, 0x8b, 0xd0, 0xbd, 0x5f, 0x84, 0xee, 0xaf, 0x45, 0xe8, 0xde, 0x2e,
-	0x43, 0xe7, 0x7e, 0x19, 0x3a, 0xdf, 0x97, 0xa1, 0xf3, 0x9e, 0xb4, 0xb4, 0xc1, 0x80, 0x8b, 0x12,
-	0xe6, 0x14, 0xf8, 0xa0, 0x80, 0x71, 0x06, 0x37, 0xf4, 0x73, 0xeb, 0xf7, 0x30, 0x3a, 0x93, 0x1d,
-	0x73, 0x0a, 0xaf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xef, 0x3d, 0xfd, 0xd2, 0x3b, 0x03, 0x00,
-	0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x6f, 0xf3, 0x44,
+	0x10, 0xc7, 0xe3, 0xbc, 0xd1, 0x6e, 0xda, 0xb4, 0xb8, 0x48, 0x58, 0x01, 0x79, 0xab, 0xad, 0x54,
+	0xe5, 0xd0, 0xda, 0x4a, 0x91, 0x38, 0xf4, 0x00, 0x6a, 0xa8, 0x44, 0x8b, 0x7a, 0x40, 0x6e, 0x24,
+	0x24, 0x2e, 0x61, 0x63, 0x6f, 0x1d, 0xab, 0x5e, 0xaf, 0xc9, 0xae, 0x4b, 0x23, 0x71, 0xe1, 0x0b,
+	0xa0, 0x1e, 0x39, 0xf2, 0x39, 0x90, 0x38, 0x70, 0xeb, 0xb1, 0x47, 0xc4, 0xc1, 0xa0, 0xf4, 0xc6,
+	0x31, 0x9f, 0x00, 0x79, 0xd7, 0x71, 0x1c, 0xaa, 0x47, 0x6a, 0xd5, 0xe7, 0x39, 0x39, 0xf6, 0xcc,
+	0xfc, 0x66, 0xfe, 0xb3, 0x33, 0x1b, 0x00, 0x09, 0xb5, 0x83, 0xe8, 0x2a, 0xc4, 0x22, 0x60, 0x91,
+	0x7d, 0xd3, 0x5b, 0xbe, 0x58, 0xf1, 0x84, 0x09, 0xa6, 0x6f, 0x11, 0x6a, 0x2d, 0xbf, 0xdd, 0xf4,
+	0x3a, 0x1f, 0xf8, 0xcc, 0x67, 0xd2, 0x66, 0x67, 0xbf, 0x94, 0x5b, 0xc7, 0x74, 0x19, 0xa7, 0x8c,
+	0xdb, 0x23, 0xcc, 0x89, 0x7d, 0xd3, 0x1b, 0x11, 0x81, 0x7b, 0xb6, 0xcb, 0x82, 0x1c, 0xd3, 0x81,
+	0x3e, 0x63, 0x7e, 0x48, 0x6c, 0xf9, 0x36, 0x4a, 0xae, 0x6c, 0x11, 0x50, 0xc2, 0x05, 0xa6, 0xb1,
+	0x72, 0x40, 0x3f, 0xd5, 0x41, 0xfb, 0x7c, 0x91, 0xe7, 0x84, 0x73, 0x22, 0xf4, 0x7d, 0xd0, 0xf0,
+	0x48, 0xc4, 0xa8, 0xa1, 0xed, 0x6a, 0xdd, 0xf5, 0xfe, 0xf6, 0x3c, 0x85, 0x1b, 0x53, 0x4c, 0xc3,
+	0x63, 0x24, 0x3f, 0x23, 0x47, 0x99, 0xf5, 0xef, 0xc0, 0x7a, 0x51, 0xa1, 0x51, 0x95, 0xbe, 0xfd,
+	0xfb, 0x14, 0x56, 0xfe, 0x4a, 0xe1, 0xbe, 0x1f, 0x88, 0x71, 0x32, 0xb2, 0x5c, 0x46, 0xed, 0xbc,
+	0x42, 0xf5, 0x38, 0xe4, 0xde, 0xb5, 0x2d, 0xa6, 0x31, 0xe1, 0xd6, 0x29, 0x71, 0xe7, 0x29, 0xdc,
+	0x56, 0xe4, 0x02, 0x84, 0x9c, 0x25, 0x54, 0x1f, 0x80, 0x06, 0x76, 0xdd, 0x84, 0x1a, 0x35, 0x49,
+	0xff, 0xec, 0xc5, 0xf4, 0xbc, 0x6e, 0x09, 0x41, 0x8e, 0x82, 0xe9, 0x97, 0x60, 0x8d, 0xbb, 0x63,
+	0xe2, 0x25, 0x21, 0x31, 0xea, 0xbb, 0xb5, 0x6e, 0xeb, 0xc8, 0xb4, 0xfe, 0xd7, 0x6d, 0xab, 0x68,
+	0xc9, 0xa5, 0x20, 0x71, 0xff, 0xc3, 0x2c, 0xf1, 0x3c, 0x85, 0x5b, 0x0a, 0xb7, 0x88, 0x46, 0x4e,
+	0x01, 0xd2, 0x3d, 0xb0, 0xe1, 0x05, 0x5c, 0x4c, 0x82, 0x51, 0x22, 0xfb, 0xd1, 0x90, 0xe0, 0xbd,
+	0x27, 0xe0, 0xd3, 0x92, 0xd3, 0x00, 0x4f, 0x7c, 0x22, 0xfa, 0x1f, 0xe5, 0xf4, 0x9d, 0xbc, 0xc9,
+	0x25, 0x0f, 0xe4, 0xac, 0x50, 0xb3, 0x86, 0x78, 0xc4, 0xc5, 0x53, 0xa3, 0xf9, 0xba, 0x86, 0x48,
+	0x88, 0x3c, 0xc8, 0xec, 0xf9, 0x7b, 0x15, 0xe8, 0x4f, 0xeb, 0xd2, 0xbf, 0x02, 0x6d, 0xca, 0x32,
+	0x71, 0x43, 0xec, 0xba, 0x2c, 0x89, 0x44, 0x3e, 0x10, 0x7b, 0xf3, 0x14, 0x42, 0xc5, 0x59, 0xb5,
+	0x1f, 0x30, 0x1a, 0x08, 0x42, 0x63, 0x31, 0x45, 0xce, 0xa6, 0x32, 0x9d, 0x28, 0x8b, 0xfe, 0x29,
+	0x78, 0x0f, 0x7b, 0xde, 0x84, 0x70, 0x9e, 0x4f, 0xca, 0xc7, 0xf3, 0x14, 0x1a, 0xf9, 0xe9, 0x28,
+	0x43, 0x39, 0x7a, 0xe1, 0xac, 0x7f, 0x03, 0x9a, 0x3f, 0x90, 0xc0, 0x1f, 0x8b, 0x7c, 0x04, 0x3e,
+	0x7f, 0xb1, 0xe2, 0x4d, 0x95, 0x44, 0x51, 0x90, 0x93, 0xe3, 0x32, 0x71, 0x2e, 0xa3, 0x34, 0x89,
+	0x02, 0x31, 0x1d, 0xc6, 0x8c, 0x85, 0x46, 0x7d, 0x57, 0xeb, 0xae, 0x95, 0xc5, 0xad, 0xda, 0x57,
+	0xc4, 0x15, 0xa6, 0xaf, 0x19, 0x0b, 0xd1, 0x6f, 0x1a, 0xd8, 0x5c, 0x19, 0x18, 0xfd, 0x4b, 0x50,
+	0xcf, 0x16, 0x4d, 0x36, 0xac, 0x75, 0xd4, 0xb1, 0xd4, 0x16, 0x5a, 0x8b, 0x2d, 0xb4, 0x06, 0x8b,
+	0x2d, 0x2c, 0x46, 0xab, 0xa5, 0x72, 0x66, 0x51, 0xe8, 0xee, 0x6f, 0xa8, 0x39, 0x12, 0xf0, 0xee,
+	0x77, 0x0c, 0xfd, 0x5b, 0x2d, 0x5d, 0x00, 0x97, 0x02, 0x0b, 0xa2, 0x7f, 0x0f, 0x36, 0x42, 0xcc,
+	0xc5, 0x10, 0xc7, 0x71, 0x18, 0x10, 0xef, 0x19, 0x2a, 0x8e, 0xb2, 0x9a, 0x66, 0x29, 0xdc, 0xba,
+	0xc0, 0x5c, 0x9c, 0xa8, 0xb0, 0xcc, 0xba, 0x9c, 0xea, 0x32, 0x50, 0x09, 0x6c, 0x85, 0x4b, 0x5f,
+	0xfd, 0x47, 0xb0, 0x53, 0xf6, 0x18, 0x8e, 0xd5, 0xa1, 0x2b, 0xc5, 0x17, 0x2f, 0x50, 0x7c, 0x1e,
+	0x89, 0x79, 0x0a, 0x3b, 0x4f, 0x93, 0xe6, 0x48, 0xe4, 0xbc, 0x5f, 0xca, 0x7b, 0xa6, 0x86, 0x01,
+	0x83, 0x26, 0xe6, 0x9c, 0x08, 0x6e, 0xd4, 0xe4, 0xda, 0xc2, 0x37, 0xdf, 0x07, 0xf2, 0x8a, 0xec,
+	0x77, 0x17, 0x7a, 0x57, 0xbf, 0xf3, 0xe5, 0xbc, 0x29, 0x1e, 0x72, 0x72, 0xf0, 0x71, 0xfd, 0x97,
+	0x5f, 0x61, 0x05, 0xfd, 0xac, 0x81, 0xf6, 0x29, 0xa1, 0xc9, 0x64, 0x82, 0x7d, 0x72, 0x1e, 0x79,
+	0xe4, 0xf6, 0xd9, 0xb7, 0xed, 0x00, 0x34, 0x82, 0x2c, 0xc0, 0xa8, 0xbe, 0x6e, 0xf5, 0x25, 0x04,
+	0x39, 0x0a, 0x86, 0xfe, 0xd0, 0xc0, 0x4e, 0x51, 0xd0, 0x17, 0x63, 0xe2, 0x5e, 0xc7, 0x2c, 0x88,
+	0x84, 0x7e, 0xb0, 0xdc, 0x57, 0x55, 0x97, 0x3e, 0x4f, 0x61, 0x7b, 0x65, 0x5f, 0x4b, 0x5b, 0x5a,
+	0x68, 0xa8, 0x3e, 0x53, 0x43, 0xed, 0x2d, 0x6a, 0xe8, 0x9f, 0xdd, 0xcf, 0x4c, 0xed, 0x61, 0x66,
+	0x6a, 0xff, 0xcc, 0x4c, 0xed, 0xee, 0xd1, 0xac, 0x3c, 0x3c, 0x9a, 0x95, 0x3f, 0x1f, 0xcd, 0xca,
+	0xb7, 0x56, 0x09, 0x4c, 0x0e, 0x29, 0x8b, 0xc8, 0xd4, 0x26, 0xf4, 0x30, 0x24, 0x9e, 0x4f, 0x26,
+	0xf6, 0x6d, 0xe9, 0x1f, 0x58, 0x26, 0x19, 0x35, 0xe5, 0x68, 0x7f, 0xf2, 0xdf, 0x00, 0x7c, 0xd2,
+	0x41, 0x56, 0x9e, 0x07, 0x00, 0x00,
 }
 
 func (m *InflationAsset) Marshal() (dAtA []byte, err error) {
@@ -188,6 +446,44 @@ func (m *InflationAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Decay.Size()
+		i -= size
+		if _, err := m.Decay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.Accum.Size()
 		i -= size
@@ -218,6 +514,104 @@ func (m *InflationAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommunityPool {
+		i--
+		if m.CommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintInflation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *InflationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -248,85 +642,792 @@ func (m *InflationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintInflation(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.LastAppliedHeight.Size()
-		i -= size
-		if _, err := m.LastAppliedHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.LastAppliedHeight.Size()
+		i -= size
+		if _, err := m.LastAppliedHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAppliedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintInflation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DemurrageIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemurrageIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemurrageIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DemurrageCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemurrageCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemurrageCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Accum.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	l = m.Decay.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *DistributionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.CommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *InflationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime)
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.LastAppliedHeight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.InflationAssets) > 0 {
+		for _, e := range m.InflationAssets {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DemurrageIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *DemurrageCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, InflationStep{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, DistributionTarget{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Decay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAppliedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintInflation(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInflation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *InflationAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovInflation(uint64(l))
 	}
-	l = m.Inflation.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.Accum.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
-}
 
-func (m *InflationState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime)
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.LastAppliedHeight.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	if len(m.InflationAssets) > 0 {
-		for _, e := range m.InflationAssets {
-			l = e.Size()
-			n += 1 + l + sovInflation(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovInflation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInflation(x uint64) (n int) {
-	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *InflationAsset) Unmarshal(dAtA []byte) error {
+func (m *InflationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -349,15 +1450,48 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAppliedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -385,11 +1519,97 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.LastAppliedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationAssets = append(m.InflationAssets, InflationAsset{})
+			if err := m.InflationAssets[len(m.InflationAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemurrageIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemurrageIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemurrageIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -417,13 +1637,11 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -451,7 +1669,7 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Accum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -476,7 +1694,7 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InflationState) Unmarshal(dAtA []byte) error {
+func (m *DemurrageCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -499,17 +1717,17 @@ func (m *InflationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DemurrageCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DemurrageCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -519,28 +1737,27 @@ func (m *InflationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAppliedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -568,15 +1785,13 @@ func (m *InflationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastAppliedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -586,23 +1801,23 @@ func (m *InflationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InflationAssets = append(m.InflationAssets, InflationAsset{})
-			if err := m.InflationAssets[len(m.InflationAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10,7 +10,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Parameter store keys
@@ -50,6 +53,7 @@ func NewInflationState(now time.Time, assets ...string) InflationState {
 			Denom:     assets[i],
 			Inflation: inflation,
 			Accum:     sdk.NewDec(0),
+			Decay:     sdk.NewDec(0),
 		})
 	}
 
@@ -79,18 +83,139 @@ func ValidateInflationState(is InflationState) error {
 		}
 	}
 
-	// Check for negative inflation
-	{
-		for _, asset := range is.InflationAssets {
-			if asset.Inflation.IsNegative() {
-				return fmt.Errorf("inflation parameters contain an asset with negative interest: %v", asset.Denom)
-			}
+	for _, asset := range is.InflationAssets {
+		if err := ValidateRate(asset.Inflation); err != nil {
+			return fmt.Errorf("inflation parameters of %v: %w", asset.Denom, err)
+		}
+		if asset.GetDecay().IsNegative() {
+			return fmt.Errorf("inflation parameters contain an asset with negative decay: %v", asset.Denom)
+		}
+		if err := ValidateSchedule(asset.Schedule); err != nil {
+			return fmt.Errorf("inflation schedule of %v: %w", asset.Denom, err)
+		}
+		if err := ValidateDistribution(asset.Distribution); err != nil {
+			return fmt.Errorf("inflation distribution of %v: %w", asset.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRate checks an annual rate. Negative rates apply demurrage, which cannot exceed the full balance.
+func ValidateRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("missing rate")
+	}
+	if rate.LTE(sdk.NewDec(-1)) {
+		return fmt.Errorf("negative interest of %v would decay entire balances", rate)
+	}
+	return nil
+}
+
+// ValidateSchedule checks that the steps of an inflation schedule are in chronological order with valid rates.
+func ValidateSchedule(schedule []InflationStep) error {
+	for i, step := range schedule {
+		if err := ValidateRate(step.Inflation); err != nil {
+			return fmt.Errorf("step at %v: %w", step.Time, err)
+		}
+		if i > 0 && !step.Time.After(schedule[i-1].Time) {
+			return fmt.Errorf("step at %v is not after the previous step", step.Time)
+		}
+	}
+	return nil
+}
+
+// ValidateDemurrage checks that demurrage indices lie within (0, 1] and that checkpoints refer to decayed denominations.
+func ValidateDemurrage(indices []DemurrageIndex, checkpoints []DemurrageCheckpoint) error {
+	decayed := make(map[string]bool, len(indices))
+	for _, index := range indices {
+		if decayed[index.Denom] {
+			return fmt.Errorf("duplicate demurrage index of %v", index.Denom)
+		}
+		if err := validateDemurrageIndex(index.Index); err != nil {
+			return fmt.Errorf("demurrage index of %v: %w", index.Denom, err)
+		}
+		decayed[index.Denom] = true
+	}
+
+	for _, checkpoint := range checkpoints {
+		if !decayed[checkpoint.Denom] {
+			return fmt.Errorf("demurrage checkpoint of %v without index", checkpoint.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(checkpoint.Address); err != nil {
+			return fmt.Errorf("demurrage checkpoint address %v: %w", checkpoint.Address, err)
+		}
+		if err := validateDemurrageIndex(checkpoint.Index); err != nil {
+			return fmt.Errorf("demurrage checkpoint of %v in %v: %w", checkpoint.Address, checkpoint.Denom, err)
 		}
 	}
+	return nil
+}
 
+func validateDemurrageIndex(index sdk.Dec) error {
+	if index.IsNil() || !index.IsPositive() || index.GT(sdk.OneDec()) {
+		return fmt.Errorf("%v is not within (0, 1]", index)
+	}
 	return nil
 }
 
+// Module accounts whose balances are accounted for by their own module. Sending tokens to them directly breaks the
+// invariants of that module, so they cannot be distribution targets.
+var trackedModuleAccounts = []string{
+	distrtypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	govtypes.ModuleName,
+}
+
+// CommunityPoolRecipient identifies community pool targets.
+const CommunityPoolRecipient = "community_pool"
+
+// ValidateDistribution checks that each distribution target names a single recipient and carries a positive weight.
+func ValidateDistribution(targets []DistributionTarget) error {
+	for _, target := range targets {
+		recipients := 0
+		for _, set := range []bool{target.ModuleAccount != "", target.Address != "", target.CommunityPool} {
+			if set {
+				recipients++
+			}
+		}
+
+		switch {
+		case recipients == 0:
+			return fmt.Errorf("target without recipient")
+		case recipients > 1:
+			return fmt.Errorf("target with more than one recipient: %v", target.Recipient())
+		case target.Address != "":
+			if _, err := sdk.AccAddressFromBech32(target.Address); err != nil {
+				return fmt.Errorf("target address %v: %w", target.Address, err)
+			}
+		case target.ModuleAccount != "":
+			for _, name := range trackedModuleAccounts {
+				if target.ModuleAccount == name {
+					return fmt.Errorf("module account %v cannot receive tokens directly", name)
+				}
+			}
+		}
+
+		if target.Weight.IsNil() || !target.Weight.IsPositive() {
+			return fmt.Errorf("target %v does not have a positive weight", target.Recipient())
+		}
+	}
+	return nil
+}
+
+// Recipient returns the module account or address of the target.
+func (t DistributionTarget) Recipient() string {
+	switch {
+	case t.CommunityPool:
+		return CommunityPoolRecipient
+	case t.ModuleAccount != "":
+		return t.ModuleAccount
+	}
+	return t.Address
+}
+
 func (is InflationState) String() string {
 	var result strings.Builder
 
@@ -98,6 +223,15 @@ func (is InflationState) String() string {
 	result.WriteString("Inflation state:\n")
 	for _, asset := range is.InflationAssets {
 		result.WriteString(fmt.Sprintf("\tDenom: %v\t\t\tInflation: %v\t\tAccum: %v\n", asset.Denom, asset.Inflation, asset.Accum))
+		if asset.Inflation.IsNegative() || asset.GetDecay().IsPositive() {
+			result.WriteString(fmt.Sprintf("\t\tDecay: %v\n", asset.GetDecay()))
+		}
+		for _, step := range asset.Schedule {
+			result.WriteString(fmt.Sprintf("\t\tFrom %v\tInflation: %v\n", step.Time, step.Inflation))
+		}
+		for _, target := range asset.Distribution {
+			result.WriteString(fmt.Sprintf("\t\tTo %v\tWeight: %v\n", target.Recipient(), target.Weight))
+		}
 	}
 
 	return result.String()
@@ -24,7 +24,7 @@ func TestNewParams1(t *testing.T) {
 
 func TestValidation(t *testing.T) {
 	inflationStates := [...]InflationState{
-		NewInflationState(time.Now(), "caps", "-0.04"),
+		NewInflationState(time.Now(), "caps", "-1.00"),
 		NewInflationState(time.Now(), "caps", "0.04", "CAPS", "0.10"),
 	}
 
@@ -32,6 +32,22 @@ func TestValidation(t *testing.T) {
 		err := ValidateInflationState(is)
 		assert.Error(t, err)
 	}
+
+	// Negative interest applies demurrage
+	assert.NoError(t, ValidateInflationState(NewInflationState(time.Now(), "caps", "-0.04")))
+
+	now := time.Now()
+	schedules := [...][]InflationStep{
+		{{Time: now, Inflation: sdk.NewDec(-1)}},
+		{{Time: now, Inflation: sdk.ZeroDec()}, {Time: now, Inflation: sdk.ZeroDec()}},
+		{{Time: now.Add(time.Hour), Inflation: sdk.ZeroDec()}, {Time: now, Inflation: sdk.ZeroDec()}},
+	}
+
+	for _, schedule := range schedules {
+		is := NewInflationState(now, "caps", "0.04")
+		is.InflationAssets[0].Schedule = schedule
+		assert.Error(t, ValidateInflationState(is))
+	}
 }
 
 func TestFindAndChangeAssetByDenom(t *testing.T) {
@@ -4,9 +4,16 @@
 
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // the one key to use for the keeper store
 var MinterKey = []byte{0x00}
 
+var (
+	DemurrageIndexKeyPrefix      = []byte{0x01}
+	DemurrageCheckpointKeyPrefix = []byte{0x02}
+)
+
 // nolint
 const (
 	// module name
@@ -24,3 +31,17 @@ const (
 	// Query endpoints supported by the inflation querier
 	QueryInflation = ModuleName
 )
+
+func GetDemurrageIndexKey(denom string) []byte {
+	return append(append([]byte{}, DemurrageIndexKeyPrefix...), denom...)
+}
+
+// GetDemurrageCheckpointKey returns the key of the checkpoint of an account in a denomination. The denomination is
+// length-prefixed so checkpoints can be iterated per denomination.
+func GetDemurrageCheckpointKey(denom string, account sdk.AccAddress) []byte {
+	return append(GetDemurrageCheckpointsKey(denom), account...)
+}
+
+func GetDemurrageCheckpointsKey(denom string) []byte {
+	return append(append(append([]byte{}, DemurrageCheckpointKeyPrefix...), byte(len(denom))), denom...)
+}
@@ -6,23 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,36 +116,268 @@ func (m *QueryInflationResponse) GetState() InflationState {
 	return InflationState{}
 }
 
+type QueryProjectionRequest struct {
+	// period following the current block time to project minting over.
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{2}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+type QueryProjectionResponse struct {
+	LastApplied time.Time `protobuf:"bytes,1,opt,name=last_applied,json=lastApplied,proto3,stdtime" json:"last_applied" yaml:"last_applied"`
+	// Earliest block time at which inflation is minted next. It is never before
+	// the current block time.
+	NextMintTime   time.Time         `protobuf:"bytes,2,opt,name=next_mint_time,json=nextMintTime,proto3,stdtime" json:"next_mint_time" yaml:"next_mint_time"`
+	ProjectedUntil time.Time         `protobuf:"bytes,3,opt,name=projected_until,json=projectedUntil,proto3,stdtime" json:"projected_until" yaml:"projected_until"`
+	Assets         []AssetProjection `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets" yaml:"assets"`
+	// Earliest height at which inflation is minted next. Inflation is never
+	// minted in the block following a mint.
+	NextMintHeight int64 `protobuf:"varint,5,opt,name=next_mint_height,json=nextMintHeight,proto3" json:"next_mint_height,omitempty" yaml:"next_mint_height"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{3}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetLastApplied() time.Time {
+	if m != nil {
+		return m.LastApplied
+	}
+	return time.Time{}
+}
+
+func (m *QueryProjectionResponse) GetNextMintTime() time.Time {
+	if m != nil {
+		return m.NextMintTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryProjectionResponse) GetProjectedUntil() time.Time {
+	if m != nil {
+		return m.ProjectedUntil
+	}
+	return time.Time{}
+}
+
+func (m *QueryProjectionResponse) GetAssets() []AssetProjection {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+func (m *QueryProjectionResponse) GetNextMintHeight() int64 {
+	if m != nil {
+		return m.NextMintHeight
+	}
+	return 0
+}
+
+type AssetProjection struct {
+	Denom  string     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply" yaml:"supply"`
+	// Accrued since the last mint and minted with the next one.
+	Pending types.Coin `protobuf:"bytes,3,opt,name=pending,proto3" json:"pending" yaml:"pending"`
+	// Minted over the requested period at the current supply, taking scheduled
+	// rate changes into account. This is a simple-interest estimate: the tokens
+	// minted during the period are not compounded, so actual minting is higher.
+	Projected types.Coin `protobuf:"bytes,4,opt,name=projected,proto3" json:"projected" yaml:"projected"`
+	// Fractional remainder carried over from previous mints.
+	Accum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=accum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accum" yaml:"accum"`
+	// Fraction of each balance burned by demurrage over the requested period.
+	ProjectedDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=projected_decay,json=projectedDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"projected_decay" yaml:"projected_decay"`
+}
+
+func (m *AssetProjection) Reset()         { *m = AssetProjection{} }
+func (m *AssetProjection) String() string { return proto.CompactTextString(m) }
+func (*AssetProjection) ProtoMessage()    {}
+func (*AssetProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{4}
+}
+func (m *AssetProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetProjection.Merge(m, src)
+}
+func (m *AssetProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetProjection proto.InternalMessageInfo
+
+func (m *AssetProjection) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetProjection) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *AssetProjection) GetPending() types.Coin {
+	if m != nil {
+		return m.Pending
+	}
+	return types.Coin{}
+}
+
+func (m *AssetProjection) GetProjected() types.Coin {
+	if m != nil {
+		return m.Projected
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRequest)(nil), "em.inflation.v1.QueryInflationRequest")
 	proto.RegisterType((*QueryInflationResponse)(nil), "em.inflation.v1.QueryInflationResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "em.inflation.v1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "em.inflation.v1.QueryProjectionResponse")
+	proto.RegisterType((*AssetProjection)(nil), "em.inflation.v1.AssetProjection")
 }
 
 func init() { proto.RegisterFile("em/inflation/v1/query.proto", fileDescriptor_8c188548f8d76523) }
 
 var fileDescriptor_8c188548f8d76523 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x3f, 0x4b, 0xfb, 0x40,
-	0x18, 0xc7, 0x73, 0x3f, 0xe8, 0x0f, 0x8c, 0x82, 0x10, 0xea, 0x1f, 0x5a, 0xbd, 0x48, 0x04, 0x75,
-	0xe9, 0x1d, 0xa9, 0x9b, 0x63, 0x27, 0xc5, 0xc9, 0xba, 0xb9, 0x5d, 0xea, 0x63, 0x0c, 0xe4, 0xee,
-	0x49, 0x7b, 0xd7, 0x62, 0x46, 0x5d, 0x5d, 0x04, 0xdf, 0x54, 0xc7, 0x82, 0x8b, 0x53, 0x91, 0xd6,
-	0x57, 0xe0, 0x2b, 0x90, 0x5e, 0x6b, 0xd4, 0x28, 0xb8, 0x1d, 0xf7, 0xfd, 0xf3, 0x7c, 0x9e, 0xc7,
-	0xad, 0x83, 0xe4, 0x89, 0xba, 0x4a, 0x85, 0x49, 0x50, 0xf1, 0x41, 0xc8, 0xbb, 0x7d, 0xe8, 0xe5,
-	0x2c, 0xeb, 0xa1, 0x41, 0x6f, 0x15, 0x24, 0x2b, 0x44, 0x36, 0x08, 0x6b, 0xd5, 0x18, 0x63, 0xb4,
-	0x1a, 0x9f, 0xbd, 0xe6, 0xb6, 0x1a, 0xed, 0xa0, 0x96, 0xa8, 0x79, 0x24, 0x34, 0xf0, 0x41, 0x18,
-	0x81, 0x11, 0x21, 0xef, 0x60, 0xa2, 0x16, 0xfa, 0x56, 0x8c, 0x18, 0xa7, 0xc0, 0x45, 0x96, 0x70,
-	0xa1, 0x14, 0x1a, 0xdb, 0xa7, 0x17, 0xaa, 0x5f, 0x26, 0xf8, 0x9c, 0x68, 0x0d, 0xc1, 0x86, 0xbb,
-	0x76, 0x36, 0x83, 0x3a, 0xf9, 0xf8, 0x6f, 0x43, 0xb7, 0x0f, 0xda, 0x04, 0xe0, 0xae, 0x97, 0x05,
-	0x9d, 0xa1, 0xd2, 0xe0, 0x9d, 0xba, 0x15, 0x6d, 0x84, 0x81, 0x4d, 0xb2, 0x43, 0x0e, 0x96, 0x9b,
-	0x3e, 0x2b, 0x2d, 0xc2, 0x8a, 0xc8, 0xf9, 0xcc, 0xd6, 0xaa, 0x0e, 0xc7, 0xbe, 0xf3, 0x36, 0xf6,
-	0x57, 0x72, 0x21, 0xd3, 0xa3, 0xc0, 0x66, 0x83, 0xf6, 0xbc, 0xa3, 0x79, 0x4f, 0xdc, 0x8a, 0x9d,
-	0xe3, 0xdd, 0x12, 0x77, 0xa9, 0x48, 0x7a, 0x7b, 0x3f, 0x5a, 0x7f, 0xc5, 0xac, 0xed, 0xff, 0xe9,
-	0x9b, 0x53, 0x07, 0xbb, 0x77, 0x4f, 0xaf, 0x8f, 0xff, 0xb6, 0xbd, 0x3a, 0x87, 0x86, 0x44, 0x05,
-	0xf9, 0xf7, 0xbb, 0x58, 0x9a, 0xd6, 0xf1, 0x70, 0x42, 0xc9, 0x68, 0x42, 0xc9, 0xcb, 0x84, 0x92,
-	0x87, 0x29, 0x75, 0x46, 0x53, 0xea, 0x3c, 0x4f, 0xa9, 0x73, 0xc1, 0xe2, 0xc4, 0x5c, 0xf7, 0x23,
-	0xd6, 0x41, 0x59, 0x14, 0x80, 0x6c, 0xa4, 0x70, 0x19, 0x43, 0x8f, 0xdf, 0x7c, 0x29, 0x33, 0x79,
-	0x06, 0x3a, 0xfa, 0x6f, 0xcf, 0x7b, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xde, 0x43, 0x55,
-	0x03, 0x02, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0x9b, 0x26, 0xdf, 0x6f, 0xae, 0x25, 0xad, 0x8e, 0xfe, 0x70, 0x53, 0x88, 0xc3, 0x21,
+	0x95, 0x2c, 0xb5, 0x95, 0xb2, 0x81, 0x84, 0xd4, 0x50, 0xa4, 0xa0, 0x0a, 0x41, 0x4d, 0xbb, 0x30,
+	0x10, 0x39, 0xf6, 0xd5, 0x35, 0xd8, 0x3e, 0x37, 0x77, 0xae, 0x9a, 0x11, 0x36, 0xb6, 0x4a, 0x0c,
+	0xf0, 0x27, 0x75, 0xac, 0xc4, 0x82, 0x18, 0x52, 0xd4, 0x32, 0x32, 0xf5, 0x2f, 0x40, 0xbe, 0x3b,
+	0x27, 0xa9, 0x53, 0x14, 0x31, 0x25, 0x7e, 0xef, 0x7d, 0x3e, 0x9f, 0xf7, 0xeb, 0x1e, 0x58, 0xc5,
+	0x81, 0xe1, 0x85, 0xfb, 0xbe, 0xc5, 0x3c, 0x12, 0x1a, 0x47, 0x0d, 0xe3, 0x30, 0xc6, 0xdd, 0x9e,
+	0x1e, 0x75, 0x09, 0x23, 0x70, 0x0e, 0x07, 0xfa, 0xc0, 0xa9, 0x1f, 0x35, 0x2a, 0x0b, 0x2e, 0x71,
+	0x09, 0xf7, 0x19, 0xc9, 0x3f, 0x11, 0x56, 0xa9, 0xda, 0x84, 0x06, 0x84, 0x1a, 0x1d, 0x8b, 0x62,
+	0xe3, 0xa8, 0xd1, 0xc1, 0xcc, 0x6a, 0x18, 0x36, 0xf1, 0x42, 0xe9, 0xbf, 0xe3, 0x12, 0xe2, 0xfa,
+	0xd8, 0xb0, 0x22, 0xcf, 0xb0, 0xc2, 0x90, 0x30, 0xce, 0x47, 0x53, 0xb4, 0xf4, 0xf2, 0xaf, 0x4e,
+	0xbc, 0x6f, 0x38, 0x71, 0x57, 0x08, 0x0a, 0xbf, 0x96, 0xf5, 0x33, 0x2f, 0xc0, 0x94, 0x59, 0x41,
+	0x94, 0x06, 0x64, 0x4b, 0x18, 0xa6, 0xcc, 0x03, 0xd0, 0x32, 0x58, 0xdc, 0x49, 0xaa, 0x7a, 0x9e,
+	0xda, 0x4d, 0x7c, 0x18, 0x63, 0xca, 0x10, 0x06, 0x4b, 0x59, 0x07, 0x8d, 0x48, 0x48, 0x31, 0xdc,
+	0x06, 0x05, 0xca, 0x2c, 0x86, 0x55, 0xa5, 0xa6, 0xd4, 0x67, 0x36, 0x34, 0x3d, 0xd3, 0x09, 0x7d,
+	0x00, 0x79, 0x9d, 0x84, 0x35, 0x17, 0x4e, 0xfb, 0x5a, 0xee, 0xaa, 0xaf, 0xcd, 0xf6, 0xac, 0xc0,
+	0x7f, 0x84, 0x38, 0x16, 0x99, 0x82, 0x03, 0xed, 0x49, 0x99, 0x57, 0x5d, 0xf2, 0x0e, 0xdb, 0x23,
+	0x09, 0xc0, 0xc7, 0xa0, 0x18, 0xe1, 0xae, 0x47, 0x1c, 0xa9, 0xb3, 0xa2, 0x8b, 0x62, 0xf5, 0xb4,
+	0x58, 0x7d, 0x4b, 0x36, 0xa3, 0xf9, 0x7f, 0xa2, 0xf0, 0xf5, 0x5c, 0x53, 0x4c, 0x09, 0x41, 0xe7,
+	0x79, 0xb0, 0x3c, 0xc6, 0x2b, 0xf3, 0x7f, 0x0b, 0x66, 0x7d, 0x8b, 0xb2, 0xb6, 0x15, 0x45, 0xbe,
+	0x87, 0x53, 0xfa, 0xca, 0x18, 0xfd, 0x6e, 0xda, 0xcb, 0xa6, 0x26, 0x2b, 0xb8, 0x2d, 0x2a, 0x18,
+	0x45, 0xa3, 0x93, 0x44, 0x76, 0x26, 0x31, 0x6d, 0x0a, 0x0b, 0xb4, 0x41, 0x39, 0xc4, 0xc7, 0xac,
+	0x1d, 0x78, 0x21, 0x6b, 0x27, 0x03, 0x51, 0xa7, 0x26, 0x2a, 0xdc, 0x93, 0x0a, 0x8b, 0x42, 0xe1,
+	0x3a, 0x5e, 0x68, 0xcc, 0x26, 0xc6, 0x17, 0x5e, 0xc8, 0x12, 0x14, 0x74, 0xc1, 0x5c, 0x24, 0x4a,
+	0xc3, 0x4e, 0x3b, 0x0e, 0x99, 0xe7, 0xab, 0xf9, 0x89, 0x2a, 0x48, 0xaa, 0x2c, 0x09, 0x95, 0x0c,
+	0x81, 0x90, 0x29, 0x0f, 0xac, 0x7b, 0x89, 0x11, 0xbe, 0x04, 0x45, 0x8b, 0x52, 0xcc, 0xa8, 0x3a,
+	0x5d, 0xcb, 0xd7, 0x67, 0x36, 0x6a, 0x63, 0xe3, 0xde, 0x4c, 0xdc, 0xc3, 0x3e, 0x37, 0x17, 0xa5,
+	0xca, 0x2d, 0xa1, 0x22, 0xd0, 0xc8, 0x94, 0x34, 0xf0, 0x19, 0x98, 0x1f, 0x96, 0x77, 0x80, 0x3d,
+	0xf7, 0x80, 0xa9, 0x85, 0x9a, 0x52, 0xcf, 0x37, 0x57, 0xaf, 0xfa, 0xda, 0x72, 0xb6, 0x01, 0x22,
+	0x02, 0x99, 0xe5, 0xb4, 0xfc, 0x96, 0x30, 0xfc, 0xce, 0x83, 0xb9, 0x8c, 0x32, 0x5c, 0x03, 0x05,
+	0x07, 0x87, 0x24, 0xe0, 0x23, 0x2d, 0x35, 0xe7, 0x87, 0x4b, 0xc7, 0xcd, 0xc8, 0x14, 0x6e, 0xd8,
+	0x02, 0x45, 0x1a, 0x47, 0x91, 0xdf, 0x93, 0x93, 0x59, 0xd1, 0xc5, 0x2b, 0xd5, 0x93, 0x57, 0xaa,
+	0xcb, 0x57, 0xaa, 0x3f, 0x25, 0xde, 0x58, 0x31, 0x02, 0x86, 0x4c, 0x89, 0x87, 0xdb, 0xe0, 0xbf,
+	0x08, 0x87, 0x8e, 0x17, 0xba, 0x6a, 0x7e, 0x12, 0xd5, 0x92, 0xa4, 0x2a, 0xcb, 0xee, 0x0b, 0x1c,
+	0x32, 0x53, 0x06, 0xb8, 0x03, 0x4a, 0x83, 0xe6, 0xab, 0xd3, 0x93, 0xe8, 0x54, 0x49, 0x37, 0x9f,
+	0x19, 0x26, 0x32, 0x87, 0x2c, 0x70, 0x17, 0x14, 0x2c, 0xdb, 0x8e, 0x03, 0xde, 0xe1, 0x52, 0xf3,
+	0x49, 0x82, 0xf9, 0xd1, 0xd7, 0xd6, 0x5c, 0x8f, 0x1d, 0xc4, 0x1d, 0xdd, 0x26, 0x81, 0x21, 0x0f,
+	0x94, 0xf8, 0x59, 0xa7, 0xce, 0x7b, 0x83, 0xf5, 0x22, 0x4c, 0xf5, 0x2d, 0x6c, 0x0f, 0xfb, 0xc7,
+	0x49, 0x90, 0x29, 0xc8, 0xe0, 0xe1, 0xe8, 0xf2, 0x39, 0xd8, 0xb6, 0x7a, 0x6a, 0x91, 0xf3, 0xb7,
+	0xfe, 0x99, 0x7f, 0x6c, 0x15, 0x39, 0x1d, 0x1a, 0x59, 0xc3, 0xad, 0xc4, 0xb0, 0xf1, 0x65, 0x0a,
+	0x14, 0xf8, 0x83, 0x86, 0x1f, 0x14, 0x50, 0x1a, 0x5c, 0x18, 0xb8, 0x36, 0xb6, 0x8e, 0x37, 0x9e,
+	0xb3, 0xca, 0x83, 0x89, 0x71, 0xe2, 0x3a, 0xa0, 0xfb, 0x1f, 0xbf, 0xfd, 0xfa, 0x3c, 0x75, 0x17,
+	0xae, 0x1a, 0x78, 0x3d, 0x20, 0x21, 0xee, 0x5d, 0xbf, 0x9f, 0xfc, 0x6a, 0xc1, 0x4f, 0x0a, 0x00,
+	0x23, 0x7b, 0xf7, 0x17, 0xf2, 0xb1, 0x9b, 0x56, 0xa9, 0x4f, 0x0e, 0x94, 0x69, 0xd4, 0x79, 0x1a,
+	0x08, 0xd6, 0x6e, 0x4e, 0x23, 0x1a, 0x3e, 0xb7, 0xd6, 0xe9, 0x45, 0x55, 0x39, 0xbb, 0xa8, 0x2a,
+	0x3f, 0x2f, 0xaa, 0xca, 0xc9, 0x65, 0x35, 0x77, 0x76, 0x59, 0xcd, 0x7d, 0xbf, 0xac, 0xe6, 0xde,
+	0xe8, 0x23, 0x53, 0x48, 0x59, 0x70, 0xb0, 0xee, 0x63, 0xc7, 0xc5, 0x5d, 0xe3, 0x78, 0x84, 0x91,
+	0x4f, 0xa4, 0x53, 0xe4, 0x27, 0xe3, 0xe1, 0x9f, 0x01, 0x00, 0x44, 0xbb, 0xc8, 0xad, 0xf8, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +393,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -174,9 +413,19 @@ func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest,
 	return out, nil
 }
 
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/em.inflation.v1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +435,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
 }
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +461,24 @@ func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.inflation.v1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +487,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Inflation",
 			Handler:    _Query_Inflation_Handler,
 		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/inflation/v1/query.proto",
@@ -278,6 +552,183 @@ func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextMintHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextMintHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ProjectedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ProjectedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextMintTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextMintTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastApplied, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastApplied):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProjectedDecay.Size()
+		i -= size
+		if _, err := m.ProjectedDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Accum.Size()
+		i -= size
+		if _, err := m.Accum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Projected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -309,16 +760,74 @@ func (m *QueryInflationResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastApplied)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextMintTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ProjectedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextMintHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextMintHeight))
+	}
+	return n
+}
+
+func (m *AssetProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Pending.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Projected.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Accum.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedDecay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -448,6 +957,540 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastApplied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastApplied, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMintTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextMintTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ProjectedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, AssetProjection{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMintHeight", wireType)
+			}
+			m.NextMintHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMintHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Projected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
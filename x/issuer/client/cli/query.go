@@ -32,5 +32,71 @@ func GetQueryCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.AddCommand(
+		getCmdQueryDenomRestrictions(),
+		getCmdQueryReserveCoverage(),
+	)
+	return cmd
+}
+
+func getCmdQueryDenomRestrictions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restrictions [denomination]",
+		Example: "emd query issuers restrictions eeur",
+		Short:   "Query the transfer restrictions of a denomination",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomRestrictions(cmd.Context(), &types.QueryDenomRestrictionsRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const flagMaxAge = "max-age"
+
+func getCmdQueryReserveCoverage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reserves [denomination]",
+		Example: "emd query issuers reserves eeur --max-age 720h",
+		Short:   "Compare the latest reserve attestations with the supply of all or a single denomination",
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryReserveCoverageRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+			if req.MaxAge, err = cmd.Flags().GetDuration(flagMaxAge); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReserveCoverage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Duration(flagMaxAge, types.DefaultAttestationMaxAge, "Age after which an attestation is considered stale")
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
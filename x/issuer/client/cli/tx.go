@@ -5,11 +5,20 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 	"github.com/spf13/cobra"
 )
 
@@ -26,17 +35,111 @@ func GetTxCmd() *cobra.Command {
 		getCmdIncreaseMintableAmount(),
 		getCmdDecreaseMintableAmount(),
 		getCmdSetInflation(),
+		getCmdScheduleInflation(),
 		getCmdRevokeLiquidityProvider(),
+		getCmdUpdateDenylist(),
+		getCmdUpdateAllowlist(),
+		getCmdSetAllowlistOnly(),
+		getCmdFreezeAccount(),
+		getCmdUnfreezeAccount(),
+		getCmdClawback(),
+		getCmdUpdateDenomMetadata(),
+		getCmdSetMintRateLimit(),
+		getCmdAttestReserves(),
 	)
 
 	return issuanceTxCmd
 }
 
-func getCmdSetInflation() *cobra.Command {
+const (
+	flagAdd       = "add"
+	flagRemove    = "remove"
+	flagRecipient = "recipient"
+	flagReference = "reference"
+	flagExpiresAt = "expires-at"
+
+	flagMaxPerTx     = "max-per-tx"
+	flagMaxPerWindow = "max-per-window"
+	flagWindow       = "window"
+
+	flagAttestedAt = "attested-at"
+)
+
+func getCmdUpdateDenylist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-denylist [issuer_key_or_address] [denomination]",
+		Example: "emd tx issuer update-denylist issuerkey eeur --add emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0",
+		Short:   "Add or remove accounts that may not send or receive a denomination",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, _ := cmd.Flags().GetStringSlice(flagAdd)
+			remove, _ := cmd.Flags().GetStringSlice(flagRemove)
+
+			msg := &types.MsgUpdateDenylist{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Denom:  args[1],
+				Add:    add,
+				Remove: remove,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagAdd, nil, "Comma separated accounts to add to the denylist")
+	cmd.Flags().StringSlice(flagRemove, nil, "Comma separated accounts to remove from the denylist")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdUpdateAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-allowlist [issuer_key_or_address] [denomination]",
+		Example: "emd tx issuer update-allowlist issuerkey eeur --add emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0",
+		Short:   "Add or remove accounts that may hold a denomination in allowlist-only mode",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, _ := cmd.Flags().GetStringSlice(flagAdd)
+			remove, _ := cmd.Flags().GetStringSlice(flagRemove)
+
+			msg := &types.MsgUpdateAllowlist{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Denom:  args[1],
+				Add:    add,
+				Remove: remove,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagAdd, nil, "Comma separated accounts to add to the allowlist")
+	cmd.Flags().StringSlice(flagRemove, nil, "Comma separated accounts to remove from the allowlist")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetAllowlistOnly() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "set-inflation [issuer_key_or_address] [denomination] [inflation]",
-		Example: "emd tx issuer set-inflation issuerkey eeur 0.02",
-		Short:   "Set the inflation rate for a denomination",
+		Use:     "set-allowlist-only [issuer_key_or_address] [denomination] [true|false]",
+		Example: "emd tx issuer set-allowlist-only issuerkey eeur true",
+		Short:   "Restrict holding a denomination to the accounts on its allowlist",
 		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
@@ -45,6 +148,276 @@ func getCmdSetInflation() *cobra.Command {
 				return err
 			}
 
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetAllowlistOnly{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				Denom:   args[1],
+				Enabled: enabled,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freeze [issuer_key_or_address] [denomination] [account_address]",
+		Example: "emd tx issuer freeze issuerkey eeur emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0",
+		Short:   "Prevent an account from sending a denomination",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFreezeAccount{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				Denom:   args[1],
+				Account: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unfreeze [issuer_key_or_address] [denomination] [account_address]",
+		Example: "emd tx issuer unfreeze issuerkey eeur emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0",
+		Short:   "Allow a frozen account to send a denomination again",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnfreezeAccount{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				Denom:   args[1],
+				Account: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clawback [issuer_key_or_address] [account_address] [amount]",
+		Example: "emd tx issuer clawback issuerkey emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0 1000eeur --reference case-2021-42",
+		Short:   "Recover tokens from an account. The tokens are burned unless a recipient is given",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			recipient, _ := cmd.Flags().GetString(flagRecipient)
+			reference, _ := cmd.Flags().GetString(flagReference)
+
+			msg := &types.MsgClawback{
+				Issuer:    clientCtx.GetFromAddress().String(),
+				Account:   args[1],
+				Amount:    amount,
+				Recipient: recipient,
+				Reference: reference,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagRecipient, "", "Account receiving the recovered tokens instead of burning them")
+	cmd.Flags().String(flagReference, "", "Reference to the order mandating the recovery")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdUpdateDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-denom-metadata [issuer_key_or_address] [metadata_file]",
+		Example: "emd tx issuer update-denom-metadata issuerkey eeur-metadata.json",
+		Short:   "Replace the metadata of a denomination with the JSON encoded bank metadata in the file",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateDenomMetadata{
+				Issuer:   clientCtx.GetFromAddress().String(),
+				Metadata: metadata,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-mint-rate-limit [issuer_key_or_address] [liquidity_provider_address]",
+		Example: "emd tx issuer set-mint-rate-limit issuerkey emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu --max-per-tx 100000eeur --max-per-window 1000000eeur --window 24h",
+		Short:   "Limit how fast a liquidity provider can mint its allowance. Omitting all limits removes them",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lpAcc, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxPerTx, _ := cmd.Flags().GetString(flagMaxPerTx)
+			maxPerWindow, _ := cmd.Flags().GetString(flagMaxPerWindow)
+			window, _ := cmd.Flags().GetDuration(flagWindow)
+
+			var limit lptypes.MintRateLimit
+			if limit.MaxPerTx, err = sdk.ParseCoinsNormalized(maxPerTx); err != nil {
+				return err
+			}
+			if limit.MaxPerWindow, err = sdk.ParseCoinsNormalized(maxPerWindow); err != nil {
+				return err
+			}
+			limit.Window = window
+
+			msg := &types.MsgSetMintRateLimit{
+				Issuer:            clientCtx.GetFromAddress().String(),
+				LiquidityProvider: lpAcc.String(),
+				RateLimit:         limit,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagMaxPerTx, "", "Maximum amount that can be minted in a single transaction")
+	cmd.Flags().String(flagMaxPerWindow, "", "Maximum amount that can be minted within the window")
+	cmd.Flags().Duration(flagWindow, 0, "Length of the rolling window")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdAttestReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "attest-reserves [issuer_key_or_address] [denomination] [reserve_amount] [currency] [auditor] [document_hash]",
+		Example: "emd tx issuer attest-reserves issuerkey eeur 1250000000000 EUR \"Example Audit GmbH\" 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --attested-at 2022-01-31T00:00:00Z",
+		Short:   "Publish the audited reserves backing a denomination, expressed in its base units",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid reserve amount: %v", args[2])
+			}
+
+			attestedAt := time.Now().UTC()
+			if v, _ := cmd.Flags().GetString(flagAttestedAt); v != "" {
+				if attestedAt, err = time.Parse(time.RFC3339, v); err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgAttestReserves{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Attestation: types.ReserveAttestation{
+					Denom:         args[1],
+					ReserveAmount: amount,
+					Currency:      args[3],
+					AttestedAt:    attestedAt,
+					Auditor:       args[4],
+					DocumentHash:  args[5],
+				},
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagAttestedAt, "", "Time of the attestation (RFC3339). Defaults to now")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-inflation [issuer_key_or_address] [denomination] [inflation]",
+		Example: `emd tx issuer set-inflation issuerkey eeur 0.02
+emd tx issuer set-inflation issuerkey eeur -- -0.005`,
+		Short: "Set the inflation rate for a denomination. Negative rates decay the balances of holders",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			denom := args[1]
 			if err := sdk.ValidateDenom(denom); err != nil {
 				return err
@@ -71,6 +444,57 @@ func getCmdSetInflation() *cobra.Command {
 	return cmd
 }
 
+func getCmdScheduleInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule-inflation [issuer_key_or_address] [denomination] [inflation@time]...",
+		Example: "emd tx issuer schedule-inflation issuerkey eeur 0.01@2023-01-01T00:00:00Z 0.005@2023-07-01T00:00:00Z",
+		Short:   "Announce future inflation rate changes for a denomination",
+		Long: `Announce future inflation rate changes for a denomination. Each rate applies from its RFC3339 time onwards.
+The given steps replace any previously announced changes. Omit the steps to cancel all pending changes.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			schedule := make([]inflationtypes.InflationStep, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				parts := strings.SplitN(arg, "@", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("expected inflation@time: %v", arg)
+				}
+
+				inflation, err := sdk.NewDecFromStr(parts[0])
+				if err != nil {
+					return err
+				}
+
+				t, err := time.Parse(time.RFC3339, parts[1])
+				if err != nil {
+					return err
+				}
+
+				schedule = append(schedule, inflationtypes.InflationStep{Time: t.UTC(), Inflation: inflation})
+			}
+
+			msg := &types.MsgScheduleInflation{
+				Issuer:   clientCtx.GetFromAddress().String(),
+				Denom:    args[1],
+				Schedule: schedule,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getCmdIncreaseMintableAmount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "increase-mintable [issuer_key_or_address] [liquidity_provider_address] [amount]",
@@ -99,12 +523,21 @@ func getCmdIncreaseMintableAmount() *cobra.Command {
 				Issuer:            clientCtx.GetFromAddress().String(),
 			}
 
+			if expiry, _ := cmd.Flags().GetString(flagExpiresAt); expiry != "" {
+				expiresAt, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+				msg.ExpiresAt = &expiresAt
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagExpiresAt, "", "Time after which the allowance can no longer be used for minting (RFC3339)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
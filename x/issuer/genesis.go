@@ -20,6 +20,19 @@ func initGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
 		}
 		k.AddIssuer(ctx, issuer, denomMetadata)
 	}
+
+	for _, restrictions := range state.DenomRestrictions {
+		if err := k.SetDenomRestrictions(ctx, restrictions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, attestation := range state.ReserveAttestations {
+		if err := attestation.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetReserveAttestation(ctx, attestation)
+	}
 }
 
 func defaultGenesisState() *types.GenesisState {
@@ -0,0 +1,52 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	lp "github.com/e-money/em-ledger/x/liquidityprovider"
+)
+
+// Clawback recovers tokens from an account through the liquidity provider module account.
+// The tokens are burned when no recipient is given. Transfer restrictions do not apply to the
+// account the tokens are recovered from, so frozen and denylisted balances can be recovered.
+func (k Keeper) Clawback(ctx sdk.Context, issuer, account sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress, reference string) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), amount.Denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bk.SendCoinsFromAccountToModule(apptypes.WithoutTransferRestrictions(ctx), account, lp.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	recipientAttr := "burned"
+	if recipient.Empty() {
+		if err := k.bk.BurnCoins(ctx, lp.ModuleName, coins); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, lp.ModuleName, recipient, coins); err != nil {
+			return nil, err
+		}
+		recipientAttr = recipient.String()
+	}
+
+	k.logger(ctx).Info("Clawback", "issuer", issuer, "account", account, "amount", amount, "recipient", recipientAttr, "reference", reference)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyIssuer, issuer.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAttr),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
@@ -21,3 +21,47 @@ func (k Keeper) Issuers(c context.Context, req *types.QueryIssuersRequest) (*typ
 	}
 	return &response, nil
 }
+
+func (k Keeper) DenomRestrictions(c context.Context, req *types.QueryDenomRestrictionsRequest) (*types.QueryDenomRestrictionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := types.QueryDenomRestrictionsResponse{
+		Restrictions: k.GetDenomRestrictions(sdk.UnwrapSDKContext(c), req.Denom),
+	}
+	return &response, nil
+}
+
+func (k Keeper) ReserveCoverage(c context.Context, req *types.QueryReserveCoverageRequest) (*types.QueryReserveCoverageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maxAge := req.MaxAge
+	if maxAge <= 0 {
+		maxAge = types.DefaultAttestationMaxAge
+	}
+
+	denoms := collectDenoms(k.GetIssuers(ctx))
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		denoms = []string{req.Denom}
+	}
+
+	response := types.QueryReserveCoverageResponse{
+		Coverage: make([]types.ReserveCoverage, len(denoms)),
+	}
+	for i, denom := range denoms {
+		response.Coverage[i] = k.GetReserveCoverage(ctx, denom, maxAge)
+	}
+	return &response, nil
+}
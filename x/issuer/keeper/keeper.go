@@ -7,6 +7,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	authtypes "github.com/e-money/em-ledger/x/authority/types"
 
@@ -16,8 +17,10 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	lp "github.com/e-money/em-ledger/x/liquidityprovider"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -48,7 +51,7 @@ func NewKeeper(
 	}
 }
 
-func (k Keeper) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error) {
+func (k Keeper) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error) {
 	logger := k.logger(ctx)
 
 	i, err := k.mustBeIssuer(ctx, issuer.String())
@@ -62,19 +65,17 @@ func (k Keeper) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liqui
 		}
 	}
 
-	lpAcc := k.lpKeeper.GetLiquidityProviderAccount(ctx, liquidityProvider)
-	if lpAcc == nil {
-		logger.Info("Creating liquidity provider", "account", liquidityProvider, "increase", mintableIncrease)
-		// Account was not previously a liquidity provider. Create it
-		if res, err := k.lpKeeper.CreateLiquidityProvider(ctx, liquidityProvider, mintableIncrease); err == nil {
-			return res, nil
-		}
-	} else {
-		logger.Info("Increasing liquidity provider mintable amount", "account", liquidityProvider, "increase", mintableIncrease)
-		lpAcc.IncreaseMintableAmount(mintableIncrease)
-		k.lpKeeper.SetLiquidityProviderAccount(ctx, lpAcc)
+	if err := k.validateSupplyCaps(ctx, mintableIncrease, true); err != nil {
+		return nil, err
 	}
 
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "allowance expiry %v is not in the future", expiresAt)
+	}
+
+	logger.Info("Increasing liquidity provider mintable amount", "account", liquidityProvider, "issuer", issuer, "increase", mintableIncrease, "expires_at", expiresAt)
+	k.lpKeeper.GrantAllowance(ctx, liquidityProvider, issuer, mintableIncrease, expiresAt)
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -97,17 +98,11 @@ func (k Keeper) DecreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liqui
 		return nil, sdkerrors.Wrapf(types.ErrNotLiquidityProvider, "%v", liquidityProvider)
 	}
 
-	_, anyNegative := lpAcc.Mintable.SafeSub(mintableDecrease)
-	if anyNegative {
-		return nil, sdkerrors.Wrapf(types.ErrNegativeMintableBalance, "%v", lpAcc.String())
+	if err := k.lpKeeper.DecreaseAllowance(ctx, liquidityProvider, issuer, mintableDecrease); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrNegativeMintableBalance, "%v", err)
 	}
 
 	logger.Info("Liquidity provider mintable amount decreased", "account", liquidityProvider, "decrease", mintableDecrease)
-	if err := lpAcc.DecreaseMintableAmount(mintableDecrease); err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrNegativeMintableBalance, "%v", lpAcc.String())
-	}
-	k.lpKeeper.SetLiquidityProviderAccount(ctx, lpAcc)
-
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -127,20 +122,42 @@ func (k Keeper) RevokeLiquidityProvider(ctx sdk.Context, liquidityProvider, issu
 		newMintableAmount = removeDenom(newMintableAmount, denom)
 	}
 
-	if len(newMintableAmount) == len(lpAcc.Mintable) {
+	if len(newMintableAmount) == len(lpAcc.Mintable) && lpAcc.GetAllowance(issuer.Address) == nil {
 		// Nothing was changed. Issuer was not controlling this lp.
 		return nil, sdkerrors.Wrap(types.ErrNotLiquidityProvider, liquidityProvider.String())
 	}
 
-	if len(newMintableAmount) == 0 {
-		// Mintable amount is zero, so demote to ordinary account
-		k.lpKeeper.RevokeLiquidityProviderAccount(ctx, liquidityProvider)
-	} else {
-		// This liquidity provider has been granted a mintable amounts from multiple issuers so some amount remain.
-		lpAcc.Mintable = newMintableAmount
-		k.lpKeeper.SetLiquidityProviderAccount(ctx, lpAcc)
+	// Removes the issuer's allowance and demotes the account to an ordinary one when nothing remains.
+	k.lpKeeper.RevokeAllowance(ctx, liquidityProvider, issuerAddress, issuer.Denoms)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// SetMintRateLimit limits how fast the liquidity provider can mint the allowance granted by the issuer.
+func (k Keeper) SetMintRateLimit(ctx sdk.Context, issuer, liquidityProvider sdk.AccAddress, limit lptypes.MintRateLimit) (*sdk.Result, error) {
+	i, err := k.mustBeIssuer(ctx, issuer.String())
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotAnIssuer, issuer.String())
+	}
+
+	for _, denom := range limit.Denoms() {
+		if !anyContained(i.Denoms, denom) {
+			return nil, sdkerrors.Wrapf(types.ErrDoesNotControlDenomination, "%v", denom)
+		}
 	}
 
+	if err := k.lpKeeper.SetMintRateLimit(ctx, liquidityProvider, issuer, limit); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotLiquidityProvider, err.Error())
+	}
+
+	k.logger(ctx).Info("Mint rate limit set", "account", liquidityProvider, "issuer", issuer, "limit", limit.String())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintRateLimit,
+			sdk.NewAttribute(types.AttributeKeyIssuer, issuer.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, liquidityProvider.String()),
+		),
+	)
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -153,6 +170,38 @@ func (k Keeper) SetInflationRate(ctx sdk.Context, issuer sdk.AccAddress, inflati
 	return k.ik.SetInflation(ctx, inflationRate, denom)
 }
 
+// ScheduleInflation replaces the announced inflation rate changes of a denomination controlled by the issuer.
+func (k Keeper) ScheduleInflation(ctx sdk.Context, issuer sdk.AccAddress, denom string, schedule []inflationtypes.InflationStep) (*sdk.Result, error) {
+	_, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotAnIssuer, issuer.String())
+	}
+
+	return k.ik.SetInflationSchedule(ctx, denom, schedule)
+}
+
+// UpdateDenomMetadata replaces the bank metadata of a denomination controlled by the issuer.
+func (k Keeper) UpdateDenomMetadata(ctx sdk.Context, issuer sdk.AccAddress, metadata banktypes.Metadata) (*sdk.Result, error) {
+	_, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), metadata.Base)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, metadata.Base)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	k.bk.SetDenomMetaData(ctx, metadata)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+			sdk.NewAttribute(types.AttributeKeyIssuer, issuer.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func (k Keeper) logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -253,6 +302,76 @@ func (k Keeper) RemoveIssuer(ctx sdk.Context, issuer sdk.AccAddress) (*sdk.Resul
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// TransferDenom moves a denomination to another, possibly new, issuer. Supply, inflation, metadata and
+// transfer restrictions of the denomination are unaffected. The mintable amounts of the denomination are
+// either kept by the liquidity providers, who are then managed by the new issuer, or revoked.
+func (k Keeper) TransferDenom(ctx sdk.Context, denom string, from, to sdk.AccAddress, revokeLiquidityProviders bool) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, from.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	if from.Equals(to) {
+		return nil, sdkerrors.Wrapf(types.ErrDenominationAlreadyAssigned, "%v", denom)
+	}
+
+	issuers := k.GetIssuers(ctx)
+
+	// The supply cap follows the denomination
+	supplyCap := sdk.NewCoins()
+	for _, i := range issuers {
+		if i.Address == from.String() {
+			supplyCap = sdk.NewCoins(sdk.NewCoin(denom, i.SupplyCaps.AmountOf(denom)))
+		}
+	}
+
+	updatedIssuers := make([]types.Issuer, 0)
+	found := false
+	for _, i := range issuers {
+		switch i.Address {
+		case from.String():
+			i.Denoms = removeString(i.Denoms, denom)
+			i.SupplyCaps = removeDenom(i.SupplyCaps, denom)
+			if len(i.Denoms) == 0 {
+				continue
+			}
+		case to.String():
+			i.Denoms = append(i.Denoms, denom)
+			sort.Strings(i.Denoms)
+			i.SupplyCaps = i.SupplyCaps.Add(supplyCap...)
+			found = true
+		}
+
+		updatedIssuers = append(updatedIssuers, i)
+	}
+
+	if !found {
+		issuer := types.NewIssuer(to, denom)
+		issuer.SupplyCaps = supplyCap
+		updatedIssuers = append(updatedIssuers, issuer)
+	}
+	k.setIssuers(ctx, updatedIssuers)
+
+	lpAction := "migrated"
+	if revokeLiquidityProviders {
+		lpAction = "revoked"
+		k.lpKeeper.RemoveDenom(ctx, denom)
+	} else {
+		k.lpKeeper.MigrateDenom(ctx, denom, from, to)
+	}
+
+	k.logger(ctx).Info("Denomination transferred", "denom", denom, "from", from, "to", to, "liquidity_providers", lpAction)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyFromIssuer, from.String()),
+			sdk.NewAttribute(types.AttributeKeyToIssuer, to.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityProviders, lpAction),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func anyContained(s []string, searchterms ...string) bool {
 	for _, st := range searchterms {
 		index := sort.SearchStrings(s, st)
@@ -273,6 +392,18 @@ func collectDenoms(issuers []types.Issuer) (res []string) {
 	return
 }
 
+func removeString(s []string, r string) (res []string) {
+	for _, v := range s {
+		if v == r {
+			continue
+		}
+
+		res = append(res, v)
+	}
+
+	return
+}
+
 func removeDenom(coins sdk.Coins, denom string) (res sdk.Coins) {
 	for _, c := range coins {
 		if c.Denom == denom {
@@ -7,6 +7,7 @@ package keeper
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -23,8 +24,10 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	embank "github.com/e-money/em-ledger/hooks/bank"
 	apptypes "github.com/e-money/em-ledger/types"
 	emauthtypes "github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	"github.com/e-money/em-ledger/x/liquidityprovider"
 	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
@@ -121,6 +124,44 @@ func TestAddDenomMetadata(t *testing.T) {
 	denomFound(ctx, t, bk, "edkk")
 }
 
+func TestUpdateDenomMetadata(t *testing.T) {
+	ctx, _, _, keeper, bk := createTestComponents(t)
+
+	var (
+		acc1, _  = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		acc2, _  = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		metadata = banktypes.Metadata{
+			Description: "e-Money EUR stablecoin",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "eeur", Exponent: 0},
+				{Denom: "EEUR", Exponent: 6},
+			},
+			Base:    "eeur",
+			Display: "EEUR",
+			Name:    "e-Money EUR",
+			Symbol:  "EEUR",
+		}
+	)
+
+	_, err := keeper.AddIssuer(ctx, types.NewIssuer(acc1, "eeur"), getDenomsMetadata([]string{"eeur"}))
+	require.NoError(t, err)
+
+	_, err = keeper.UpdateDenomMetadata(ctx, acc2, metadata)
+	require.True(t, types.ErrDoesNotControlDenomination.Is(err))
+
+	invalid := metadata
+	invalid.Display = "unknown"
+	_, err = keeper.UpdateDenomMetadata(ctx, acc1, invalid)
+	require.True(t, types.ErrInvalidDenomMetadata.Is(err))
+
+	_, err = keeper.UpdateDenomMetadata(ctx, acc1, metadata)
+	require.NoError(t, err)
+
+	got, found := bk.GetDenomMetaData(ctx, "eeur")
+	require.True(t, found)
+	require.Equal(t, metadata, got)
+}
+
 func TestRemoveIssuer(t *testing.T) {
 	ctx, _, _, keeper, _ := createTestComponents(t)
 
@@ -157,10 +198,10 @@ func TestIssuerModifyLiquidityProvider(t *testing.T) {
 	keeper.AddIssuer(ctx, issuer, getDenomsMetadata(issuer.Denoms))
 	mintable := MustParseCoins("100000eeur,5000ejpy")
 
-	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable)
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable, nil)
 	require.NoError(t, err)
 
-	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable)
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable, nil)
 	require.NoError(t, err)
 
 	// Verify the two increases in mintable balance
@@ -184,6 +225,12 @@ func TestIssuerModifyLiquidityProvider(t *testing.T) {
 	expected = MustParseCoins("150000eeur,8000ejpy")
 	a = lpk.GetLiquidityProviderAccount(ctx, lpacc)
 	require.Equal(t, expected.String(), a.Mintable.String())
+	require.Equal(t, expected.String(), a.GetAllowance(iacc.String()).Mintable.String())
+
+	// Allowances cannot be granted with an expiry in the past
+	expiry := ctx.BlockTime()
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable, &expiry)
+	require.Error(t, err)
 }
 
 func TestAddAndRevokeLiquidityProvider(t *testing.T) {
@@ -202,10 +249,10 @@ func TestAddAndRevokeLiquidityProvider(t *testing.T) {
 	mintable := MustParseCoins("100000eeur,5000ejpy")
 
 	// Ensure that a random account can't create a LP
-	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, randomacc, mintable)
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, randomacc, mintable, nil)
 	require.Error(t, err)
 
-	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable)
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, mintable, nil)
 	require.NoError(t, err)
 
 	// Make sure a random account can't revoke LP status
@@ -234,14 +281,14 @@ func TestDoubleLiquidityProvider(t *testing.T) {
 	mintable1 := MustParseCoins("100000eeur,5000ejpy")
 	mintable2 := MustParseCoins("250000edkk,1000esek")
 
-	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, mintable1)
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, mintable1, nil)
 	require.NoError(t, err)
 
 	// Attempt to revoke liquidity given by other issuer
 	_, err = keeper.RevokeLiquidityProvider(ctx, lp, issuer2)
 	require.Error(t, err)
 
-	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer2, mintable2)
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer2, mintable2, nil)
 	require.NoError(t, err)
 
 	lpAccount := lpk.GetLiquidityProviderAccount(ctx, lp)
@@ -258,6 +305,422 @@ func TestDoubleLiquidityProvider(t *testing.T) {
 	require.IsType(t, &authtypes.BaseAccount{}, ak.GetAccount(ctx, lp))
 }
 
+func TestTransferDenom(t *testing.T) {
+	ctx, ak, lpk, keeper, bk := createTestComponents(t)
+
+	var (
+		issuer1, _ = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		issuer2, _ = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		lp, _      = sdk.AccAddressFromBech32("emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum")
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, lp))
+	keeper.AddIssuer(ctx, types.NewIssuer(issuer1, "eeur", "ejpy"), []emauthtypes.Denomination{{Base: "eeur"}, {Base: "ejpy"}})
+
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("100000eeur,5000ejpy"), nil)
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+
+	// Only the controlling issuer can be the source of a transfer
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer2, issuer1, false)
+	require.Error(t, err)
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer1, issuer1, false)
+	require.Error(t, err)
+
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer1, issuer2, false)
+	require.NoError(t, err)
+
+	issuers := keeper.GetIssuers(ctx)
+	require.Len(t, issuers, 2)
+	require.Equal(t, []string{"ejpy"}, issuers[0].Denoms)
+	require.Equal(t, issuer2.String(), issuers[1].Address)
+	require.Equal(t, []string{"eeur"}, issuers[1].Denoms)
+
+	// Supply and metadata are untouched and the liquidity provider now answers to the new issuer
+	require.Equal(t, sdk.NewInt(1000), bk.(bankkeeper.Keeper).GetSupply(ctx, "eeur").Amount)
+	_, found := bk.(bankkeeper.Keeper).GetDenomMetaData(ctx, "eeur")
+	require.True(t, found)
+	require.Equal(t, MustParseCoins("99000eeur,5000ejpy"), lpk.GetLiquidityProviderAccount(ctx, lp).Mintable)
+
+	_, err = keeper.DecreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("1eeur"))
+	require.Error(t, err)
+	_, err = keeper.DecreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer2, MustParseCoins("1eeur"))
+	require.NoError(t, err)
+
+	// Transferring the last denomination removes the issuer and revokes the liquidity provider entirely
+	_, err = keeper.TransferDenom(ctx, "ejpy", issuer1, issuer2, true)
+	require.NoError(t, err)
+
+	issuers = keeper.GetIssuers(ctx)
+	require.Len(t, issuers, 1)
+	require.Equal(t, []string{"eeur", "ejpy"}, issuers[0].Denoms)
+	require.Equal(t, MustParseCoins("98999eeur"), lpk.GetLiquidityProviderAccount(ctx, lp).Mintable)
+
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer2, issuer1, true)
+	require.NoError(t, err)
+	require.Nil(t, lpk.GetLiquidityProviderAccount(ctx, lp))
+	require.IsType(t, &authtypes.BaseAccount{}, ak.GetAccount(ctx, lp))
+}
+
+func TestTransferRateLimitedDenom(t *testing.T) {
+	ctx, ak, lpk, keeper, _ := createTestComponents(t)
+
+	var (
+		issuer1, _ = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		issuer2, _ = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		lp, _      = sdk.AccAddressFromBech32("emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum")
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, lp))
+	keeper.AddIssuer(ctx, types.NewIssuer(issuer1, "eeur", "ejpy"), []emauthtypes.Denomination{{Base: "eeur"}, {Base: "ejpy"}})
+
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("100000eeur,5000ejpy"), nil)
+	require.NoError(t, err)
+	_, err = keeper.SetMintRateLimit(ctx, issuer1, lp, lptypes.MintRateLimit{
+		MaxPerTx:     MustParseCoins("1000eeur,100ejpy"),
+		MaxPerWindow: MustParseCoins("1500eeur"),
+		Window:       24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer1, issuer2, false)
+	require.NoError(t, err)
+
+	// The limit and the mints within the current window move to the new issuer's allowance
+	prov := lpk.GetLiquidityProviderAccount(ctx, lp)
+	source, target := prov.GetAllowance(issuer1.String()), prov.GetAllowance(issuer2.String())
+	require.Equal(t, MustParseCoins("100ejpy"), source.RateLimit.MaxPerTx)
+	require.Empty(t, source.RecentMints)
+	require.Equal(t, MustParseCoins("1000eeur"), target.RateLimit.MaxPerTx)
+	require.Equal(t, MustParseCoins("1500eeur"), target.RateLimit.MaxPerWindow)
+	require.Len(t, target.RecentMints, 1)
+
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("1001eeur"))
+	require.ErrorIs(t, err, lptypes.ErrMintRateLimitExceeded)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("501eeur"))
+	require.ErrorIs(t, err, lptypes.ErrMintRateLimitExceeded)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("500eeur"))
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("101ejpy"))
+	require.ErrorIs(t, err, lptypes.ErrMintRateLimitExceeded)
+}
+
+func TestSupplyCap(t *testing.T) {
+	ctx, ak, lpk, keeper, bk := createTestComponents(t)
+
+	var (
+		issuer1, _ = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		issuer2, _ = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		lp, _      = sdk.AccAddressFromBech32("emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum")
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, lp))
+	keeper.AddIssuer(ctx, types.NewIssuer(issuer1, "eeur", "ejpy"), []emauthtypes.Denomination{{Base: "eeur"}, {Base: "ejpy"}})
+
+	_, err := keeper.SetSupplyCap(ctx, sdk.NewInt64Coin("echf", 1000))
+	require.ErrorIs(t, err, types.ErrDoesNotControlDenomination)
+
+	_, err = keeper.SetSupplyCap(ctx, sdk.NewInt64Coin("eeur", 10000))
+	require.NoError(t, err)
+	require.Equal(t, MustParseCoins("10000eeur"), keeper.GetIssuers(ctx)[0].SupplyCaps)
+
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("8000eeur,50000ejpy"), nil)
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("5000eeur"))
+	require.NoError(t, err)
+
+	// Minted supply and outstanding mintable amounts both count towards the cap
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("2001eeur"), nil)
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("2000eeur"), nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(5000), bk.(bankkeeper.Keeper).GetSupply(ctx, "eeur").Amount)
+
+	// Lowering the cap below the committed amount halts minting
+	_, err = keeper.SetSupplyCap(ctx, sdk.NewInt64Coin("eeur", 9000))
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("1eeur"))
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("1ejpy"))
+	require.NoError(t, err)
+
+	_, err = keeper.DecreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer1, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lp, MustParseCoins("4000eeur"))
+	require.NoError(t, err)
+
+	// The cap follows the denomination to a new issuer
+	_, err = keeper.TransferDenom(ctx, "eeur", issuer1, issuer2, false)
+	require.NoError(t, err)
+	issuers := keeper.GetIssuers(ctx)
+	require.Empty(t, issuers[0].SupplyCaps)
+	require.Equal(t, MustParseCoins("9000eeur"), issuers[1].SupplyCaps)
+
+	// A zero cap removes it
+	_, err = keeper.SetSupplyCap(ctx, sdk.NewInt64Coin("eeur", 0))
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetIssuers(ctx)[1].SupplyCaps)
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lp, issuer2, MustParseCoins("100000eeur"), nil)
+	require.NoError(t, err)
+}
+
+func TestMintRateLimit(t *testing.T) {
+	ctx, ak, lpk, keeper, _ := createTestComponents(t)
+
+	var (
+		iacc, _  = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		lpacc, _ = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		limit    = lptypes.MintRateLimit{
+			MaxPerTx:     MustParseCoins("1000eeur"),
+			MaxPerWindow: MustParseCoins("1500eeur"),
+			Window:       24 * time.Hour,
+		}
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, lpacc))
+	keeper.AddIssuer(ctx, types.NewIssuer(iacc, "eeur"), []emauthtypes.Denomination{{Base: "eeur"}})
+
+	// Rate limits apply to existing allowances only
+	_, err := keeper.SetMintRateLimit(ctx, iacc, lpacc, limit)
+	require.Error(t, err)
+
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, MustParseCoins("100000eeur"), nil)
+	require.NoError(t, err)
+
+	_, err = keeper.SetMintRateLimit(ctx, iacc, lpacc, lptypes.MintRateLimit{MaxPerTx: MustParseCoins("1ejpy")})
+	require.Error(t, err)
+
+	_, err = keeper.SetMintRateLimit(ctx, iacc, lpacc, limit)
+	require.NoError(t, err)
+
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("1001eeur"))
+	require.ErrorIs(t, err, lptypes.ErrMintRateLimitExceeded)
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("501eeur"))
+	require.ErrorIs(t, err, lptypes.ErrMintRateLimitExceeded)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+
+	// An empty limit removes the rate limit
+	_, err = keeper.SetMintRateLimit(ctx, iacc, lpacc, lptypes.MintRateLimit{})
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("5000eeur"))
+	require.NoError(t, err)
+	require.Equal(t, MustParseCoins("93000eeur"), lpk.GetLiquidityProviderAccount(ctx, lpacc).Mintable)
+}
+
+func TestReserveAttestations(t *testing.T) {
+	ctx, ak, lpk, keeper, _ := createTestComponents(t)
+
+	var (
+		iacc, _  = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		lpacc, _ = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		now      = time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	)
+	ctx = ctx.WithBlockTime(now)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, lpacc))
+	keeper.AddIssuer(ctx, types.NewIssuer(iacc, "eeur", "ejpy"), []emauthtypes.Denomination{{Base: "eeur"}, {Base: "ejpy"}})
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, MustParseCoins("1000eeur"), nil)
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+
+	attestation := types.ReserveAttestation{
+		Denom:         "eeur",
+		ReserveAmount: sdk.NewInt(1200),
+		Currency:      "EUR",
+		AttestedAt:    now.Add(-24 * time.Hour),
+		Auditor:       "Example Audit",
+		DocumentHash:  "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}
+
+	_, err = keeper.AttestReserves(ctx, lpacc, attestation)
+	require.Error(t, err)
+
+	future := attestation
+	future.AttestedAt = now.Add(time.Minute)
+	_, err = keeper.AttestReserves(ctx, iacc, future)
+	require.Error(t, err)
+
+	_, err = keeper.AttestReserves(ctx, iacc, attestation)
+	require.NoError(t, err)
+
+	// Attestations must be newer than the previous one
+	_, err = keeper.AttestReserves(ctx, iacc, attestation)
+	require.Error(t, err)
+
+	coverage := keeper.GetReserveCoverage(ctx, "eeur", types.DefaultAttestationMaxAge)
+	require.Equal(t, sdk.NewInt(1000), coverage.Supply.Amount)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), coverage.CoverageRatio)
+	require.Equal(t, sdk.OneDec(), coverage.DemurrageIndex)
+	require.False(t, coverage.UnderReserved)
+	require.False(t, coverage.Stale)
+
+	coverage = keeper.GetReserveCoverage(ctx, "eeur", time.Hour)
+	require.True(t, coverage.Stale)
+
+	// Supply exceeding the attested reserves is flagged
+	_, err = keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, lpacc, iacc, MustParseCoins("500eeur"), nil)
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, lpacc, MustParseCoins("500eeur"))
+	require.NoError(t, err)
+
+	coverage = keeper.GetReserveCoverage(ctx, "eeur", types.DefaultAttestationMaxAge)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), coverage.CoverageRatio)
+	require.True(t, coverage.UnderReserved)
+
+	// Denominations without attestations are stale
+	coverage = keeper.GetReserveCoverage(ctx, "ejpy", types.DefaultAttestationMaxAge)
+	require.Nil(t, coverage.Attestation)
+	require.True(t, coverage.Stale)
+	require.False(t, coverage.UnderReserved)
+
+	// Unsettled demurrage is part of the supply, the index bounds it
+	keeper.ik = mockInflationKeeper{demurrage: map[string]sdk.Dec{"eeur": sdk.MustNewDecFromStr("0.75")}}
+	coverage = keeper.GetReserveCoverage(ctx, "eeur", types.DefaultAttestationMaxAge)
+	require.Equal(t, sdk.NewInt(1500), coverage.Supply.Amount)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), coverage.CoverageRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), coverage.DemurrageIndex)
+	require.True(t, coverage.UnderReserved)
+}
+
+func TestDenomRestrictions(t *testing.T) {
+	ctx, _, _, keeper, _ := createTestComponents(t)
+
+	var (
+		iacc, _        = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		acc1, _        = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		acc2, _        = sdk.AccAddressFromBech32("emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum")
+		eeur           = MustParseCoins("1eeur")
+		ejpy           = MustParseCoins("1ejpy")
+		notAnIssuer    = acc1
+		noRestrictions = types.DenomRestrictions{Denom: "eeur"}
+	)
+
+	keeper.AddIssuer(ctx, types.NewIssuer(iacc, "eeur"), getDenomsMetadata([]string{"eeur"}))
+	require.Equal(t, noRestrictions, keeper.GetDenomRestrictions(ctx, "eeur"))
+
+	_, err := keeper.UpdateDenylist(ctx, notAnIssuer, "eeur", []sdk.AccAddress{acc2}, nil)
+	require.True(t, types.ErrDoesNotControlDenomination.Is(err))
+	_, err = keeper.UpdateDenylist(ctx, iacc, "ejpy", []sdk.AccAddress{acc2}, nil)
+	require.True(t, types.ErrDoesNotControlDenomination.Is(err))
+
+	// denylisted accounts can neither send nor receive
+	_, err = keeper.UpdateDenylist(ctx, iacc, "eeur", []sdk.AccAddress{acc1}, nil)
+	require.NoError(t, err)
+	require.True(t, types.ErrTransferRestricted.Is(keeper.ValidateSend(ctx, acc1, eeur)))
+	require.True(t, types.ErrTransferRestricted.Is(keeper.ValidateReceive(ctx, acc1, eeur)))
+	require.NoError(t, keeper.ValidateSend(ctx, acc1, ejpy))
+	require.NoError(t, keeper.ValidateReceive(ctx, acc2, eeur))
+
+	// only allowlisted accounts may receive in allowlist-only mode
+	_, err = keeper.SetAllowlistOnly(ctx, iacc, "eeur", true)
+	require.NoError(t, err)
+	require.True(t, types.ErrTransferRestricted.Is(keeper.ValidateReceive(ctx, acc2, eeur)))
+	require.NoError(t, keeper.ValidateSend(ctx, acc2, eeur))
+
+	_, err = keeper.UpdateAllowlist(ctx, iacc, "eeur", []sdk.AccAddress{acc1, acc2}, nil)
+	require.NoError(t, err)
+	require.NoError(t, keeper.ValidateReceive(ctx, acc2, eeur))
+	require.True(t, types.ErrTransferRestricted.Is(keeper.ValidateReceive(ctx, acc1, eeur)), "denylist takes precedence")
+
+	expRestrictions := types.DenomRestrictions{
+		Denom:         "eeur",
+		AllowlistOnly: true,
+		Denylist:      []string{acc1.String()},
+		Allowlist:     []string{acc2.String(), acc1.String()}, // ordered by address bytes
+	}
+	require.Equal(t, expRestrictions, keeper.GetDenomRestrictions(ctx, "eeur"))
+	require.Equal(t, []types.DenomRestrictions{expRestrictions}, keeper.GetAllDenomRestrictions(ctx))
+
+	_, err = keeper.UpdateDenylist(ctx, iacc, "eeur", nil, []sdk.AccAddress{acc1})
+	require.NoError(t, err)
+	_, err = keeper.UpdateAllowlist(ctx, iacc, "eeur", nil, []sdk.AccAddress{acc1, acc2})
+	require.NoError(t, err)
+	_, err = keeper.SetAllowlistOnly(ctx, iacc, "eeur", false)
+	require.NoError(t, err)
+	require.Equal(t, noRestrictions, keeper.GetDenomRestrictions(ctx, "eeur"))
+	require.Empty(t, keeper.GetAllDenomRestrictions(ctx))
+}
+
+func TestFreezeAndClawback(t *testing.T) {
+	ctx, ak, lpk, keeper, bk := createTestComponents(t)
+
+	var (
+		iacc, _     = sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		acc1, _     = sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		acc2, _     = sdk.AccAddressFromBech32("emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum")
+		balanceOf   = func(acc sdk.AccAddress) string { return bk.(bankkeeper.Keeper).GetAllBalances(ctx, acc).String() }
+		notAnIssuer = acc2
+	)
+
+	// Restrictions are enforced by the bank proxy, as in the app
+	proxy := embank.Wrap(bk.(bankkeeper.Keeper))
+	proxy.SetTransferRestrictions(keeper)
+	keeper.bk = proxy
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, acc1))
+	keeper.AddIssuer(ctx, types.NewIssuer(iacc, "eeur"), getDenomsMetadata([]string{"eeur"}))
+	_, err := keeper.IncreaseMintableAmountOfLiquidityProvider(ctx, acc1, iacc, MustParseCoins("1000eeur"), nil)
+	require.NoError(t, err)
+	_, err = lpk.MintTokens(ctx, acc1, MustParseCoins("1000eeur"))
+	require.NoError(t, err)
+
+	_, err = keeper.FreezeAccount(ctx, notAnIssuer, "eeur", acc1)
+	require.True(t, types.ErrDoesNotControlDenomination.Is(err))
+	_, err = keeper.UnfreezeAccount(ctx, iacc, "eeur", acc1)
+	require.Error(t, err, "not frozen")
+
+	_, err = keeper.FreezeAccount(ctx, iacc, "eeur", acc1)
+	require.NoError(t, err)
+	require.True(t, types.ErrAccountFrozen.Is(keeper.ValidateSend(ctx, acc1, MustParseCoins("1eeur"))))
+	require.NoError(t, keeper.ValidateReceive(ctx, acc1, MustParseCoins("1eeur")))
+	require.Equal(t, []string{acc1.String()}, keeper.GetDenomRestrictions(ctx, "eeur").Frozen)
+
+	// frozen balances can be recovered
+	_, err = keeper.Clawback(ctx, notAnIssuer, acc1, sdk.NewInt64Coin("eeur", 100), nil, "case-1")
+	require.True(t, types.ErrDoesNotControlDenomination.Is(err))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.Clawback(ctx, iacc, acc1, sdk.NewInt64Coin("eeur", 100), acc2, "case-1")
+	require.NoError(t, err)
+	require.Equal(t, "900eeur", balanceOf(acc1))
+	require.Equal(t, "100eeur", balanceOf(acc2))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeClawback, events[len(events)-1].Type)
+
+	// denylisted accounts cannot receive recovered tokens
+	acc3, _ := sdk.AccAddressFromBech32("emoney1cs4323dyzu0wxfj4vc62m8q3xsczfavqx9x3zd")
+	_, err = keeper.UpdateDenylist(ctx, iacc, "eeur", []sdk.AccAddress{acc3}, nil)
+	require.NoError(t, err)
+	// the transaction is discarded on failure, which reverts the recovery from acc1
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = keeper.Clawback(cacheCtx, iacc, acc1, sdk.NewInt64Coin("eeur", 100), acc3, "case-1b")
+	require.True(t, types.ErrTransferRestricted.Is(err))
+	require.Equal(t, "900eeur", balanceOf(acc1))
+
+	_, err = keeper.Clawback(ctx, iacc, acc1, sdk.NewInt64Coin("eeur", 900), nil, "case-2")
+	require.NoError(t, err)
+	require.Empty(t, balanceOf(acc1))
+	require.Equal(t, "100eeur", bk.(bankkeeper.Keeper).GetSupply(ctx, "eeur").String())
+
+	_, err = keeper.Clawback(ctx, iacc, acc1, sdk.NewInt64Coin("eeur", 1), nil, "case-3")
+	require.Error(t, err, "insufficient funds")
+
+	_, err = keeper.UnfreezeAccount(ctx, iacc, "eeur", acc1)
+	require.NoError(t, err)
+	require.NoError(t, keeper.ValidateSend(ctx, acc1, MustParseCoins("1eeur")))
+}
+
 func TestCollectDenominations(t *testing.T) {
 	issuers := []types.Issuer{
 		{
@@ -320,7 +783,8 @@ func createTestComponentsWithEncodingConfig(t *testing.T, encConfig simappparams
 
 		blockedAddrs = make(map[string]bool)
 		maccPerms    = map[string][]string{
-			types.ModuleName: {authtypes.Minter},
+			types.ModuleName:   {authtypes.Minter},
+			lptypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 		}
 	)
 
@@ -361,19 +825,33 @@ func createTestComponentsWithEncodingConfig(t *testing.T, encConfig simappparams
 	lpk := liquidityprovider.NewKeeper(encConfig.Marshaler, lpKey, bk)
 
 	keeper := NewKeeper(encConfig.Marshaler, issuerKey, lpk, mockInflationKeeper{}, bk)
+	lpk.SetSupplyCaps(keeper)
 	return ctx, ak, lpk, keeper, bk
 }
 
-type mockInflationKeeper struct{}
+type mockInflationKeeper struct {
+	demurrage map[string]sdk.Dec
+}
 
 func (m mockInflationKeeper) SetInflation(ctx sdk.Context, inflation sdk.Dec, denom string) (_ *sdk.Result, _ error) {
 	return
 }
 
+func (m mockInflationKeeper) SetInflationSchedule(sdk.Context, string, []inflationtypes.InflationStep) (_ *sdk.Result, _ error) {
+	return
+}
+
 func (m mockInflationKeeper) AddDenoms(sdk.Context, []string) (_ *sdk.Result, _ error) {
 	return
 }
 
+func (m mockInflationKeeper) GetDemurrageIndex(_ sdk.Context, denom string) (sdk.Dec, bool) {
+	if index, found := m.demurrage[denom]; found {
+		return index, true
+	}
+	return sdk.OneDec(), false
+}
+
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
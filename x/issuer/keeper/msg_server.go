@@ -2,19 +2,33 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 )
 
 var _ types.MsgServer = msgServer{}
 
 type issuerKeeper interface {
-	IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error)
+	IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error)
 	DecreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableDecrease sdk.Coins) (*sdk.Result, error)
 	RevokeLiquidityProvider(ctx sdk.Context, liquidityProvider, issuerAddress sdk.AccAddress) (*sdk.Result, error)
 	SetInflationRate(ctx sdk.Context, issuer sdk.AccAddress, inflationRate sdk.Dec, denom string) (*sdk.Result, error)
+	ScheduleInflation(ctx sdk.Context, issuer sdk.AccAddress, denom string, schedule []inflationtypes.InflationStep) (*sdk.Result, error)
+	UpdateDenylist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error)
+	UpdateAllowlist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error)
+	SetAllowlistOnly(ctx sdk.Context, issuer sdk.AccAddress, denom string, enabled bool) (*sdk.Result, error)
+	FreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error)
+	UnfreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error)
+	Clawback(ctx sdk.Context, issuer, account sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress, reference string) (*sdk.Result, error)
+	UpdateDenomMetadata(ctx sdk.Context, issuer sdk.AccAddress, metadata banktypes.Metadata) (*sdk.Result, error)
+	SetMintRateLimit(ctx sdk.Context, issuer, liquidityProvider sdk.AccAddress, limit lptypes.MintRateLimit) (*sdk.Result, error)
+	AttestReserves(ctx sdk.Context, issuer sdk.AccAddress, attestation types.ReserveAttestation) (*sdk.Result, error)
 }
 
 type msgServer struct {
@@ -37,7 +51,7 @@ func (m msgServer) IncreaseMintable(c context.Context, msg *types.MsgIncreaseMin
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "liquidity provider:"+msg.LiquidityProvider)
 	}
 
-	result, err := m.k.IncreaseMintableAmountOfLiquidityProvider(ctx, lqAcc, issuer, msg.MintableIncrease)
+	result, err := m.k.IncreaseMintableAmountOfLiquidityProvider(ctx, lqAcc, issuer, msg.MintableIncrease, msg.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -108,3 +122,231 @@ func (m msgServer) SetInflation(c context.Context, msg *types.MsgSetInflation) (
 	}
 	return &types.MsgSetInflationResponse{}, nil
 }
+
+func (m msgServer) ScheduleInflation(c context.Context, msg *types.MsgScheduleInflation) (*types.MsgScheduleInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.ScheduleInflation(ctx, issuer, msg.Denom, msg.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgScheduleInflationResponse{}, nil
+}
+
+func (m msgServer) UpdateDenylist(c context.Context, msg *types.MsgUpdateDenylist) (*types.MsgUpdateDenylistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	add, err := toAccAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err := toAccAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.k.UpdateDenylist(ctx, issuer, msg.Denom, add, remove)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgUpdateDenylistResponse{}, nil
+}
+
+func (m msgServer) UpdateAllowlist(c context.Context, msg *types.MsgUpdateAllowlist) (*types.MsgUpdateAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	add, err := toAccAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err := toAccAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.k.UpdateAllowlist(ctx, issuer, msg.Denom, add, remove)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgUpdateAllowlistResponse{}, nil
+}
+
+func (m msgServer) SetAllowlistOnly(c context.Context, msg *types.MsgSetAllowlistOnly) (*types.MsgSetAllowlistOnlyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.SetAllowlistOnly(ctx, issuer, msg.Denom, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetAllowlistOnlyResponse{}, nil
+}
+
+func (m msgServer) FreezeAccount(c context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "account:"+msg.Account)
+	}
+
+	result, err := m.k.FreezeAccount(ctx, issuer, msg.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (m msgServer) UnfreezeAccount(c context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "account:"+msg.Account)
+	}
+
+	result, err := m.k.UnfreezeAccount(ctx, issuer, msg.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (m msgServer) Clawback(c context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "account:"+msg.Account)
+	}
+
+	var recipient sdk.AccAddress
+	if len(msg.Recipient) != 0 {
+		recipient, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient:"+msg.Recipient)
+		}
+	}
+
+	result, err := m.k.Clawback(ctx, issuer, account, msg.Amount, recipient, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgClawbackResponse{}, nil
+}
+
+func (m msgServer) UpdateDenomMetadata(c context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.UpdateDenomMetadata(ctx, issuer, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+func (m msgServer) SetMintRateLimit(c context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	lqAcc, err := sdk.AccAddressFromBech32(msg.LiquidityProvider)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "liquidity provider:"+msg.LiquidityProvider)
+	}
+
+	result, err := m.k.SetMintRateLimit(ctx, issuer, lqAcc, msg.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetMintRateLimitResponse{}, nil
+}
+
+func (m msgServer) AttestReserves(c context.Context, msg *types.MsgAttestReserves) (*types.MsgAttestReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.AttestReserves(ctx, issuer, msg.Attestation)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgAttestReservesResponse{}, nil
+}
+
+func toAccAddresses(bech32Addrs []string) ([]sdk.AccAddress, error) {
+	res := make([]sdk.AccAddress, len(bech32Addrs))
+	for i, bech32 := range bech32Addrs {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "account:"+bech32)
+		}
+		res[i] = addr
+	}
+	return res, nil
+}
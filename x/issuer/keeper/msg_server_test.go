@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
@@ -29,7 +33,7 @@ func TestIncreaseMintableAmountOfLiquidityProvider(t *testing.T) {
 	specs := map[string]struct {
 		setup     func(ctx sdk.Context)
 		req       *types.MsgIncreaseMintable
-		mockFn    func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error)
+		mockFn    func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error)
 		expErr    bool
 		expEvents sdk.Events
 	}{
@@ -39,7 +43,7 @@ func TestIncreaseMintableAmountOfLiquidityProvider(t *testing.T) {
 				LiquidityProvider: lpAddr.String(),
 				MintableIncrease:  sdk.NewCoins(sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()}),
 			},
-			mockFn: func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error) {
+			mockFn: func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error) {
 				gotLiquidityProviderAddr = liquidityProvider.String()
 				gotIssuer = issuer
 				gotMintableIncrease = mintableIncrease
@@ -91,7 +95,7 @@ func TestIncreaseMintableAmountOfLiquidityProvider(t *testing.T) {
 				LiquidityProvider: lpAddr.String(),
 				MintableIncrease:  sdk.NewCoins(sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()}),
 			},
-			mockFn: func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error) {
+			mockFn: func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error) {
 				return nil, errors.New("testing")
 			},
 			expErr: true,
@@ -218,6 +222,92 @@ func TestDecreaseMintableAmountOfLiquidityProvider(t *testing.T) {
 	}
 }
 
+func TestUpdateDenylist(t *testing.T) {
+	var (
+		issuerAddr = accAddress
+		gotIssuer  sdk.AccAddress
+		gotDenom   string
+		gotAdd     []sdk.AccAddress
+		gotRemove  []sdk.AccAddress
+	)
+
+	keeper := issuerKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req       *types.MsgUpdateDenylist
+		mockFn    func(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error)
+		expErr    bool
+		expEvents sdk.Events
+	}{
+		"all good": {
+			req: &types.MsgUpdateDenylist{
+				Issuer: issuerAddr.String(),
+				Denom:  "eeur",
+				Add:    []string{accAddress.String()},
+			},
+			mockFn: func(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+				gotIssuer, gotDenom, gotAdd, gotRemove = issuer, denom, add, remove
+				return &sdk.Result{
+					Events: []abcitypes.Event{{
+						Type:       "testing",
+						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+					}},
+				}, nil
+			},
+			expEvents: sdk.Events{{
+				Type:       "testing",
+				Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+			}},
+		},
+		"issuer invalid": {
+			req: &types.MsgUpdateDenylist{
+				Issuer: "invalid",
+				Denom:  "eeur",
+				Add:    []string{accAddress.String()},
+			},
+			expErr: true,
+		},
+		"account invalid": {
+			req: &types.MsgUpdateDenylist{
+				Issuer: issuerAddr.String(),
+				Denom:  "eeur",
+				Remove: []string{"invalid"},
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgUpdateDenylist{
+				Issuer: issuerAddr.String(),
+				Denom:  "eeur",
+				Add:    []string{accAddress.String()},
+			},
+			mockFn: func(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.UpdateDenylistFn = spec.mockFn
+			eventManager := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
+			_, gotErr := svr.UpdateDenylist(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, eventManager.Events())
+			assert.Equal(t, spec.req.Issuer, gotIssuer.String())
+			assert.Equal(t, spec.req.Denom, gotDenom)
+			assert.Equal(t, []sdk.AccAddress{accAddress}, gotAdd)
+			assert.Empty(t, gotRemove)
+		})
+	}
+}
+
 func TestSetInflationRate(t *testing.T) {
 	var (
 		issuerAddr       = accAddress
@@ -345,17 +435,27 @@ func TestSetInflationRate(t *testing.T) {
 }
 
 type issuerKeeperMock struct {
-	IncreaseMintableAmountOfLiquidityProviderFn func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error)
+	IncreaseMintableAmountOfLiquidityProviderFn func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error)
 	DecreaseMintableAmountOfLiquidityProviderFn func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableDecrease sdk.Coins) (*sdk.Result, error)
 	RevokeLiquidityProviderFn                   func(ctx sdk.Context, liquidityProvider, issuerAddress sdk.AccAddress) (*sdk.Result, error)
 	SetInflationRateFn                          func(ctx sdk.Context, issuer sdk.AccAddress, inflationRate sdk.Dec, denom string) (*sdk.Result, error)
+	ScheduleInflationFn                         func(ctx sdk.Context, issuer sdk.AccAddress, denom string, schedule []inflationtypes.InflationStep) (*sdk.Result, error)
+	UpdateDenylistFn                            func(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error)
+	UpdateAllowlistFn                           func(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error)
+	SetAllowlistOnlyFn                          func(ctx sdk.Context, issuer sdk.AccAddress, denom string, enabled bool) (*sdk.Result, error)
+	FreezeAccountFn                             func(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error)
+	UnfreezeAccountFn                           func(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error)
+	ClawbackFn                                  func(ctx sdk.Context, issuer, account sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress, reference string) (*sdk.Result, error)
+	UpdateDenomMetadataFn                       func(ctx sdk.Context, issuer sdk.AccAddress, metadata banktypes.Metadata) (*sdk.Result, error)
+	SetMintRateLimitFn                          func(ctx sdk.Context, issuer, liquidityProvider sdk.AccAddress, limit lptypes.MintRateLimit) (*sdk.Result, error)
+	AttestReservesFn                            func(ctx sdk.Context, issuer sdk.AccAddress, attestation types.ReserveAttestation) (*sdk.Result, error)
 }
 
-func (m issuerKeeperMock) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error) {
+func (m issuerKeeperMock) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins, expiresAt *time.Time) (*sdk.Result, error) {
 	if m.IncreaseMintableAmountOfLiquidityProviderFn == nil {
 		panic("not expected to be called")
 	}
-	return m.IncreaseMintableAmountOfLiquidityProviderFn(ctx, liquidityProvider, issuer, mintableIncrease)
+	return m.IncreaseMintableAmountOfLiquidityProviderFn(ctx, liquidityProvider, issuer, mintableIncrease, expiresAt)
 }
 
 func (m issuerKeeperMock) DecreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableDecrease sdk.Coins) (*sdk.Result, error) {
@@ -372,9 +472,79 @@ func (m issuerKeeperMock) RevokeLiquidityProvider(ctx sdk.Context, liquidityProv
 	return m.RevokeLiquidityProviderFn(ctx, liquidityProvider, issuerAddress)
 }
 
+func (m issuerKeeperMock) ScheduleInflation(ctx sdk.Context, issuer sdk.AccAddress, denom string, schedule []inflationtypes.InflationStep) (*sdk.Result, error) {
+	if m.ScheduleInflationFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ScheduleInflationFn(ctx, issuer, denom, schedule)
+}
+
 func (m issuerKeeperMock) SetInflationRate(ctx sdk.Context, issuer sdk.AccAddress, inflationRate sdk.Dec, denom string) (*sdk.Result, error) {
 	if m.SetInflationRateFn == nil {
 		panic("not expected to be called")
 	}
 	return m.SetInflationRateFn(ctx, issuer, inflationRate, denom)
 }
+
+func (m issuerKeeperMock) UpdateDenylist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+	if m.UpdateDenylistFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UpdateDenylistFn(ctx, issuer, denom, add, remove)
+}
+
+func (m issuerKeeperMock) UpdateAllowlist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+	if m.UpdateAllowlistFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UpdateAllowlistFn(ctx, issuer, denom, add, remove)
+}
+
+func (m issuerKeeperMock) SetAllowlistOnly(ctx sdk.Context, issuer sdk.AccAddress, denom string, enabled bool) (*sdk.Result, error) {
+	if m.SetAllowlistOnlyFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetAllowlistOnlyFn(ctx, issuer, denom, enabled)
+}
+
+func (m issuerKeeperMock) FreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error) {
+	if m.FreezeAccountFn == nil {
+		panic("not expected to be called")
+	}
+	return m.FreezeAccountFn(ctx, issuer, denom, account)
+}
+
+func (m issuerKeeperMock) UnfreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error) {
+	if m.UnfreezeAccountFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnfreezeAccountFn(ctx, issuer, denom, account)
+}
+
+func (m issuerKeeperMock) Clawback(ctx sdk.Context, issuer, account sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress, reference string) (*sdk.Result, error) {
+	if m.ClawbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ClawbackFn(ctx, issuer, account, amount, recipient, reference)
+}
+
+func (m issuerKeeperMock) UpdateDenomMetadata(ctx sdk.Context, issuer sdk.AccAddress, metadata banktypes.Metadata) (*sdk.Result, error) {
+	if m.UpdateDenomMetadataFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UpdateDenomMetadataFn(ctx, issuer, metadata)
+}
+
+func (m issuerKeeperMock) SetMintRateLimit(ctx sdk.Context, issuer, liquidityProvider sdk.AccAddress, limit lptypes.MintRateLimit) (*sdk.Result, error) {
+	if m.SetMintRateLimitFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetMintRateLimitFn(ctx, issuer, liquidityProvider, limit)
+}
+
+func (m issuerKeeperMock) AttestReserves(ctx sdk.Context, issuer sdk.AccAddress, attestation types.ReserveAttestation) (*sdk.Result, error) {
+	if m.AttestReservesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AttestReservesFn(ctx, issuer, attestation)
+}
@@ -0,0 +1,111 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+const keyPrefixReserves = "reserves/"
+
+// AttestReserves records the latest reserve attestation of a denomination controlled by the issuer.
+func (k Keeper) AttestReserves(ctx sdk.Context, issuer sdk.AccAddress, attestation types.ReserveAttestation) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), attestation.Denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, attestation.Denom)
+	}
+
+	if err := attestation.Validate(); err != nil {
+		return nil, err
+	}
+
+	if attestation.AttestedAt.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "attestation time %v is in the future", attestation.AttestedAt)
+	}
+
+	if previous, found := k.GetReserveAttestation(ctx, attestation.Denom); found && !attestation.AttestedAt.After(previous.AttestedAt) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "attestation is not newer than the one of %v", previous.AttestedAt)
+	}
+
+	k.SetReserveAttestation(ctx, attestation)
+
+	k.logger(ctx).Info("Reserves attested", "denom", attestation.Denom, "amount", attestation.ReserveAmount, "auditor", attestation.Auditor)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReserveAttestation,
+			sdk.NewAttribute(types.AttributeKeyDenom, attestation.Denom),
+			sdk.NewAttribute(types.AttributeKeyIssuer, issuer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, attestation.ReserveAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyAuditor, attestation.Auditor),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func (k Keeper) GetReserveAttestation(ctx sdk.Context, denom string) (types.ReserveAttestation, bool) {
+	var attestation types.ReserveAttestation
+
+	bz := k.reservesStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return attestation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
+
+// SetReserveAttestation stores an attestation without checks. Used when importing state.
+func (k Keeper) SetReserveAttestation(ctx sdk.Context, attestation types.ReserveAttestation) {
+	k.reservesStore(ctx).Set([]byte(attestation.Denom), k.cdc.MustMarshal(&attestation))
+}
+
+func (k Keeper) GetAllReserveAttestations(ctx sdk.Context) (res []types.ReserveAttestation) {
+	iterator := k.reservesStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.ReserveAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		res = append(res, attestation)
+	}
+
+	return
+}
+
+// GetReserveCoverage compares the latest reserve attestation of the denomination with its supply. Attestations older
+// than maxAge are flagged as stale.
+// The supply includes demurrage that holders have not settled yet. Subtracting it exactly would mean visiting every
+// balance of the denomination, so the demurrage index is reported instead to bound the unsettled part.
+func (k Keeper) GetReserveCoverage(ctx sdk.Context, denom string, maxAge time.Duration) types.ReserveCoverage {
+	coverage := types.ReserveCoverage{
+		Denom:         denom,
+		Supply:        k.bk.GetSupply(ctx, denom),
+		CoverageRatio: sdk.ZeroDec(),
+		Stale:         true,
+	}
+	coverage.DemurrageIndex, _ = k.ik.GetDemurrageIndex(ctx, denom)
+
+	reserves := sdk.ZeroInt()
+	if attestation, found := k.GetReserveAttestation(ctx, denom); found {
+		coverage.Attestation = &attestation
+		coverage.Stale = ctx.BlockTime().Sub(attestation.AttestedAt) > maxAge
+		reserves = attestation.ReserveAmount
+	}
+
+	if coverage.Supply.Amount.IsPositive() {
+		coverage.CoverageRatio = reserves.ToDec().QuoInt(coverage.Supply.Amount)
+	}
+	coverage.UnderReserved = coverage.Supply.Amount.GT(reserves)
+
+	return coverage
+}
+
+func (k Keeper) reservesStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefixReserves))
+}
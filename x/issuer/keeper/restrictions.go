@@ -0,0 +1,231 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+const (
+	keyPrefixDenylist      = "denylist/"
+	keyPrefixAllowlist     = "allowlist/"
+	keyPrefixAllowlistOnly = "allowlistonly/"
+	keyPrefixFrozen        = "frozen/"
+)
+
+// UpdateDenylist adds and removes accounts from the list of accounts that may not send or receive the denomination.
+func (k Keeper) UpdateDenylist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	k.updateList(ctx, keyPrefixDenylist, denom, add, remove)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// UpdateAllowlist adds and removes accounts from the list of accounts that may receive the denomination in allowlist-only mode.
+func (k Keeper) UpdateAllowlist(ctx sdk.Context, issuer sdk.AccAddress, denom string, add, remove []sdk.AccAddress) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	k.updateList(ctx, keyPrefixAllowlist, denom, add, remove)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// SetAllowlistOnly toggles whether only accounts on the allowlist may receive the denomination.
+func (k Keeper) SetAllowlistOnly(ctx sdk.Context, issuer sdk.AccAddress, denom string, enabled bool) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	k.setAllowlistOnly(ctx, denom, enabled)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomRestrictions,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAction, "allowlist_only"),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// FreezeAccount prevents the account from sending the denomination.
+func (k Keeper) FreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	k.updateList(ctx, keyPrefixFrozen, denom, []sdk.AccAddress{account}, nil)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// UnfreezeAccount allows a frozen account to send the denomination again.
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, issuer sdk.AccAddress, denom string, account sdk.AccAddress) (*sdk.Result, error) {
+	if _, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrDoesNotControlDenomination, denom)
+	}
+
+	if !k.IsFrozen(ctx, denom, account) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%v is not frozen for %v", account, denom)
+	}
+
+	k.updateList(ctx, keyPrefixFrozen, denom, nil, []sdk.AccAddress{account})
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// ValidateSend rejects transfers out of accounts denylisted or frozen for any of the coins.
+func (k Keeper) ValidateSend(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if k.IsDenylisted(ctx, coin.Denom, sender) {
+			return sdkerrors.Wrapf(types.ErrTransferRestricted, "%v may not send %v", sender, coin.Denom)
+		}
+
+		if k.IsFrozen(ctx, coin.Denom, sender) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%v may not send %v", sender, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ValidateReceive rejects transfers to accounts that are denylisted or, in allowlist-only mode, not allowlisted for any of the coins.
+func (k Keeper) ValidateReceive(ctx sdk.Context, recipient sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if k.IsDenylisted(ctx, coin.Denom, recipient) {
+			return sdkerrors.Wrapf(types.ErrTransferRestricted, "%v may not receive %v", recipient, coin.Denom)
+		}
+
+		if k.isAllowlistOnly(ctx, coin.Denom) && !k.IsAllowlisted(ctx, coin.Denom, recipient) {
+			return sdkerrors.Wrapf(types.ErrTransferRestricted, "%v is not allowed to hold %v", recipient, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) IsDenylisted(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return k.listStore(ctx, keyPrefixDenylist, denom).Has(account)
+}
+
+func (k Keeper) IsFrozen(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return k.listStore(ctx, keyPrefixFrozen, denom).Has(account)
+}
+
+func (k Keeper) IsAllowlisted(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return k.listStore(ctx, keyPrefixAllowlist, denom).Has(account)
+}
+
+// GetDenomRestrictions returns the transfer restrictions of the denomination.
+func (k Keeper) GetDenomRestrictions(ctx sdk.Context, denom string) types.DenomRestrictions {
+	return types.DenomRestrictions{
+		Denom:         denom,
+		AllowlistOnly: k.isAllowlistOnly(ctx, denom),
+		Denylist:      k.getList(ctx, keyPrefixDenylist, denom),
+		Allowlist:     k.getList(ctx, keyPrefixAllowlist, denom),
+		Frozen:        k.getList(ctx, keyPrefixFrozen, denom),
+	}
+}
+
+// GetAllDenomRestrictions returns the transfer restrictions of all issued denominations that have any.
+func (k Keeper) GetAllDenomRestrictions(ctx sdk.Context) (res []types.DenomRestrictions) {
+	for _, denom := range collectDenoms(k.GetIssuers(ctx)) {
+		r := k.GetDenomRestrictions(ctx, denom)
+		if !r.AllowlistOnly && len(r.Denylist) == 0 && len(r.Allowlist) == 0 && len(r.Frozen) == 0 {
+			continue
+		}
+
+		res = append(res, r)
+	}
+
+	return
+}
+
+// SetDenomRestrictions replaces the transfer restrictions of a denomination. Used when importing state.
+func (k Keeper) SetDenomRestrictions(ctx sdk.Context, r types.DenomRestrictions) error {
+	lists := map[string][]string{
+		keyPrefixDenylist:  r.Denylist,
+		keyPrefixAllowlist: r.Allowlist,
+		keyPrefixFrozen:    r.Frozen,
+	}
+
+	for keyPrefix, list := range lists {
+		store := k.listStore(ctx, keyPrefix, r.Denom)
+		for _, bech32 := range list {
+			account, err := sdk.AccAddressFromBech32(bech32)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%v", bech32)
+			}
+			store.Set(account, []byte{})
+		}
+	}
+
+	k.setAllowlistOnly(ctx, r.Denom, r.AllowlistOnly)
+	return nil
+}
+
+func (k Keeper) updateList(ctx sdk.Context, keyPrefix, denom string, add, remove []sdk.AccAddress) {
+	store := k.listStore(ctx, keyPrefix, denom)
+	// the list name without the trailing separator
+	list := keyPrefix[:len(keyPrefix)-1]
+
+	for _, account := range add {
+		store.Set(account, []byte{})
+		emitListEvent(ctx, denom, list+"_add", account)
+	}
+
+	for _, account := range remove {
+		store.Delete(account)
+		emitListEvent(ctx, denom, list+"_remove", account)
+	}
+}
+
+func (k Keeper) getList(ctx sdk.Context, keyPrefix, denom string) (res []string) {
+	iterator := k.listStore(ctx, keyPrefix, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		res = append(res, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return
+}
+
+func (k Keeper) listStore(ctx sdk.Context, keyPrefix, denom string) prefix.Store {
+	key := append([]byte(keyPrefix), address.MustLengthPrefix([]byte(denom))...)
+	return prefix.NewStore(ctx.KVStore(k.storeKey), key)
+}
+
+func (k Keeper) isAllowlistOnly(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(keyPrefixAllowlistOnly + denom))
+}
+
+func (k Keeper) setAllowlistOnly(ctx sdk.Context, denom string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(keyPrefixAllowlistOnly + denom)
+	if enabled {
+		store.Set(key, []byte{})
+		return
+	}
+	store.Delete(key)
+}
+
+func emitListEvent(ctx sdk.Context, denom, action string, account sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomRestrictions,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAction, action),
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+		),
+	)
+}
@@ -0,0 +1,89 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// SetSupplyCap caps the supply plus outstanding mintable amounts of a denomination on the record of its issuer.
+// A zero amount removes the cap.
+func (k Keeper) SetSupplyCap(ctx sdk.Context, supplyCap sdk.Coin) (*sdk.Result, error) {
+	issuers := k.GetIssuers(ctx)
+
+	found := false
+	for i := range issuers {
+		if !anyContained(issuers[i].Denoms, supplyCap.Denom) {
+			continue
+		}
+
+		issuers[i].SupplyCaps = removeDenom(issuers[i].SupplyCaps, supplyCap.Denom)
+		if supplyCap.IsPositive() {
+			issuers[i].SupplyCaps = issuers[i].SupplyCaps.Add(supplyCap)
+		}
+		found = true
+		break
+	}
+
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrDoesNotControlDenomination, "no issuer controls %v", supplyCap.Denom)
+	}
+	k.setIssuers(ctx, issuers)
+
+	k.logger(ctx).Info("Supply cap set", "denom", supplyCap.Denom, "cap", supplyCap.Amount)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSupplyCap,
+			sdk.NewAttribute(types.AttributeKeyDenom, supplyCap.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, supplyCap.Amount.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// ValidateMint refuses mints while the supply plus outstanding mintable amounts exceed the cap of the denomination.
+// Minting turns mintable amounts into supply, so this only happens after a cap has been lowered.
+func (k Keeper) ValidateMint(ctx sdk.Context, amount sdk.Coins) error {
+	return k.validateSupplyCaps(ctx, amount, false)
+}
+
+// validateSupplyCaps checks that the supply plus outstanding mintable amounts of the denominations in amount stay
+// within their caps, optionally after increasing the mintable amounts by amount.
+func (k Keeper) validateSupplyCaps(ctx sdk.Context, amount sdk.Coins, increase bool) error {
+	caps := sdk.NewCoins()
+	for _, issuer := range k.GetIssuers(ctx) {
+		caps = caps.Add(issuer.SupplyCaps...)
+	}
+
+	for _, coin := range amount {
+		supplyCap := caps.AmountOf(coin.Denom)
+		if !supplyCap.IsPositive() {
+			continue
+		}
+
+		total := k.bk.GetSupply(ctx, coin.Denom).Amount.Add(k.outstandingMintable(ctx, coin.Denom))
+		if increase {
+			total = total.Add(coin.Amount)
+		}
+
+		if total.GT(supplyCap) {
+			return sdkerrors.Wrapf(types.ErrSupplyCapExceeded, "%v%v > %v%v", total, coin.Denom, supplyCap, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) outstandingMintable(ctx sdk.Context, denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	k.lpKeeper.IterateProviders(ctx, func(prov lptypes.LiquidityProviderAccount) (stop bool) {
+		total = total.Add(prov.Mintable.AmountOf(denom))
+		return false
+	})
+	return total
+}
@@ -93,7 +93,11 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	issuers := am.keeper.GetIssuers(ctx)
-	gs := types.GenesisState{Issuers: issuers}
+	gs := types.GenesisState{
+		Issuers:             issuers,
+		DenomRestrictions:   am.keeper.GetAllDenomRestrictions(ctx),
+		ReserveAttestations: am.keeper.GetAllReserveAttestations(ctx),
+	}
 	return cdc.MustMarshalJSON(&gs)
 }
 
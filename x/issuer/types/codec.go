@@ -26,6 +26,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDecreaseMintable{}, "e-money/MsgDecreaseMintable", nil)
 	cdc.RegisterConcrete(&MsgRevokeLiquidityProvider{}, "e-money/MsgRevokeLiquidityProvider", nil)
 	cdc.RegisterConcrete(&MsgSetInflation{}, "e-money/MsgSetInflation", nil)
+	cdc.RegisterConcrete(&MsgScheduleInflation{}, "e-money/MsgScheduleInflation", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenylist{}, "e-money/MsgUpdateDenylist", nil)
+	cdc.RegisterConcrete(&MsgUpdateAllowlist{}, "e-money/MsgUpdateAllowlist", nil)
+	cdc.RegisterConcrete(&MsgSetAllowlistOnly{}, "e-money/MsgSetAllowlistOnly", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "e-money/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "e-money/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "e-money/MsgClawback", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomMetadata{}, "e-money/MsgUpdateDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetMintRateLimit{}, "e-money/MsgSetMintRateLimit", nil)
+	cdc.RegisterConcrete(&MsgAttestReserves{}, "e-money/MsgAttestReserves", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -34,6 +44,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDecreaseMintable{},
 		&MsgRevokeLiquidityProvider{},
 		&MsgSetInflation{},
+		&MsgScheduleInflation{},
+		&MsgUpdateDenylist{},
+		&MsgUpdateAllowlist{},
+		&MsgSetAllowlistOnly{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgClawback{},
+		&MsgUpdateDenomMetadata{},
+		&MsgSetMintRateLimit{},
+		&MsgAttestReserves{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
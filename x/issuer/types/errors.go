@@ -12,6 +12,11 @@ var (
 	ErrDoesNotControlDenomination  = sdkerrors.Register(ModuleName, 3, "Account is not an Issuer of this Denomination")
 	ErrDenominationAlreadyAssigned = sdkerrors.Register(ModuleName, 4, "Domination has already been assigned")
 	ErrNotAnIssuer                 = sdkerrors.Register(ModuleName, 5, "Account is not an issuer")
-	ErrNegativeInflation           = sdkerrors.Register(ModuleName, 6, "Inflation can't be negative")
+	ErrInvalidInflation            = sdkerrors.Register(ModuleName, 6, "Inflation must be above -100%")
 	ErrDenomInflation              = sdkerrors.Register(ModuleName, 7, "Inflation denomination error")
+	ErrTransferRestricted          = sdkerrors.Register(ModuleName, 8, "Transfer restricted by the issuer of the denomination")
+	ErrAccountFrozen               = sdkerrors.Register(ModuleName, 9, "Account is frozen for this denomination")
+	ErrInvalidDenomMetadata        = sdkerrors.Register(ModuleName, 10, "Invalid denomination metadata")
+	ErrInvalidAttestation          = sdkerrors.Register(ModuleName, 11, "Invalid reserve attestation")
+	ErrSupplyCapExceeded           = sdkerrors.Register(ModuleName, 12, "Supply cap of the denomination exceeded")
 )
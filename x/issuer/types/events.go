@@ -0,0 +1,30 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+// Issuer module event types
+const (
+	EventTypeDenomRestrictions  = "denom_restrictions"
+	EventTypeClawback           = "clawback"
+	EventTypeDenomMetadata      = "denom_metadata"
+	EventTypeTransferDenom      = "transfer_denom"
+	EventTypeMintRateLimit      = "mint_rate_limit"
+	EventTypeReserveAttestation = "reserve_attestation"
+	EventTypeSupplyCap          = "supply_cap"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAction    = "action"
+	AttributeKeyAccount   = "account"
+	AttributeKeyEnabled   = "enabled"
+	AttributeKeyIssuer    = "issuer"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyReference = "reference"
+	AttributeKeyAuditor   = "auditor"
+
+	AttributeKeyFromIssuer         = "from_issuer"
+	AttributeKeyToIssuer           = "to_issuer"
+	AttributeKeyLiquidityProviders = "liquidity_providers"
+)
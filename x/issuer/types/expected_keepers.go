@@ -7,16 +7,23 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 type (
 	InflationKeeper interface {
 		SetInflation(sdk.Context, sdk.Dec, string) (*sdk.Result, error)
+		SetInflationSchedule(sdk.Context, string, []inflationtypes.InflationStep) (*sdk.Result, error)
 		AddDenoms(sdk.Context, []string) (*sdk.Result, error)
+		GetDemurrageIndex(sdk.Context, string) (sdk.Dec, bool)
 	}
 
 	BankKeeper interface {
 		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	}
 )
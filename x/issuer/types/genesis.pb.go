@@ -24,7 +24,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Issuers []Issuer `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers" yaml:"issuers"`
+	Issuers             []Issuer             `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers" yaml:"issuers"`
+	DenomRestrictions   []DenomRestrictions  `protobuf:"bytes,2,rep,name=denom_restrictions,json=denomRestrictions,proto3" json:"denom_restrictions" yaml:"denom_restrictions"`
+	ReserveAttestations []ReserveAttestation `protobuf:"bytes,3,rep,name=reserve_attestations,json=reserveAttestations,proto3" json:"reserve_attestations" yaml:"reserve_attestations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +69,20 @@ func (m *GenesisState) GetIssuers() []Issuer {
 	return nil
 }
 
+func (m *GenesisState) GetDenomRestrictions() []DenomRestrictions {
+	if m != nil {
+		return m.DenomRestrictions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.issuer.v1.GenesisState")
 }
@@ -74,20 +90,26 @@ func init() {
 func init() { proto.RegisterFile("em/issuer/v1/genesis.proto", fileDescriptor_871df1e5fa6f8b20) }
 
 var fileDescriptor_871df1e5fa6f8b20 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xcd, 0xd5, 0xcf,
-	0x2c, 0x2e, 0x2e, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x49, 0xcd, 0xd5, 0x83, 0xc8, 0xe9, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x49, 0x14,
-	0xfd, 0x50, 0xd5, 0x60, 0x29, 0xa5, 0x30, 0x2e, 0x1e, 0x77, 0x88, 0x79, 0xc1, 0x25, 0x89, 0x25,
-	0xa9, 0x42, 0x6e, 0x5c, 0xec, 0x10, 0xf9, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x11,
-	0x3d, 0x64, 0x0b, 0xf4, 0x3c, 0xc1, 0x2c, 0x27, 0xb1, 0x13, 0xf7, 0xe4, 0x19, 0x3e, 0xdd, 0x93,
-	0xe7, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x82, 0x6a, 0x51, 0x0a, 0x82, 0x69, 0x76, 0x72, 0x3d,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x54, 0xdd, 0xdc, 0xfc, 0xbc, 0xd4, 0x4a, 0xfd, 0xd4, 0x5c,
-	0xdd, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22, 0xfd, 0x0a, 0x98, 0x43, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0xae, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xca, 0xde, 0x5a, 0x02,
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x93, 0x16, 0x14, 0x62, 0x11, 0x8c, 0x45, 0xda, 0x08, 0x93, 0x1a, 0x37, 0x82, 0x74,
+	0x86, 0xea, 0xce, 0x9d, 0xc1, 0x1f, 0xdc, 0xc6, 0x9d, 0x9b, 0x92, 0x36, 0x97, 0x18, 0xe8, 0x64,
+	0xea, 0xcc, 0x34, 0x34, 0x6f, 0xe1, 0x3b, 0xb9, 0xe9, 0xb2, 0x4b, 0x57, 0x45, 0x92, 0x37, 0xf0,
+	0x09, 0xa4, 0x33, 0x29, 0x36, 0xd6, 0xdd, 0x85, 0xf3, 0x9d, 0xef, 0x2c, 0xae, 0xe5, 0x00, 0x25,
+	0x89, 0x10, 0x33, 0xe0, 0x24, 0x1b, 0x90, 0x18, 0x52, 0x10, 0x89, 0xc0, 0x53, 0xce, 0x24, 0xb3,
+	0x5b, 0x40, 0xb1, 0xce, 0x70, 0x36, 0x70, 0xda, 0x31, 0x8b, 0x99, 0x0a, 0xc8, 0xfa, 0xd2, 0x8c,
+	0xd3, 0xad, 0xf5, 0x2b, 0x5a, 0x45, 0xde, 0x47, 0xc3, 0x6a, 0x3d, 0x6a, 0xe1, 0xb3, 0x0c, 0x25,
+	0xd8, 0x0f, 0xd6, 0xbe, 0x06, 0x44, 0xc7, 0xec, 0x35, 0x2f, 0x0e, 0xae, 0xda, 0x78, 0x7b, 0x01,
+	0x3f, 0xa9, 0xcb, 0x3f, 0x59, 0xac, 0x5c, 0xe3, 0x7b, 0xe5, 0x1e, 0xe6, 0x21, 0x9d, 0xdc, 0x78,
+	0x55, 0xc5, 0x0b, 0x36, 0x65, 0xfb, 0xcd, 0xb2, 0x23, 0x48, 0x19, 0x1d, 0x72, 0x10, 0x92, 0x27,
+	0x63, 0x99, 0xb0, 0x54, 0x74, 0x1a, 0x4a, 0xe9, 0xd6, 0x95, 0x77, 0x6b, 0x2e, 0xd8, 0xc2, 0xfc,
+	0xb3, 0xca, 0xde, 0xd5, 0xf6, 0x5d, 0x91, 0x17, 0x1c, 0x45, 0x7f, 0x5b, 0xf6, 0xdc, 0x6a, 0x73,
+	0x10, 0xc0, 0x33, 0x18, 0x86, 0x52, 0x82, 0x90, 0xa1, 0x1e, 0x6d, 0xaa, 0xd1, 0x5e, 0x7d, 0x34,
+	0xd0, 0xe4, 0xed, 0x2f, 0xe8, 0x9f, 0x57, 0xab, 0xa7, 0x7a, 0xf5, 0x3f, 0x97, 0x17, 0x1c, 0xf3,
+	0x9d, 0xa2, 0xf0, 0xef, 0x17, 0x05, 0x32, 0x97, 0x05, 0x32, 0xbf, 0x0a, 0x64, 0xbe, 0x97, 0xc8,
+	0x58, 0x96, 0xc8, 0xf8, 0x2c, 0x91, 0xf1, 0x72, 0x19, 0x27, 0xf2, 0x75, 0x36, 0xc2, 0x63, 0x46,
+	0x09, 0xf4, 0x29, 0x4b, 0x21, 0x27, 0x40, 0xfb, 0x13, 0x88, 0x62, 0xe0, 0x64, 0xbe, 0x79, 0x8b,
+	0xcc, 0xa7, 0x20, 0x46, 0x7b, 0xea, 0x27, 0xd7, 0x3f, 0x03, 0x00, 0x23, 0x1f, 0x65, 0xa5, 0xf0,
 	0x01, 0x00, 0x00,
 }
 
@@ -111,6 +133,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReserveAttestations) > 0 {
+		for iNdEx := len(m.ReserveAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DenomRestrictions) > 0 {
+		for iNdEx := len(m.DenomRestrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRestrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Issuers) > 0 {
 		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +201,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomRestrictions) > 0 {
+		for _, e := range m.DenomRestrictions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for _, e := range m.ReserveAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +285,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRestrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRestrictions = append(m.DenomRestrictions, DenomRestrictions{})
+			if err := m.DenomRestrictions[len(m.DenomRestrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestations = append(m.ReserveAttestations, ReserveAttestation{})
+			if err := m.ReserveAttestations[len(m.ReserveAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Issuer struct {
 	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Denoms  []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// Hard caps on supply plus outstanding mintable amounts, set by the
+	// authority. Denominations without a cap are unlimited.
+	SupplyCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply_caps,json=supplyCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply_caps" yaml:"supply_caps"`
 }
 
 func (m *Issuer) Reset()         { *m = Issuer{} }
@@ -75,6 +84,13 @@ func (m *Issuer) GetDenoms() []string {
 	return nil
 }
 
+func (m *Issuer) GetSupplyCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SupplyCaps
+	}
+	return nil
+}
+
 type Issuers struct {
 	Issuers []Issuer `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers" yaml:"issuers"`
 }
@@ -118,32 +134,223 @@ func (m *Issuers) GetIssuers() []Issuer {
 	return nil
 }
 
+// DenomRestrictions holds the accounts an issuer has denied or allowed to hold
+// a denomination.
+type DenomRestrictions struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// When set, only accounts on the allowlist may receive the denomination.
+	AllowlistOnly bool     `protobuf:"varint,2,opt,name=allowlist_only,json=allowlistOnly,proto3" json:"allowlist_only,omitempty" yaml:"allowlist_only"`
+	Denylist      []string `protobuf:"bytes,3,rep,name=denylist,proto3" json:"denylist,omitempty" yaml:"denylist"`
+	Allowlist     []string `protobuf:"bytes,4,rep,name=allowlist,proto3" json:"allowlist,omitempty" yaml:"allowlist"`
+	// Accounts that may not send the denomination.
+	Frozen []string `protobuf:"bytes,5,rep,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+}
+
+func (m *DenomRestrictions) Reset()         { *m = DenomRestrictions{} }
+func (m *DenomRestrictions) String() string { return proto.CompactTextString(m) }
+func (*DenomRestrictions) ProtoMessage()    {}
+func (*DenomRestrictions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{2}
+}
+func (m *DenomRestrictions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRestrictions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRestrictions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRestrictions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRestrictions.Merge(m, src)
+}
+func (m *DenomRestrictions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRestrictions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRestrictions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRestrictions proto.InternalMessageInfo
+
+func (m *DenomRestrictions) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRestrictions) GetAllowlistOnly() bool {
+	if m != nil {
+		return m.AllowlistOnly
+	}
+	return false
+}
+
+func (m *DenomRestrictions) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func (m *DenomRestrictions) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *DenomRestrictions) GetFrozen() []string {
+	if m != nil {
+		return m.Frozen
+	}
+	return nil
+}
+
+// ReserveAttestation is an issuer's statement of the reserves backing a
+// denomination, as verified by an auditor.
+type ReserveAttestation struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Reserves expressed in base units of the denomination, making them directly
+	// comparable to its supply.
+	ReserveAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=reserve_amount,json=reserveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_amount" yaml:"reserve_amount"`
+	// Currency the reserves are held in, e.g. EUR.
+	Currency   string    `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty" yaml:"currency"`
+	AttestedAt time.Time `protobuf:"bytes,4,opt,name=attested_at,json=attestedAt,proto3,stdtime" json:"attested_at" yaml:"attested_at"`
+	Auditor    string    `protobuf:"bytes,5,opt,name=auditor,proto3" json:"auditor,omitempty" yaml:"auditor"`
+	// Hex encoded hash of the attestation document.
+	DocumentHash string `protobuf:"bytes,6,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty" yaml:"document_hash"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{3}
+}
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetAttestedAt() time.Time {
+	if m != nil {
+		return m.AttestedAt
+	}
+	return time.Time{}
+}
+
+func (m *ReserveAttestation) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Issuer)(nil), "em.issuer.v1.Issuer")
 	proto.RegisterType((*Issuers)(nil), "em.issuer.v1.Issuers")
+	proto.RegisterType((*DenomRestrictions)(nil), "em.issuer.v1.DenomRestrictions")
+	proto.RegisterType((*ReserveAttestation)(nil), "em.issuer.v1.ReserveAttestation")
 }
 
 func init() { proto.RegisterFile("em/issuer/v1/issuer.proto", fileDescriptor_0215b6b8fa8ee15b) }
 
 var fileDescriptor_0215b6b8fa8ee15b = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xcd, 0xd5, 0xcf,
-	0x2c, 0x2e, 0x2e, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x78, 0x52, 0x73, 0xf5, 0xa0, 0x02, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x09, 0x7d, 0x10, 0x0b, 0xa2, 0x46, 0x29, 0x91, 0x8b, 0xcd, 0x13, 0xac, 0x44, 0x48, 0x87, 0x8b,
-	0x3d, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe8,
-	0xd3, 0x3d, 0x79, 0xbe, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xa8, 0x84, 0x52, 0x10, 0x4c, 0x89,
-	0x90, 0x26, 0x17, 0x5b, 0x4a, 0x6a, 0x5e, 0x7e, 0x6e, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xa7,
-	0x93, 0xe0, 0xa7, 0x7b, 0xf2, 0xbc, 0x10, 0xc5, 0x10, 0x71, 0xa5, 0x20, 0xa8, 0x02, 0xa5, 0x70,
-	0x2e, 0x76, 0x88, 0x15, 0xc5, 0x42, 0x6e, 0x5c, 0xec, 0x10, 0x07, 0x81, 0xec, 0x60, 0xd6, 0xe0,
-	0x36, 0x12, 0xd1, 0x43, 0x76, 0xa3, 0x1e, 0x44, 0x9d, 0x93, 0xd8, 0x89, 0x7b, 0xf2, 0x0c, 0x08,
-	0xdb, 0xa1, 0x5a, 0x94, 0x82, 0x60, 0x9a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x72, 0x3d,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x54, 0xdd, 0xdc, 0xfc, 0xbc, 0xd4, 0x4a, 0xfd, 0xd4, 0x5c,
-	0xdd, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22, 0xfd, 0x0a, 0x58, 0x80, 0x95, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0x43, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x27, 0xb4, 0x11, 0x17, 0x4a,
-	0x01, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xe3, 0xa6, 0x4d, 0x9b, 0x4b, 0xd3, 0x5f, 0xeb, 0x5f, 0x40, 0x6e, 0x07, 0x3b, 0xba,
+	0xa1, 0x0a, 0x82, 0xda, 0x4a, 0xd9, 0x2a, 0x21, 0x51, 0x17, 0x0a, 0x9d, 0x90, 0x4e, 0x48, 0x48,
+	0x30, 0x44, 0x8e, 0x7d, 0x4d, 0x2c, 0x6c, 0x5f, 0xe4, 0x3b, 0x07, 0xcc, 0xc8, 0x8e, 0xd4, 0x91,
+	0x91, 0x0d, 0x89, 0x57, 0xd2, 0xb1, 0x23, 0x62, 0x70, 0x51, 0x2b, 0xf1, 0x02, 0xfc, 0x0a, 0xd0,
+	0xfd, 0x71, 0x9a, 0x94, 0xa5, 0x53, 0xee, 0x9e, 0xe7, 0xfb, 0xbd, 0x7b, 0x9e, 0xcf, 0xf9, 0x09,
+	0xd8, 0xc6, 0xb1, 0x13, 0x52, 0x9a, 0xe1, 0xd4, 0x99, 0xf6, 0xd5, 0xca, 0x9e, 0xa4, 0x84, 0x11,
+	0x7d, 0x1d, 0xc7, 0xb6, 0x0a, 0x4c, 0xfb, 0x3b, 0x9d, 0x11, 0x19, 0x11, 0x91, 0x70, 0xf8, 0x4a,
+	0x6a, 0x76, 0x4c, 0x9f, 0xd0, 0x98, 0x50, 0x67, 0xe8, 0x51, 0xec, 0x4c, 0xfb, 0x43, 0xcc, 0xbc,
+	0xbe, 0xe3, 0x93, 0x30, 0x51, 0x79, 0x6b, 0x44, 0xc8, 0x28, 0xc2, 0x8e, 0xd8, 0x0d, 0xb3, 0x53,
+	0x87, 0x85, 0x31, 0xa6, 0xcc, 0x8b, 0x27, 0x52, 0x00, 0xff, 0x68, 0xa0, 0x71, 0x22, 0x2e, 0xd1,
+	0x1f, 0x81, 0x55, 0x2f, 0x08, 0x52, 0x4c, 0xa9, 0xa1, 0x75, 0xb5, 0x5e, 0xd3, 0xd5, 0xcb, 0xc2,
+	0xda, 0xc8, 0xbd, 0x38, 0x3a, 0x80, 0x2a, 0x01, 0x51, 0x25, 0xd1, 0x1f, 0x80, 0x46, 0x80, 0x13,
+	0x12, 0x53, 0x63, 0xa9, 0x5b, 0xef, 0x35, 0xdd, 0xad, 0xb2, 0xb0, 0xda, 0x52, 0x2c, 0xe3, 0x10,
+	0x29, 0x81, 0xfe, 0x59, 0x03, 0x2d, 0x9a, 0x4d, 0x26, 0x51, 0x3e, 0xf0, 0xbd, 0x09, 0x35, 0xea,
+	0xdd, 0x7a, 0xaf, 0xb5, 0xbf, 0x6d, 0xcb, 0xda, 0x6d, 0x5e, 0xbb, 0xad, 0x6a, 0xb7, 0x8f, 0x48,
+	0x98, 0xb8, 0xc7, 0xe7, 0x85, 0x55, 0x2b, 0x0b, 0x4b, 0x97, 0xe7, 0xcd, 0x79, 0xe1, 0x8f, 0x4b,
+	0xab, 0x37, 0x0a, 0xd9, 0x38, 0x1b, 0xda, 0x3e, 0x89, 0x1d, 0xd5, 0xbe, 0xfc, 0xd9, 0xa3, 0xc1,
+	0x7b, 0x87, 0xe5, 0x13, 0x4c, 0xc5, 0x31, 0x14, 0x01, 0xe9, 0x3c, 0xe2, 0xc6, 0x37, 0x60, 0x55,
+	0xf6, 0x49, 0xf5, 0x63, 0xb0, 0x2a, 0xb9, 0xf2, 0x46, 0x79, 0x29, 0x1d, 0x7b, 0x1e, 0xb5, 0x2d,
+	0x75, 0xee, 0x7d, 0x55, 0x85, 0x42, 0xa0, 0x2c, 0x10, 0x55, 0xe6, 0x83, 0xe5, 0xaf, 0xdf, 0xac,
+	0x1a, 0xfc, 0xb2, 0x04, 0xb6, 0x9e, 0xf1, 0x46, 0x11, 0xa6, 0x2c, 0x0d, 0x7d, 0x16, 0x92, 0x84,
+	0xea, 0xbb, 0x60, 0x45, 0x74, 0xaf, 0x50, 0x6e, 0x96, 0x85, 0xb5, 0x3e, 0x47, 0x07, 0x22, 0x99,
+	0xd6, 0x9f, 0x82, 0x0d, 0x2f, 0x8a, 0xc8, 0x87, 0x28, 0xa4, 0x6c, 0x40, 0x92, 0x28, 0x37, 0x96,
+	0xba, 0x5a, 0x6f, 0xcd, 0xdd, 0x2e, 0x0b, 0xeb, 0x9e, 0x62, 0xbf, 0x90, 0x87, 0xa8, 0x3d, 0x0b,
+	0xbc, 0x4a, 0xa2, 0x5c, 0x77, 0xc0, 0x5a, 0x80, 0x93, 0x9c, 0xef, 0x05, 0xd9, 0xa6, 0xfb, 0x7f,
+	0x59, 0x58, 0xff, 0xcd, 0x2e, 0x13, 0x19, 0x88, 0x66, 0x22, 0x7d, 0x1f, 0x34, 0x67, 0x27, 0x18,
+	0xcb, 0xc2, 0xd1, 0x29, 0x0b, 0x6b, 0xf3, 0xd6, 0x6d, 0x10, 0xdd, 0xc8, 0xf8, 0x6b, 0x9f, 0xa6,
+	0xe4, 0x13, 0x4e, 0x8c, 0x95, 0xdb, 0xaf, 0x2d, 0xe3, 0x10, 0x29, 0x01, 0xfc, 0x5e, 0x07, 0x3a,
+	0xc2, 0x14, 0xa7, 0x53, 0x7c, 0xc8, 0x18, 0xff, 0xd8, 0x38, 0x91, 0x3b, 0x03, 0x49, 0xc0, 0x46,
+	0x2a, 0xdd, 0x03, 0x2f, 0x26, 0x59, 0xc2, 0x04, 0x90, 0xa6, 0xfb, 0x82, 0xbf, 0xc6, 0xaf, 0xc2,
+	0xda, 0xbd, 0xc3, 0xeb, 0x9f, 0x24, 0xec, 0x06, 0xdf, 0xe2, 0x69, 0x10, 0xb5, 0x55, 0xe0, 0x50,
+	0xec, 0x39, 0x3e, 0x3f, 0x4b, 0x53, 0x9c, 0xf8, 0xb9, 0x51, 0xef, 0x6a, 0x8b, 0xf8, 0xaa, 0x0c,
+	0x44, 0x33, 0x91, 0xfe, 0x0e, 0xb4, 0x3c, 0xd1, 0x17, 0x0e, 0x06, 0x1e, 0x07, 0xa8, 0xf5, 0x5a,
+	0xfb, 0x3b, 0xb6, 0x1c, 0x34, 0xbb, 0x1a, 0x34, 0xfb, 0x75, 0x35, 0x68, 0xae, 0xb9, 0xf8, 0x35,
+	0xcf, 0x99, 0xe1, 0xd9, 0xa5, 0xa5, 0x21, 0x50, 0x45, 0x0e, 0x99, 0x98, 0xc1, 0x2c, 0x08, 0x19,
+	0x49, 0x8d, 0x95, 0x7f, 0x66, 0x50, 0x26, 0xf8, 0x0c, 0xca, 0x95, 0xfe, 0x04, 0xb4, 0x03, 0xe2,
+	0x67, 0x31, 0x4e, 0xd8, 0x60, 0xec, 0xd1, 0xb1, 0xd1, 0x10, 0x1e, 0xa3, 0x2c, 0xac, 0x8e, 0x62,
+	0x3b, 0x9f, 0x86, 0x68, 0xbd, 0xda, 0xbf, 0xf4, 0xe8, 0xd8, 0x7d, 0x7e, 0x7e, 0x65, 0x6a, 0x17,
+	0x57, 0xa6, 0xf6, 0xfb, 0xca, 0xd4, 0xce, 0xae, 0xcd, 0xda, 0xc5, 0xb5, 0x59, 0xfb, 0x79, 0x6d,
+	0xd6, 0xde, 0x3e, 0x9c, 0x83, 0x8c, 0xf7, 0x62, 0x92, 0xe0, 0xdc, 0xc1, 0xf1, 0x5e, 0x84, 0x83,
+	0x11, 0x4e, 0x9d, 0x8f, 0xd5, 0x3f, 0x96, 0xa0, 0x3d, 0x6c, 0x88, 0x9e, 0x1f, 0xff, 0x1d, 0x00,
+	0x4f, 0x47, 0x46, 0xa2, 0xcb, 0x04, 0x00, 0x00,
 }
 
 func (m *Issuer) Marshal() (dAtA []byte, err error) {
@@ -166,6 +373,20 @@ func (m *Issuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplyCaps) > 0 {
+		for iNdEx := len(m.SupplyCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Denoms) > 0 {
 		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Denoms[iNdEx])
@@ -222,6 +443,142 @@ func (m *Issuers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRestrictions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRestrictions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frozen[iNdEx])
+			copy(dAtA[i:], m.Frozen[iNdEx])
+			i = encodeVarintIssuer(dAtA, i, uint64(len(m.Frozen[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintIssuer(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AllowlistOnly {
+		i--
+		if m.AllowlistOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AttestedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AttestedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIssuer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ReserveAmount.Size()
+		i -= size
+		if _, err := m.ReserveAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIssuer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIssuer(v)
 	base := offset
@@ -249,6 +606,12 @@ func (m *Issuer) Size() (n int) {
 			n += 1 + l + sovIssuer(uint64(l))
 		}
 	}
+	if len(m.SupplyCaps) > 0 {
+		for _, e := range m.SupplyCaps {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +630,69 @@ func (m *Issuers) Size() (n int) {
 	return n
 }
 
+func (m *DenomRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	if m.AllowlistOnly {
+		n += 2
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for _, s := range m.Frozen {
+			l = len(s)
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = m.ReserveAmount.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AttestedAt)
+	n += 1 + l + sovIssuer(uint64(l))
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	return n
+}
+
 func sovIssuer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -366,6 +792,40 @@ func (m *Issuer) Unmarshal(dAtA []byte) error {
 			}
 			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCaps = append(m.SupplyCaps, types.Coin{})
+			if err := m.SupplyCaps[len(m.SupplyCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIssuer(dAtA[iNdEx:])
@@ -471,6 +931,449 @@ func (m *Issuers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRestrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frozen = append(m.Frozen, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AttestedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIssuer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 var (
@@ -14,6 +15,16 @@ var (
 	_ sdk.Msg = &MsgDecreaseMintable{}
 	_ sdk.Msg = &MsgRevokeLiquidityProvider{}
 	_ sdk.Msg = &MsgSetInflation{}
+	_ sdk.Msg = &MsgScheduleInflation{}
+	_ sdk.Msg = &MsgUpdateDenylist{}
+	_ sdk.Msg = &MsgUpdateAllowlist{}
+	_ sdk.Msg = &MsgSetAllowlistOnly{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateDenomMetadata{}
+	_ sdk.Msg = &MsgSetMintRateLimit{}
+	_ sdk.Msg = &MsgAttestReserves{}
 )
 
 func (msg MsgSetInflation) Route() string { return ModuleName }
@@ -21,9 +32,9 @@ func (msg MsgSetInflation) Route() string { return ModuleName }
 func (msg MsgSetInflation) Type() string { return "set_inflation" }
 
 func (msg MsgSetInflation) ValidateBasic() error {
-	if msg.InflationRate.IsNegative() {
-		return sdkerrors.Wrap(ErrNegativeInflation, "cannot set negative inflation")
-		// return ErrNegativeInflation()
+	// Negative rates apply demurrage to the holders of the denomination
+	if err := inflationtypes.ValidateRate(msg.InflationRate); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflation, err.Error())
 	}
 
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
@@ -45,6 +56,38 @@ func (msg MsgSetInflation) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (msg MsgScheduleInflation) Route() string { return ModuleName }
+
+func (msg MsgScheduleInflation) Type() string { return "schedule_inflation" }
+
+func (msg MsgScheduleInflation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := inflationtypes.ValidateSchedule(msg.Schedule); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflation, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgScheduleInflation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleInflation) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgRevokeLiquidityProvider) Route() string { return ModuleName }
 
 func (msg MsgRevokeLiquidityProvider) Type() string { return "revoke_liquidity_provider" }
@@ -137,3 +180,251 @@ func (msg MsgIncreaseMintable) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+func (msg MsgUpdateDenylist) Route() string { return ModuleName }
+
+func (msg MsgUpdateDenylist) Type() string { return "update_denylist" }
+
+func (msg MsgUpdateDenylist) ValidateBasic() error {
+	return validateListUpdate(msg.Issuer, msg.Denom, msg.Add, msg.Remove)
+}
+
+func (msg MsgUpdateDenylist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateDenylist) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgUpdateAllowlist) Route() string { return ModuleName }
+
+func (msg MsgUpdateAllowlist) Type() string { return "update_allowlist" }
+
+func (msg MsgUpdateAllowlist) ValidateBasic() error {
+	return validateListUpdate(msg.Issuer, msg.Denom, msg.Add, msg.Remove)
+}
+
+func (msg MsgUpdateAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateAllowlist) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetAllowlistOnly) Route() string { return ModuleName }
+
+func (msg MsgSetAllowlistOnly) Type() string { return "set_allowlist_only" }
+
+func (msg MsgSetAllowlistOnly) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgSetAllowlistOnly) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetAllowlistOnly) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgFreezeAccount) Route() string { return ModuleName }
+
+func (msg MsgFreezeAccount) Type() string { return "freeze_account" }
+
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	return validateListUpdate(msg.Issuer, msg.Denom, []string{msg.Account}, nil)
+}
+
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgUnfreezeAccount) Route() string { return ModuleName }
+
+func (msg MsgUnfreezeAccount) Type() string { return "unfreeze_account" }
+
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	return validateListUpdate(msg.Issuer, msg.Denom, nil, []string{msg.Account})
+}
+
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgClawback) Route() string { return ModuleName }
+
+func (msg MsgClawback) Type() string { return "clawback" }
+
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address (%s)", err)
+	}
+
+	if len(msg.Recipient) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "clawback amount is invalid: %v", msg.Amount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgUpdateDenomMetadata) Route() string { return ModuleName }
+
+func (msg MsgUpdateDenomMetadata) Type() string { return "update_denom_metadata" }
+
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetMintRateLimit) Route() string { return ModuleName }
+
+func (msg MsgSetMintRateLimit) Type() string { return "set_mint_rate_limit" }
+
+func (msg MsgSetMintRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.LiquidityProvider); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidity provider address (%s)", err)
+	}
+
+	return msg.RateLimit.Validate()
+}
+
+func (msg MsgSetMintRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetMintRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgAttestReserves) Route() string { return ModuleName }
+
+func (msg MsgAttestReserves) Type() string { return "attest_reserves" }
+
+func (msg MsgAttestReserves) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	return msg.Attestation.Validate()
+}
+
+func (msg MsgAttestReserves) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAttestReserves) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateListUpdate(issuer, denom string, add, remove []string) error {
+	if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no accounts to add or remove")
+	}
+
+	for _, account := range append(append([]string{}, add...), remove...) {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address (%s)", err)
+		}
+	}
+
+	return nil
+}
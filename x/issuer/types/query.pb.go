@@ -6,23 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,35 +115,343 @@ func (m *QueryIssuersResponse) GetIssuers() []Issuer {
 	return nil
 }
 
+type QueryDenomRestrictionsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomRestrictionsRequest) Reset()         { *m = QueryDenomRestrictionsRequest{} }
+func (m *QueryDenomRestrictionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsRequest) ProtoMessage()    {}
+func (*QueryDenomRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{2}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryDenomRestrictionsResponse struct {
+	Restrictions DenomRestrictions `protobuf:"bytes,1,opt,name=restrictions,proto3" json:"restrictions" yaml:"restrictions"`
+}
+
+func (m *QueryDenomRestrictionsResponse) Reset()         { *m = QueryDenomRestrictionsResponse{} }
+func (m *QueryDenomRestrictionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsResponse) ProtoMessage()    {}
+func (*QueryDenomRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{3}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsResponse) GetRestrictions() DenomRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return DenomRestrictions{}
+}
+
+type QueryReserveCoverageRequest struct {
+	// denom optionally restricts the result to a single denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Age after which an attestation is considered stale. Defaults to 35 days.
+	MaxAge time.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age" yaml:"max_age"`
+}
+
+func (m *QueryReserveCoverageRequest) Reset()         { *m = QueryReserveCoverageRequest{} }
+func (m *QueryReserveCoverageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveCoverageRequest) ProtoMessage()    {}
+func (*QueryReserveCoverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{4}
+}
+func (m *QueryReserveCoverageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveCoverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveCoverageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserveCoverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveCoverageRequest.Merge(m, src)
+}
+func (m *QueryReserveCoverageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveCoverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveCoverageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReserveCoverageRequest proto.InternalMessageInfo
+
+func (m *QueryReserveCoverageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryReserveCoverageRequest) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+type QueryReserveCoverageResponse struct {
+	Coverage []ReserveCoverage `protobuf:"bytes,1,rep,name=coverage,proto3" json:"coverage" yaml:"coverage"`
+}
+
+func (m *QueryReserveCoverageResponse) Reset()         { *m = QueryReserveCoverageResponse{} }
+func (m *QueryReserveCoverageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveCoverageResponse) ProtoMessage()    {}
+func (*QueryReserveCoverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{5}
+}
+func (m *QueryReserveCoverageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveCoverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveCoverageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserveCoverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveCoverageResponse.Merge(m, src)
+}
+func (m *QueryReserveCoverageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveCoverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveCoverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReserveCoverageResponse proto.InternalMessageInfo
+
+func (m *QueryReserveCoverageResponse) GetCoverage() []ReserveCoverage {
+	if m != nil {
+		return m.Coverage
+	}
+	return nil
+}
+
+// ReserveCoverage compares the latest reserve attestation of a denomination
+// with its current supply.
+//
+// Demurrage is settled lazily, so for a decaying denomination the supply is
+// gross of decay that holders have not settled yet and the coverage ratio is
+// a lower bound. At most supply * (1 - demurrage_index) of it is unsettled.
+type ReserveCoverage struct {
+	Denom  string     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply" yaml:"supply"`
+	// Absent if the issuer has not attested the reserves of the denomination.
+	Attestation *ReserveAttestation `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty" yaml:"attestation"`
+	// Attested reserves divided by supply. Zero when there is no supply.
+	CoverageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=coverage_ratio,json=coverageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"coverage_ratio" yaml:"coverage_ratio"`
+	UnderReserved bool                                   `protobuf:"varint,5,opt,name=under_reserved,json=underReserved,proto3" json:"under_reserved,omitempty" yaml:"under_reserved"`
+	Stale         bool                                   `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty" yaml:"stale"`
+	// Fraction of the balances remaining after demurrage. One for denominations
+	// that have not decayed.
+	DemurrageIndex github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=demurrage_index,json=demurrageIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"demurrage_index" yaml:"demurrage_index"`
+}
+
+func (m *ReserveCoverage) Reset()         { *m = ReserveCoverage{} }
+func (m *ReserveCoverage) String() string { return proto.CompactTextString(m) }
+func (*ReserveCoverage) ProtoMessage()    {}
+func (*ReserveCoverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{6}
+}
+func (m *ReserveCoverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveCoverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveCoverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveCoverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveCoverage.Merge(m, src)
+}
+func (m *ReserveCoverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveCoverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveCoverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveCoverage proto.InternalMessageInfo
+
+func (m *ReserveCoverage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ReserveCoverage) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *ReserveCoverage) GetAttestation() *ReserveAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *ReserveCoverage) GetUnderReserved() bool {
+	if m != nil {
+		return m.UnderReserved
+	}
+	return false
+}
+
+func (m *ReserveCoverage) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryIssuersRequest)(nil), "em.issuer.v1.QueryIssuersRequest")
 	proto.RegisterType((*QueryIssuersResponse)(nil), "em.issuer.v1.QueryIssuersResponse")
+	proto.RegisterType((*QueryDenomRestrictionsRequest)(nil), "em.issuer.v1.QueryDenomRestrictionsRequest")
+	proto.RegisterType((*QueryDenomRestrictionsResponse)(nil), "em.issuer.v1.QueryDenomRestrictionsResponse")
+	proto.RegisterType((*QueryReserveCoverageRequest)(nil), "em.issuer.v1.QueryReserveCoverageRequest")
+	proto.RegisterType((*QueryReserveCoverageResponse)(nil), "em.issuer.v1.QueryReserveCoverageResponse")
+	proto.RegisterType((*ReserveCoverage)(nil), "em.issuer.v1.ReserveCoverage")
 }
 
 func init() { proto.RegisterFile("em/issuer/v1/query.proto", fileDescriptor_58837c42d7dad2b1) }
 
 var fileDescriptor_58837c42d7dad2b1 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x3f, 0x4b, 0xfb, 0x40,
-	0x18, 0xc7, 0x93, 0xdf, 0x0f, 0x2d, 0x44, 0x71, 0x88, 0x55, 0x6a, 0x28, 0x57, 0xbd, 0x49, 0x90,
-	0xe6, 0x48, 0xdd, 0x1c, 0x0b, 0x0a, 0x8e, 0x76, 0x74, 0x10, 0x92, 0xfa, 0x70, 0x06, 0x7a, 0xf7,
-	0xa4, 0x79, 0x2e, 0xc5, 0x0c, 0x2e, 0xbe, 0x02, 0xc1, 0x37, 0xd5, 0xb1, 0xe0, 0xe2, 0x54, 0xa4,
-	0xf5, 0x15, 0xf8, 0x0a, 0xa4, 0x49, 0x2a, 0x06, 0xc5, 0x2d, 0x3c, 0x9f, 0xef, 0xbf, 0x9c, 0xd3,
-	0x02, 0x25, 0x62, 0xa2, 0x0c, 0x52, 0x31, 0x09, 0xc4, 0x38, 0x83, 0x34, 0xf7, 0x93, 0x14, 0x0d,
-	0xba, 0xdb, 0xa0, 0xfc, 0x92, 0xf8, 0x93, 0xc0, 0x6b, 0x4a, 0x94, 0x58, 0x00, 0xb1, 0xfa, 0x2a,
-	0x35, 0x1e, 0x1b, 0x22, 0x29, 0x24, 0x11, 0x85, 0x04, 0x62, 0x12, 0x44, 0x60, 0xc2, 0x40, 0x0c,
-	0x31, 0xd6, 0x15, 0x6f, 0x4b, 0x44, 0x39, 0x02, 0x11, 0x26, 0xb1, 0x08, 0xb5, 0x46, 0x13, 0x9a,
-	0x18, 0x35, 0x55, 0xf4, 0xa0, 0xd6, 0x5d, 0x75, 0x15, 0x88, 0xef, 0x39, 0xbb, 0x57, 0xab, 0x2d,
-	0x97, 0xc5, 0x91, 0x06, 0x30, 0xce, 0x80, 0x0c, 0xbf, 0x71, 0x9a, 0xf5, 0x33, 0x25, 0xa8, 0x09,
-	0xdc, 0x0b, 0xa7, 0x51, 0xda, 0xa9, 0x65, 0x1f, 0xfe, 0x3f, 0xde, 0xea, 0x35, 0xfd, 0xef, 0xeb,
-	0xfd, 0x52, 0xdf, 0xdf, 0x9f, 0xce, 0x3b, 0xd6, 0xc7, 0xbc, 0xb3, 0x93, 0x87, 0x6a, 0x74, 0xc6,
-	0x2b, 0x0b, 0x1f, 0xac, 0xcd, 0xbd, 0x07, 0x67, 0xa3, 0xc8, 0x77, 0x8d, 0xd3, 0xa8, 0x3a, 0xdc,
-	0xa3, 0x7a, 0xd4, 0x2f, 0xb3, 0x3c, 0xfe, 0x97, 0xa4, 0x9c, 0xc8, 0xf9, 0xe3, 0xcb, 0xfb, 0xf3,
-	0xbf, 0xb6, 0xeb, 0x09, 0xe8, 0x2a, 0xd4, 0x90, 0xff, 0xf8, 0x75, 0xea, 0x9f, 0x4f, 0x17, 0xcc,
-	0x9e, 0x2d, 0x98, 0xfd, 0xb6, 0x60, 0xf6, 0xd3, 0x92, 0x59, 0xb3, 0x25, 0xb3, 0x5e, 0x97, 0xcc,
-	0xba, 0x3e, 0x91, 0xb1, 0xb9, 0xcb, 0x22, 0x7f, 0x88, 0xea, 0xcb, 0x0f, 0xaa, 0x3b, 0x82, 0x5b,
-	0x09, 0xa9, 0xb8, 0x5f, 0x67, 0x99, 0x3c, 0x01, 0x8a, 0x36, 0x8b, 0x37, 0x3c, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x20, 0x27, 0x41, 0xdc, 0x01, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0x8e, 0xf9, 0x49, 0xe8, 0x00, 0xa1, 0x1d, 0x7e, 0x6a, 0x02, 0xd8, 0xe9, 0x54, 0xa2, 0x50,
+	0x8a, 0x47, 0xa1, 0xbb, 0xae, 0x8a, 0xa1, 0x05, 0x36, 0x95, 0x3a, 0x4b, 0x16, 0xa5, 0x4e, 0x3c,
+	0x75, 0xad, 0xc6, 0x9e, 0xe0, 0xb1, 0xa3, 0x44, 0x55, 0x37, 0xec, 0x2b, 0x55, 0xaa, 0x2a, 0x75,
+	0xd7, 0x17, 0xa8, 0xd4, 0xd7, 0x60, 0x89, 0xd4, 0x4d, 0xd5, 0x45, 0xee, 0x15, 0xdc, 0x27, 0xc8,
+	0x13, 0x5c, 0x79, 0x66, 0x0c, 0x36, 0x31, 0x57, 0xb0, 0x4a, 0x7c, 0xce, 0xf9, 0xbe, 0xf9, 0xbe,
+	0x39, 0x73, 0x0e, 0xd0, 0x69, 0x80, 0x7d, 0xce, 0x13, 0x1a, 0xe1, 0x7e, 0x0b, 0x5f, 0x26, 0x34,
+	0x1a, 0x5a, 0xbd, 0x88, 0xc5, 0x0c, 0x2e, 0xd0, 0xc0, 0x92, 0x19, 0xab, 0xdf, 0x6a, 0xac, 0x78,
+	0xcc, 0x63, 0x22, 0x81, 0xd3, 0x7f, 0xb2, 0xa6, 0x61, 0x74, 0x18, 0x0f, 0x18, 0xc7, 0x6d, 0x87,
+	0x53, 0xdc, 0x6f, 0xb5, 0x69, 0xec, 0xb4, 0x70, 0x87, 0xf9, 0xa1, 0xca, 0x6f, 0x7a, 0x8c, 0x79,
+	0x5d, 0x8a, 0x9d, 0x9e, 0x8f, 0x9d, 0x30, 0x64, 0xb1, 0x13, 0xfb, 0x2c, 0xe4, 0x19, 0x5a, 0x65,
+	0xc5, 0x57, 0x3b, 0xf9, 0x01, 0xbb, 0x49, 0x24, 0x0a, 0x54, 0x7e, 0xbd, 0xa0, 0x4d, 0x69, 0x11,
+	0x29, 0xb4, 0x0a, 0x96, 0xbf, 0x4d, 0xb5, 0x9e, 0x89, 0x20, 0x27, 0xf4, 0x32, 0xa1, 0x3c, 0x46,
+	0xdf, 0x81, 0x95, 0x62, 0x98, 0xf7, 0x58, 0xc8, 0x29, 0xfc, 0x1a, 0xd4, 0x24, 0x9c, 0xeb, 0x5a,
+	0x73, 0x7a, 0x67, 0xfe, 0x60, 0xc5, 0xca, 0xbb, 0xb3, 0x64, 0xbd, 0xbd, 0x76, 0x3d, 0x32, 0x2b,
+	0xe3, 0x91, 0x59, 0x1f, 0x3a, 0x41, 0xf7, 0x0b, 0xa4, 0x20, 0x88, 0x64, 0x60, 0x74, 0x02, 0xb6,
+	0x04, 0xff, 0x31, 0x0d, 0x59, 0x40, 0x28, 0x8f, 0x23, 0xbf, 0x23, 0x1c, 0x29, 0x01, 0x70, 0x1b,
+	0xcc, 0xba, 0x69, 0x4e, 0xd7, 0x9a, 0xda, 0xce, 0x7b, 0xf6, 0xfb, 0xe3, 0x91, 0xb9, 0x20, 0xc9,
+	0x44, 0x18, 0x11, 0x99, 0x46, 0x57, 0x1a, 0x30, 0x9e, 0x62, 0x52, 0x9a, 0xbf, 0x07, 0x0b, 0x51,
+	0x2e, 0x2e, 0x18, 0xe7, 0x0f, 0xcc, 0xa2, 0xf0, 0x09, 0xb8, 0xbd, 0xa1, 0x3c, 0x2c, 0xcb, 0x63,
+	0xf3, 0x14, 0x88, 0x14, 0x18, 0xd1, 0x1f, 0x1a, 0xd8, 0x10, 0x22, 0x08, 0xe5, 0x34, 0xea, 0xd3,
+	0x23, 0xd6, 0xa7, 0x91, 0xe3, 0xd1, 0x17, 0x9a, 0x81, 0xdf, 0x80, 0x5a, 0xe0, 0x0c, 0x2e, 0x1c,
+	0x8f, 0xea, 0x53, 0x42, 0xe4, 0xba, 0x25, 0x3b, 0x6b, 0x65, 0x9d, 0xb5, 0x8e, 0x55, 0x67, 0xed,
+	0x46, 0xf1, 0x8a, 0x15, 0x0e, 0xfd, 0xf9, 0xca, 0xd4, 0x48, 0x35, 0x70, 0x06, 0x87, 0x1e, 0x45,
+	0x11, 0xd8, 0x2c, 0x97, 0xa5, 0x6e, 0x86, 0x80, 0xb9, 0x8e, 0x8a, 0xa9, 0x76, 0x6e, 0x15, 0x6f,
+	0xe5, 0x11, 0xd0, 0xfe, 0x50, 0x1d, 0xba, 0x24, 0x0f, 0xcd, 0xc0, 0x88, 0xdc, 0xf3, 0xa0, 0xbf,
+	0x67, 0xc0, 0xd2, 0x23, 0xd8, 0xb3, 0xfd, 0x9f, 0x82, 0x2a, 0x4f, 0x7a, 0xbd, 0xee, 0xf0, 0xde,
+	0xbe, 0x1c, 0x0b, 0x2b, 0x1d, 0x0b, 0x4b, 0x8d, 0x85, 0x75, 0xc4, 0xfc, 0xd0, 0x5e, 0x55, 0x4a,
+	0x16, 0x25, 0x8f, 0x84, 0x21, 0xa2, 0xf0, 0xf0, 0x1c, 0xcc, 0x3b, 0x71, 0x4c, 0xb9, 0x9c, 0x13,
+	0x7d, 0x5a, 0xd0, 0x35, 0x4b, 0xcd, 0x1d, 0x3e, 0xd4, 0xd9, 0x6b, 0xe3, 0x91, 0x09, 0x25, 0x63,
+	0x0e, 0x8e, 0x48, 0x9e, 0x0c, 0x86, 0xa0, 0x9e, 0xb9, 0xbd, 0x10, 0xcd, 0xd0, 0x67, 0x84, 0xad,
+	0x93, 0x54, 0xd2, 0xff, 0x23, 0x73, 0xdb, 0xf3, 0xe3, 0x1f, 0x93, 0xb6, 0xd5, 0x61, 0x01, 0x56,
+	0x63, 0x2d, 0x7f, 0xf6, 0xb9, 0xfb, 0x13, 0x8e, 0x87, 0x3d, 0xca, 0xad, 0x63, 0xda, 0x19, 0x8f,
+	0xcc, 0xd5, 0xe2, 0x35, 0x4a, 0x36, 0x44, 0x16, 0xb3, 0x00, 0x49, 0xbf, 0xe1, 0x97, 0xa0, 0x9e,
+	0x84, 0x2e, 0x8d, 0x2e, 0x22, 0x29, 0xd8, 0xd5, 0x67, 0x9b, 0xda, 0xce, 0x9c, 0xbd, 0xfe, 0xc0,
+	0x50, 0xcc, 0x23, 0xb2, 0x28, 0x02, 0xca, 0xa0, 0x9b, 0xde, 0x3f, 0x8f, 0x9d, 0x2e, 0xd5, 0xab,
+	0x02, 0x98, 0xbb, 0x7f, 0x11, 0x46, 0x44, 0xa6, 0xe1, 0x25, 0x58, 0x72, 0x69, 0x90, 0x44, 0x42,
+	0x8c, 0x1f, 0xba, 0x74, 0xa0, 0xd7, 0x84, 0xb5, 0xd3, 0x17, 0x5b, 0x5b, 0xcb, 0xfa, 0x5b, 0xa0,
+	0x43, 0xa4, 0x7e, 0x1f, 0x39, 0x4b, 0x03, 0x07, 0xff, 0x4c, 0x83, 0x59, 0xf1, 0x46, 0x61, 0x0c,
+	0x6a, 0x6a, 0xdb, 0xc0, 0x8f, 0x8a, 0x8d, 0x2a, 0x59, 0x50, 0x0d, 0xf4, 0xae, 0x12, 0xf9, 0xbc,
+	0x11, 0xba, 0xfa, 0xf7, 0xcd, 0xef, 0x53, 0x9b, 0xb0, 0x81, 0xe9, 0x7e, 0xc0, 0x42, 0x3a, 0x9c,
+	0x58, 0x82, 0x1c, 0xfe, 0xa5, 0x81, 0x0f, 0x26, 0x66, 0x1f, 0xee, 0x95, 0xb0, 0x3f, 0xb5, 0xaa,
+	0x1a, 0x9f, 0x3d, 0xaf, 0x58, 0x89, 0xc2, 0x42, 0xd4, 0x2e, 0xfc, 0xa4, 0x44, 0x54, 0x7e, 0xa9,
+	0xe0, 0x9f, 0xc5, 0x4c, 0xfc, 0x02, 0x7f, 0xd5, 0x26, 0x07, 0x6a, 0xb7, 0xe4, 0xc8, 0xf2, 0xdd,
+	0xd3, 0xf8, 0xf4, 0x39, 0xa5, 0x4a, 0xdb, 0xc7, 0x42, 0xdb, 0x16, 0xdc, 0x28, 0xd7, 0x96, 0x62,
+	0xb8, 0xfd, 0xd5, 0xf5, 0xad, 0xa1, 0xdd, 0xdc, 0x1a, 0xda, 0xeb, 0x5b, 0x43, 0xfb, 0xed, 0xce,
+	0xa8, 0xdc, 0xdc, 0x19, 0x95, 0xff, 0xee, 0x8c, 0xca, 0xf9, 0x5e, 0xee, 0x75, 0x64, 0x04, 0x34,
+	0xd8, 0xef, 0x52, 0xd7, 0xa3, 0x11, 0x1e, 0x64, 0x64, 0xe2, 0x99, 0xb4, 0xab, 0x62, 0xa5, 0x7d,
+	0xfe, 0x76, 0x00, 0x0d, 0x9a, 0xe5, 0x2b, 0x38, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +467,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Issuers(ctx context.Context, in *QueryIssuersRequest, opts ...grpc.CallOption) (*QueryIssuersResponse, error)
+	DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error)
+	ReserveCoverage(ctx context.Context, in *QueryReserveCoverageRequest, opts ...grpc.CallOption) (*QueryReserveCoverageResponse, error)
 }
 
 type queryClient struct {
@@ -173,9 +488,29 @@ func (c *queryClient) Issuers(ctx context.Context, in *QueryIssuersRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error) {
+	out := new(QueryDenomRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Query/DenomRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveCoverage(ctx context.Context, in *QueryReserveCoverageRequest, opts ...grpc.CallOption) (*QueryReserveCoverageResponse, error) {
+	out := new(QueryReserveCoverageResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Query/ReserveCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Issuers(context.Context, *QueryIssuersRequest) (*QueryIssuersResponse, error)
+	DenomRestrictions(context.Context, *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error)
+	ReserveCoverage(context.Context, *QueryReserveCoverageRequest) (*QueryReserveCoverageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +520,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Issuers(ctx context.Context, req *QueryIssuersRequest) (*QueryIssuersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Issuers not implemented")
 }
+func (*UnimplementedQueryServer) DenomRestrictions(ctx context.Context, req *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRestrictions not implemented")
+}
+func (*UnimplementedQueryServer) ReserveCoverage(ctx context.Context, req *QueryReserveCoverageRequest) (*QueryReserveCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveCoverage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +549,42 @@ func _Query_Issuers_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Query/DenomRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRestrictions(ctx, req.(*QueryDenomRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Query/ReserveCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveCoverage(ctx, req.(*QueryReserveCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.issuer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +593,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Issuers",
 			Handler:    _Query_Issuers_Handler,
 		},
+		{
+			MethodName: "DenomRestrictions",
+			Handler:    _Query_DenomRestrictions_Handler,
+		},
+		{
+			MethodName: "ReserveCoverage",
+			Handler:    _Query_ReserveCoverage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/issuer/v1/query.proto",
@@ -281,46 +666,359 @@ func (m *QueryIssuersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomRestrictionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIssuersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryDenomRestrictionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomRestrictionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Issuers) > 0 {
-		for _, e := range m.Issuers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryReserveCoverageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryReserveCoverageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveCoverageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveCoverageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveCoverageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveCoverageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coverage) > 0 {
+		for iNdEx := len(m.Coverage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coverage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveCoverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveCoverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveCoverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DemurrageIndex.Size()
+		i -= size
+		if _, err := m.DemurrageIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnderReserved {
+		i--
+		if m.UnderReserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CoverageRatio.Size()
+		i -= size
+		if _, err := m.CoverageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIssuersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIssuersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for _, e := range m.Issuers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Restrictions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveCoverageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveCoverageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coverage) > 0 {
+		for _, e := range m.Coverage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReserveCoverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CoverageRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UnderReserved {
+		n += 2
+	}
+	if m.Stale {
+		n += 2
+	}
+	l = m.DemurrageIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryIssuersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -456,6 +1154,629 @@ func (m *QueryIssuersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomRestrictionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRestrictionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveCoverageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveCoverageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveCoverageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveCoverageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveCoverageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveCoverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coverage = append(m.Coverage, ReserveCoverage{})
+			if err := m.Coverage[len(m.Coverage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveCoverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveCoverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveCoverage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &ReserveAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoverageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderReserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnderReserved = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemurrageIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DemurrageIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,13 +5,19 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultAttestationMaxAge is the age after which reserve attestations are considered stale unless a query specifies otherwise.
+const DefaultAttestationMaxAge = 35 * 24 * time.Hour
+
 func NewIssuer(address sdk.AccAddress, denoms ...string) Issuer {
 	sort.Strings(denoms)
 
@@ -42,3 +48,25 @@ func (i Issuers) String() string {
 
 	return sb.String()
 }
+
+func (a ReserveAttestation) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAttestation, err.Error())
+	}
+	if a.ReserveAmount.IsNil() || a.ReserveAmount.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "reserve amount must not be negative")
+	}
+	if strings.TrimSpace(a.Currency) == "" {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "currency is required")
+	}
+	if strings.TrimSpace(a.Auditor) == "" {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "auditor is required")
+	}
+	if a.AttestedAt.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "attestation time is required")
+	}
+	if hash, err := hex.DecodeString(a.DocumentHash); err != nil || len(hash) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "document hash must be hex encoded")
+	}
+	return nil
+}
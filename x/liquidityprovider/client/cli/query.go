@@ -5,6 +5,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,15 +26,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetListCmd(),
 		GetMintableCmd(),
+		GetRedemptionCmd(),
+		GetRedemptionsCmd(),
 	)
 
 	return cmd
 }
 
+const flagIssuer = "issuer"
+
 func GetListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List liquidity providers",
+		Short: "List liquidity providers and the allowances granted to them",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -41,7 +47,8 @@ func GetListCmd() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.List(cmd.Context(), &types.QueryListRequest{})
+			issuer, _ := cmd.Flags().GetString(flagIssuer)
+			res, err := queryClient.List(cmd.Context(), &types.QueryListRequest{Issuer: issuer})
 			if err != nil {
 				return err
 			}
@@ -49,14 +56,15 @@ func GetListCmd() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().String(flagIssuer, "", "Only list liquidity providers holding an allowance granted by this issuer")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
 func GetMintableCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mintable",
-		Short: "List mintable coins",
+		Use:   "mintable [liquidity_provider_address]",
+		Short: "List mintable coins and the allowances they are drawn from",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -83,3 +91,73 @@ func GetMintableCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetRedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption [redemption_id]",
+		Short: "Show a redemption request and its status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Redemption(cmd.Context(), &types.QueryRedemptionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagOwner             = "owner"
+	flagLiquidityProvider = "liquidity-provider"
+	flagPending           = "pending"
+)
+
+func GetRedemptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemptions",
+		Short: "List redemption requests",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, _ := cmd.Flags().GetString(flagOwner)
+			lp, _ := cmd.Flags().GetString(flagLiquidityProvider)
+			pending, _ := cmd.Flags().GetBool(flagPending)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Redemptions(cmd.Context(), &types.QueryRedemptionsRequest{
+				Owner:             owner,
+				LiquidityProvider: lp,
+				PendingOnly:       pending,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagOwner, "", "Only list redemptions requested by this account")
+	cmd.Flags().String(flagLiquidityProvider, "", "Only list redemptions directed at this liquidity provider")
+	cmd.Flags().Bool(flagPending, false, "Only list redemptions awaiting resolution")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
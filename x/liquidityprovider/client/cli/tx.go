@@ -5,6 +5,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +26,9 @@ func GetTxCmd() *cobra.Command {
 	lpCmds.AddCommand(
 		getCmdMint(),
 		getCmdBurn(),
+		getCmdRequestRedemption(),
+		getCmdFulfillRedemption(),
+		getCmdRejectRedemption(),
 	)
 
 	return lpCmds
@@ -92,3 +97,106 @@ func getCmdMint() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func getCmdRequestRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "request-redemption [owner_key_or_address] [liquidity_provider_address] [amount] [bank_details]",
+		Example: `emd tx lp request-redemption mykey emoney1dgkjvr2kkrp0xc5qn66g23us779q2dmgle5aum 1000eeur "DK5000400440116243"`,
+		Short:   "Escrow tokens for an off-chain payout by a liquidity provider",
+		Long: `Escrow tokens for an off-chain payout by a liquidity provider.
+Only the SHA-256 hash of the bank details is recorded on-chain.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRequestRedemption{
+				Owner:             clientCtx.GetFromAddress().String(),
+				LiquidityProvider: args[1],
+				Amount:            amount,
+				BankDetailsHash:   types.HashBankDetails(args[3]),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdFulfillRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfill-redemption [key_or_address] [redemption_id] [payout_reference]",
+		Short: "Burn the escrow of a redemption once the payout has been made",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFulfillRedemption{
+				Signer:    clientCtx.GetFromAddress().String(),
+				ID:        id,
+				Reference: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdRejectRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-redemption [key_or_address] [redemption_id] [reason]",
+		Short: "Refund the escrow of a redemption to its owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRejectRedemption{
+				Signer: clientCtx.GetFromAddress().String(),
+				ID:     id,
+				Reason: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,8 @@
 package liquidityprovider
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/e-money/em-ledger/x/liquidityprovider/types"
@@ -21,10 +23,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs types.GenesisState) error {
 		if err != nil {
 			return sdkerrors.Wrapf(err, "address: %s", lp.Address)
 		}
-		_, err = keeper.CreateLiquidityProvider(ctx, acc, lp.Mintable)
+		prov, err := types.NewLiquidityProviderAccount(acc.String(), lp.Mintable)
 		if err != nil {
 			return sdkerrors.Wrap(err, "liquidity provider")
 		}
+		prov.Allowances = lp.Allowances
+		if err := prov.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "liquidity provider %s", lp.Address)
+		}
+		keeper.SetLiquidityProviderAccount(ctx, prov)
+	}
+
+	nextID := gs.NextRedemptionId
+	for _, redemption := range gs.Redemptions {
+		if err := redemption.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "redemption %d", redemption.ID)
+		}
+		if keeper.GetRedemption(ctx, redemption.ID) != nil {
+			return fmt.Errorf("duplicate redemption %d", redemption.ID)
+		}
+		if redemption.ID >= nextID {
+			nextID = redemption.ID + 1
+		}
+		keeper.SetRedemption(ctx, redemption)
+	}
+	if nextID > 0 {
+		keeper.SetNextRedemptionID(ctx, nextID)
 	}
 	return nil
 }
@@ -26,6 +26,18 @@ func newHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.BurnTokens(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgRequestRedemption:
+			res, err := msgServer.RequestRedemption(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFulfillRedemption:
+			res, err := msgServer.FulfillRedemption(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRejectRedemption:
+			res, err := msgServer.RejectRedemption(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized lp message type: %T", msg)
 		}
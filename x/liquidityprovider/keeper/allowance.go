@@ -0,0 +1,242 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// GrantAllowance increases the mintable amount the issuer has granted the liquidity provider. The account
+// becomes a liquidity provider if it is not already one. A non-nil expiresAt replaces the expiry of the allowance.
+func (k Keeper) GrantAllowance(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, amount sdk.Coins, expiresAt *time.Time) {
+	prov := k.GetLiquidityProviderAccount(ctx, liquidityProvider)
+	if prov == nil {
+		prov, _ = types.NewLiquidityProviderAccount(liquidityProvider.String(), sdk.NewCoins())
+		k.Logger(ctx).Info("Created liquidity provider account.", "account", prov.Address)
+	}
+
+	allowance := prov.GetAllowance(issuer.String())
+	if allowance == nil {
+		prov.Allowances = append(prov.Allowances, types.Allowance{
+			Issuer:    issuer.String(),
+			GrantedAt: ctx.BlockTime(),
+		})
+		allowance = &prov.Allowances[len(prov.Allowances)-1]
+	}
+
+	for _, coin := range amount {
+		if prov.AllowanceOf(coin.Denom) != nil {
+			continue
+		}
+
+		// Mintable amounts granted before allowances were tracked are attributed to the first issuer covering the denomination.
+		allowance.Denoms = append(allowance.Denoms, coin.Denom)
+		sort.Strings(allowance.Denoms)
+		if unattributed := prov.Mintable.AmountOf(coin.Denom); unattributed.IsPositive() {
+			allowance.Mintable = allowance.Mintable.Add(sdk.NewCoin(coin.Denom, unattributed))
+		}
+	}
+
+	allowance.Mintable = allowance.Mintable.Add(amount...)
+	if expiresAt != nil {
+		allowance.ExpiresAt = expiresAt
+	}
+
+	prov.IncreaseMintableAmount(amount)
+	k.SetLiquidityProviderAccount(ctx, prov)
+}
+
+// DecreaseAllowance reduces the mintable amount the issuer has granted the liquidity provider.
+func (k Keeper) DecreaseAllowance(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, amount sdk.Coins) error {
+	prov := k.GetLiquidityProviderAccount(ctx, liquidityProvider)
+	if prov == nil {
+		return sdkerrors.Wrap(types.ErrAccountDoesNotExist, liquidityProvider.String())
+	}
+
+	if allowance := prov.GetAllowance(issuer.String()); allowance != nil {
+		attributed := sdk.NewCoins()
+		for _, coin := range amount {
+			if allowance.Covers(coin.Denom) {
+				attributed = attributed.Add(coin)
+			}
+		}
+
+		mintable, anyNegative := allowance.Mintable.SafeSub(attributed)
+		if anyNegative {
+			return sdkerrors.Wrapf(types.ErrInsufficientMintable, "%s < %s", allowance.Mintable, attributed)
+		}
+		allowance.Mintable = mintable
+	}
+
+	if err := prov.DecreaseMintableAmount(amount); err != nil {
+		return sdkerrors.Wrap(types.ErrInsufficientMintable, err.Error())
+	}
+
+	k.SetLiquidityProviderAccount(ctx, prov)
+	return nil
+}
+
+// SetMintRateLimit replaces the rate limit of the issuer's allowance. An empty limit removes it.
+func (k Keeper) SetMintRateLimit(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, limit types.MintRateLimit) error {
+	prov := k.GetLiquidityProviderAccount(ctx, liquidityProvider)
+	if prov == nil {
+		return sdkerrors.Wrap(types.ErrAccountDoesNotExist, liquidityProvider.String())
+	}
+
+	allowance := prov.GetAllowance(issuer.String())
+	if allowance == nil {
+		return sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "no allowance from %s", issuer)
+	}
+
+	if limit.IsEmpty() {
+		allowance.RateLimit = nil
+		allowance.RecentMints = nil
+	} else {
+		allowance.RateLimit = &limit
+	}
+
+	k.SetLiquidityProviderAccount(ctx, prov)
+	return nil
+}
+
+// RevokeAllowance removes the issuer's allowance and any mintable amount of the given denominations. The account
+// stops being a liquidity provider when nothing remains.
+func (k Keeper) RevokeAllowance(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, denoms []string) {
+	prov := k.GetLiquidityProviderAccount(ctx, liquidityProvider)
+	if prov == nil {
+		return
+	}
+
+	allowances := make([]types.Allowance, 0, len(prov.Allowances))
+	for _, a := range prov.Allowances {
+		if a.Issuer != issuer.String() {
+			allowances = append(allowances, a)
+		}
+	}
+	prov.Allowances = allowances
+	prov.Mintable = removeDenoms(prov.Mintable, denoms...)
+
+	k.setOrRevoke(ctx, prov)
+}
+
+// RemoveDenom removes a denomination from the mintable amounts and allowances of all liquidity providers.
+func (k Keeper) RemoveDenom(ctx sdk.Context, denom string) {
+	for _, prov := range k.providersOf(ctx, denom) {
+		prov.Mintable = removeDenoms(prov.Mintable, denom)
+
+		allowances := make([]types.Allowance, 0, len(prov.Allowances))
+		for _, a := range prov.Allowances {
+			if a.Covers(denom) {
+				a.Denoms = removeString(a.Denoms, denom)
+				a.Mintable = removeDenoms(a.Mintable, denom)
+				if len(a.Denoms) == 0 {
+					continue
+				}
+			}
+			allowances = append(allowances, a)
+		}
+		prov.Allowances = allowances
+
+		k.setOrRevoke(ctx, &prov)
+	}
+}
+
+// MigrateDenom moves the allowances covering a denomination from one issuer to another. Allowances created for
+// the new issuer inherit the expiry of the one they were migrated from, and the rate limit of the denomination moves
+// along with its recent mints.
+func (k Keeper) MigrateDenom(ctx sdk.Context, denom string, from, to sdk.AccAddress) {
+	for _, prov := range k.providersOf(ctx, denom) {
+		source := prov.GetAllowance(from.String())
+		if source == nil || !source.Covers(denom) {
+			continue
+		}
+
+		amount := sdk.NewCoins(sdk.NewCoin(denom, source.Mintable.AmountOf(denom)))
+		source.Denoms = removeString(source.Denoms, denom)
+		source.Mintable = removeDenoms(source.Mintable, denom)
+		expiresAt := source.ExpiresAt
+		rateLimit, recentMints := source.SplitRateLimit(denom)
+
+		allowances := make([]types.Allowance, 0, len(prov.Allowances)+1)
+		for _, a := range prov.Allowances {
+			if len(a.Denoms) > 0 {
+				allowances = append(allowances, a)
+			}
+		}
+		prov.Allowances = allowances
+
+		target := prov.GetAllowance(to.String())
+		if target == nil {
+			prov.Allowances = append(prov.Allowances, types.Allowance{
+				Issuer:    to.String(),
+				GrantedAt: ctx.BlockTime(),
+				ExpiresAt: expiresAt,
+			})
+			target = &prov.Allowances[len(prov.Allowances)-1]
+		}
+
+		target.Denoms = append(target.Denoms, denom)
+		sort.Strings(target.Denoms)
+		target.Mintable = target.Mintable.Add(amount...)
+		target.MergeRateLimit(rateLimit, recentMints)
+
+		k.SetLiquidityProviderAccount(ctx, &prov)
+	}
+}
+
+// providersOf returns the liquidity providers that have a mintable amount or allowance in the denomination.
+func (k Keeper) providersOf(ctx sdk.Context, denom string) (res []types.LiquidityProviderAccount) {
+	k.IterateProviders(ctx, func(prov types.LiquidityProviderAccount) (stop bool) {
+		if prov.Mintable.AmountOf(denom).IsPositive() || prov.AllowanceOf(denom) != nil {
+			res = append(res, prov)
+		}
+		return false
+	})
+	return
+}
+
+func (k Keeper) setOrRevoke(ctx sdk.Context, prov *types.LiquidityProviderAccount) {
+	if len(prov.Mintable) == 0 && len(prov.Allowances) == 0 {
+		lpAcc, _ := prov.GetAccAddress()
+		k.RevokeLiquidityProviderAccount(ctx, lpAcc)
+		return
+	}
+
+	k.SetLiquidityProviderAccount(ctx, prov)
+}
+
+func removeDenoms(coins sdk.Coins, denoms ...string) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, c := range coins {
+		if !contains(denoms, c.Denom) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func removeString(s []string, v string) []string {
+	res := make([]string, 0, len(s))
+	for _, e := range s {
+		if e != v {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -17,8 +17,19 @@ func (k Keeper) List(c context.Context, req *types.QueryListRequest) (*types.Que
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	lps := k.GetAllLiquidityProviderAccounts(sdk.UnwrapSDKContext(c))
+	if req.Issuer != "" {
+		filtered := make([]types.LiquidityProviderAccount, 0)
+		for _, lp := range lps {
+			if lp.GetAllowance(req.Issuer) != nil {
+				filtered = append(filtered, lp)
+			}
+		}
+		lps = filtered
+	}
+
 	response := &types.QueryListResponse{
-		LiquidityProviders: k.GetAllLiquidityProviderAccounts(sdk.UnwrapSDKContext(c)),
+		LiquidityProviders: lps,
 	}
 
 	return response, nil
@@ -34,7 +45,8 @@ func (k Keeper) Mintable(c context.Context, req *types.QueryMintableRequest) (*t
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "liquidity provider:"+req.Address)
 	}
 
-	lp := k.GetLiquidityProviderAccount(sdk.UnwrapSDKContext(c), lqAcc)
+	ctx := sdk.UnwrapSDKContext(c)
+	lp := k.GetLiquidityProviderAccount(ctx, lqAcc)
 	if lp == nil {
 		return &types.QueryMintableResponse{
 			Mintable: sdk.NewCoins(),
@@ -42,8 +54,42 @@ func (k Keeper) Mintable(c context.Context, req *types.QueryMintableRequest) (*t
 	}
 
 	response := types.QueryMintableResponse{
-		Mintable: lp.Mintable,
+		Mintable:   lp.AvailableMintable(ctx.BlockTime()),
+		Allowances: lp.Allowances,
 	}
 
 	return &response, nil
 }
+
+func (k Keeper) Redemption(c context.Context, req *types.QueryRedemptionRequest) (*types.QueryRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	redemption := k.GetRedemption(sdk.UnwrapSDKContext(c), req.Id)
+	if redemption == nil {
+		return nil, status.Errorf(codes.NotFound, "redemption %d", req.Id)
+	}
+
+	return &types.QueryRedemptionResponse{Redemption: *redemption}, nil
+}
+
+func (k Keeper) Redemptions(c context.Context, req *types.QueryRedemptionsRequest) (*types.QueryRedemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	redemptions := make([]types.Redemption, 0)
+	k.IterateRedemptions(sdk.UnwrapSDKContext(c), func(redemption types.Redemption) (stop bool) {
+		switch {
+		case req.Owner != "" && redemption.Owner != req.Owner:
+		case req.LiquidityProvider != "" && redemption.LiquidityProvider != req.LiquidityProvider:
+		case req.PendingOnly && !redemption.IsPending():
+		default:
+			redemptions = append(redemptions, redemption)
+		}
+		return false
+	})
+
+	return &types.QueryRedemptionsResponse{Redemptions: redemptions}, nil
+}
@@ -162,3 +162,33 @@ func TestQueryMintable(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryListByIssuer(t *testing.T) {
+	encConfig := MakeTestEncodingConfig()
+	ctx, _, _, keeper := createTestComponents(t, sdk.NewCoins())
+
+	var (
+		issuer1 = sdk.AccAddress(rand.Bytes(legacyAddrLen))
+		issuer2 = sdk.AccAddress(rand.Bytes(legacyAddrLen))
+		lp1     = sdk.AccAddress(rand.Bytes(legacyAddrLen))
+		lp2     = sdk.AccAddress(rand.Bytes(legacyAddrLen))
+	)
+
+	keeper.GrantAllowance(ctx, lp1, issuer1, sdk.NewCoins(sdk.NewCoin("eeur", sdk.NewInt(1000))), nil)
+	keeper.GrantAllowance(ctx, lp2, issuer2, sdk.NewCoins(sdk.NewCoin("ejpy", sdk.NewInt(500))), nil)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encConfig.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	gotRsp, err := queryClient.List(sdk.WrapSDKContext(ctx), &types.QueryListRequest{Issuer: issuer1.String()})
+	require.NoError(t, err)
+	require.Len(t, gotRsp.LiquidityProviders, 1)
+	assert.Equal(t, lp1.String(), gotRsp.LiquidityProviders[0].Address)
+
+	gotMintable, err := queryClient.Mintable(sdk.WrapSDKContext(ctx), &types.QueryMintableRequest{Address: lp2.String()})
+	require.NoError(t, err)
+	require.Len(t, gotMintable.Allowances, 1)
+	assert.Equal(t, issuer2.String(), gotMintable.Allowances[0].Issuer)
+	assert.Equal(t, "500ejpy", gotMintable.Allowances[0].Mintable.String())
+}
@@ -20,6 +20,7 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   sdk.StoreKey
 	bankKeeper types.BankKeeper
+	supplyCaps types.SupplyCaps
 }
 
 func NewKeeper(
@@ -32,6 +33,11 @@ func NewKeeper(
 	}
 }
 
+// SetSupplyCaps registers the supply caps enforced when minting.
+func (k *Keeper) SetSupplyCaps(sc types.SupplyCaps) {
+	k.supplyCaps = sc
+}
+
 // ------------------------------------------
 //				State functions
 // ------------------------------------------
@@ -150,6 +156,11 @@ func (k Keeper) BurnTokensFromBalance(ctx sdk.Context, liquidityProvider sdk.Acc
 		return nil, err
 	}
 
+	for _, coin := range amount {
+		if allowance := prov.AllowanceOf(coin.Denom); allowance != nil {
+			allowance.Mintable = allowance.Mintable.Add(coin)
+		}
+	}
 	prov.Mintable = prov.Mintable.Add(amount...)
 	k.SetLiquidityProviderAccount(ctx, prov)
 
@@ -181,6 +192,37 @@ func (k Keeper) MintTokens(ctx sdk.Context, liquidityProvider sdk.AccAddress, am
 		)
 	}
 
+	if k.supplyCaps != nil {
+		if err := k.supplyCaps.ValidateMint(ctx, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, coin := range amount {
+		allowance := prov.AllowanceOf(coin.Denom)
+		if allowance == nil {
+			continue
+		}
+
+		if allowance.IsExpired(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrAllowanceExpired, "allowance of %s for %s", allowance.Issuer, coin.Denom)
+		}
+
+		mintable, anyNegative := allowance.Mintable.SafeSub(sdk.NewCoins(coin))
+		if anyNegative {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds,
+				"insufficient allowance of %s: %s < %s",
+				allowance.Issuer, allowance.Mintable, coin,
+			)
+		}
+		allowance.Mintable = mintable
+
+		if err := allowance.RecordMint(coin, ctx.BlockTime()); err != nil {
+			return nil, err
+		}
+	}
+
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount)
 	if err != nil {
 		return nil, err
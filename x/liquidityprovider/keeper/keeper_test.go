@@ -7,6 +7,7 @@ package keeper
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -26,6 +28,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	embank "github.com/e-money/em-ledger/hooks/bank"
 	"github.com/e-money/em-ledger/x/liquidityprovider/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -228,6 +231,177 @@ func TestAccountNotFound(t *testing.T) {
 	require.Equal(t, p.Address, acc.String())
 }
 
+func TestAllowances(t *testing.T) {
+	ctx, ak, bk, keeper := createTestComponents(t, initialBalance)
+
+	var (
+		issuer1 = sdk.AccAddress("issuer1_____________")
+		issuer2 = sdk.AccAddress("issuer2_____________")
+		now     = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		expiry  = now.Add(time.Hour)
+	)
+	ctx = ctx.WithBlockTime(now)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, accAddr1))
+	setAccBalance(t, ctx, accAddr1, bk, initialBalance)
+
+	// Mintable amounts predating allowances are attributed to the first issuer granting the denomination
+	_, err := keeper.CreateLiquidityProvider(ctx, accAddr1, mustParseCoins("100eeur"))
+	require.NoError(t, err)
+	keeper.GrantAllowance(ctx, accAddr1, issuer1, mustParseCoins("900eeur"), nil)
+	keeper.GrantAllowance(ctx, accAddr1, issuer2, mustParseCoins("500ejpy"), &expiry)
+
+	lpAcc := keeper.GetLiquidityProviderAccount(ctx, accAddr1)
+	require.Equal(t, "1000eeur,500ejpy", lpAcc.Mintable.String())
+	require.Len(t, lpAcc.Allowances, 2)
+	require.Equal(t, "1000eeur", lpAcc.GetAllowance(issuer1.String()).Mintable.String())
+	require.Equal(t, now, lpAcc.GetAllowance(issuer2.String()).GrantedAt)
+	require.Equal(t, &expiry, lpAcc.GetAllowance(issuer2.String()).ExpiresAt)
+
+	_, err = keeper.MintTokens(ctx, accAddr1, mustParseCoins("1000eeur,100ejpy"))
+	require.NoError(t, err)
+
+	// Allowances are kept when exhausted so burned tokens are credited back to them
+	lpAcc = keeper.GetLiquidityProviderAccount(ctx, accAddr1)
+	require.Empty(t, lpAcc.GetAllowance(issuer1.String()).Mintable)
+	_, err = keeper.BurnTokensFromBalance(ctx, accAddr1, mustParseCoins("200eeur"))
+	require.NoError(t, err)
+	lpAcc = keeper.GetLiquidityProviderAccount(ctx, accAddr1)
+	require.Equal(t, "200eeur", lpAcc.GetAllowance(issuer1.String()).Mintable.String())
+
+	require.Error(t, keeper.DecreaseAllowance(ctx, accAddr1, issuer1, mustParseCoins("201eeur")))
+	require.NoError(t, keeper.DecreaseAllowance(ctx, accAddr1, issuer1, mustParseCoins("50eeur")))
+	lpAcc = keeper.GetLiquidityProviderAccount(ctx, accAddr1)
+	require.Equal(t, "150eeur,400ejpy", lpAcc.Mintable.String())
+
+	// Expired allowances can no longer be minted from
+	ctx = ctx.WithBlockTime(expiry)
+	_, err = keeper.MintTokens(ctx, accAddr1, mustParseCoins("1ejpy"))
+	require.ErrorIs(t, err, types.ErrAllowanceExpired)
+	require.Equal(t, "150eeur", lpAcc.AvailableMintable(ctx.BlockTime()).String())
+
+	keeper.MigrateDenom(ctx, "eeur", issuer1, issuer2)
+	lpAcc = keeper.GetLiquidityProviderAccount(ctx, accAddr1)
+	require.Nil(t, lpAcc.GetAllowance(issuer1.String()))
+	require.Equal(t, []string{"eeur", "ejpy"}, lpAcc.GetAllowance(issuer2.String()).Denoms)
+
+	keeper.RevokeAllowance(ctx, accAddr1, issuer2, []string{"eeur", "ejpy"})
+	require.Nil(t, keeper.GetLiquidityProviderAccount(ctx, accAddr1))
+}
+
+func TestRedemptions(t *testing.T) {
+	ctx, ak, bk, keeper := createTestComponents(t, initialBalance)
+
+	var (
+		owner       = sdk.AccAddress("owner_______________")
+		issuer      = sdk.AccAddress("issuer______________")
+		other       = sdk.AccAddress("other_______________")
+		bankDetails = types.HashBankDetails("DK5000400440116243")
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, owner))
+	setAccBalance(t, ctx, owner, bk, mustParseCoins("1000eeur"))
+	keeper.GrantAllowance(ctx, accAddr1, issuer, mustParseCoins("100eeur"), nil)
+
+	_, _, err := keeper.RequestRedemption(ctx, owner, other, sdk.NewInt64Coin("eeur", 100), bankDetails)
+	require.ErrorIs(t, err, types.ErrAccountDoesNotExist)
+	_, _, err = keeper.RequestRedemption(ctx, owner, accAddr1, sdk.NewInt64Coin("ejpy", 100), bankDetails)
+	require.ErrorIs(t, err, types.ErrAccountDoesNotExist)
+
+	id1, _, err := keeper.RequestRedemption(ctx, owner, accAddr1, sdk.NewInt64Coin("eeur", 300), bankDetails)
+	require.NoError(t, err)
+	id2, _, err := keeper.RequestRedemption(ctx, owner, accAddr1, sdk.NewInt64Coin("eeur", 200), bankDetails)
+	require.NoError(t, err)
+	require.Equal(t, id1+1, id2)
+	require.Equal(t, "500eeur", bk.GetAllBalances(ctx, owner).String())
+
+	// Only the liquidity provider and the issuer of the denomination can resolve a redemption
+	_, err = keeper.FulfillRedemption(ctx, other, id1, "payout-1")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = keeper.FulfillRedemption(ctx, owner, id1, "payout-1")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	supply := bk.GetSupply(ctx, "eeur").Amount
+	_, err = keeper.FulfillRedemption(ctx, accAddr1, id1, "payout-1")
+	require.NoError(t, err)
+	require.Equal(t, supply.SubRaw(300), bk.GetSupply(ctx, "eeur").Amount)
+	require.Equal(t, "400eeur", keeper.GetLiquidityProviderAccount(ctx, accAddr1).Mintable.String())
+
+	_, err = keeper.RejectRedemption(ctx, issuer, id2, "unknown beneficiary")
+	require.NoError(t, err)
+	require.Equal(t, "700eeur", bk.GetAllBalances(ctx, owner).String())
+
+	_, err = keeper.RejectRedemption(ctx, accAddr1, id1, "")
+	require.ErrorIs(t, err, types.ErrRedemptionResolved)
+	_, err = keeper.FulfillRedemption(ctx, accAddr1, id2+1, "")
+	require.ErrorIs(t, err, types.ErrRedemptionNotFound)
+
+	redemption := keeper.GetRedemption(ctx, id1)
+	require.Equal(t, types.RedemptionStatus_Fulfilled, redemption.Status)
+	require.Equal(t, accAddr1.String(), redemption.ResolvedBy)
+	require.Equal(t, "payout-1", redemption.Memo)
+	require.Equal(t, bankDetails, redemption.BankDetailsHash)
+
+	res, err := keeper.Redemptions(sdk.WrapSDKContext(ctx), &types.QueryRedemptionsRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Redemptions, 2)
+	require.Equal(t, types.RedemptionStatus_Rejected, res.Redemptions[1].Status)
+
+	res, err = keeper.Redemptions(sdk.WrapSDKContext(ctx), &types.QueryRedemptionsRequest{PendingOnly: true})
+	require.NoError(t, err)
+	require.Empty(t, res.Redemptions)
+}
+
+func TestRedemptionOfFrozenTokens(t *testing.T) {
+	ctx, ak, bk, keeper := createTestComponents(t, initialBalance)
+
+	var (
+		owner       = sdk.AccAddress("owner_______________")
+		issuer      = sdk.AccAddress("issuer______________")
+		bankDetails = types.HashBankDetails("DK5000400440116243")
+	)
+
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, owner))
+	setAccBalance(t, ctx, owner, bk, mustParseCoins("1000eeur"))
+	keeper.GrantAllowance(ctx, accAddr1, issuer, mustParseCoins("100eeur"), nil)
+
+	// Restrictions are enforced by the bank proxy on the escrow transfer
+	proxy := embank.Wrap(bk)
+	proxy.SetTransferRestrictions(frozenMock{owner})
+	keeper.bankKeeper = proxy
+
+	_, _, err := keeper.RequestRedemption(ctx, owner, accAddr1, sdk.NewInt64Coin("eeur", 300), bankDetails)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, "1000eeur", bk.GetAllBalances(ctx, owner).String())
+	keeper.IterateRedemptions(ctx, func(types.Redemption) bool {
+		t.Fatal("no redemption must be recorded")
+		return true
+	})
+}
+
+type frozenMock struct {
+	frozen sdk.AccAddress
+}
+
+func (m frozenMock) ValidateSend(_ sdk.Context, sender sdk.AccAddress, _ sdk.Coins) error {
+	if sender.Equals(m.frozen) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is frozen", sender)
+	}
+	return nil
+}
+
+func (m frozenMock) ValidateReceive(sdk.Context, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func mustParseCoins(coins string) sdk.Coins {
+	result, err := sdk.ParseCoinsNormalized(coins)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
 func createTestComponents(t *testing.T, initialSupply sdk.Coins) (sdk.Context, authkeeper.AccountKeeper, bankkeeper.Keeper, Keeper) {
 	t.Helper()
 	encConfig := MakeTestEncodingConfig()
@@ -255,7 +429,7 @@ func createTestComponents(t *testing.T, initialSupply sdk.Coins) (sdk.Context, a
 	require.NoError(t, err)
 
 	maccPerms := map[string][]string{
-		types.ModuleName:               {authtypes.Minter},
+		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
 		authtypes.FeeCollectorName:     nil,
 		"buyback":                      {authtypes.Burner},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
@@ -13,6 +13,9 @@ var _ types.MsgServer = msgServer{}
 type liquidityProvKeeper interface {
 	MintTokens(ctx sdk.Context, liquidityProvider sdk.AccAddress, amount sdk.Coins) (*sdk.Result, error)
 	BurnTokensFromBalance(ctx sdk.Context, liquidityProvider sdk.AccAddress, amount sdk.Coins) (*sdk.Result, error)
+	RequestRedemption(ctx sdk.Context, owner, liquidityProvider sdk.AccAddress, amount sdk.Coin, bankDetailsHash string) (uint64, *sdk.Result, error)
+	FulfillRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reference string) (*sdk.Result, error)
+	RejectRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reason string) (*sdk.Result, error)
 }
 type msgServer struct {
 	k liquidityProvKeeper
@@ -54,3 +57,58 @@ func (m msgServer) BurnTokens(c context.Context, msg *types.MsgBurnTokens) (*typ
 	}
 	return &types.MsgBurnTokensResponse{}, nil
 }
+
+func (m msgServer) RequestRedemption(c context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+	lp, err := sdk.AccAddressFromBech32(msg.LiquidityProvider)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "liquidity provider")
+	}
+	id, result, err := m.k.RequestRedemption(ctx, owner, lp, msg.Amount, msg.BankDetailsHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgRequestRedemptionResponse{ID: id}, nil
+}
+
+func (m msgServer) FulfillRedemption(c context.Context, msg *types.MsgFulfillRedemption) (*types.MsgFulfillRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer")
+	}
+	result, err := m.k.FulfillRedemption(ctx, signer, msg.ID, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgFulfillRedemptionResponse{}, nil
+}
+
+func (m msgServer) RejectRedemption(c context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer")
+	}
+	result, err := m.k.RejectRedemption(ctx, signer, msg.ID, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgRejectRedemptionResponse{}, nil
+}
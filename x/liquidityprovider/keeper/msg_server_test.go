@@ -194,6 +194,9 @@ func TestBurnTokens(t *testing.T) {
 type lpKeeperMock struct {
 	MintTokensFn            func(ctx sdk.Context, liquidityProvider sdk.AccAddress, amount sdk.Coins) (*sdk.Result, error)
 	BurnTokensFromBalanceFn func(ctx sdk.Context, liquidityProvider sdk.AccAddress, amount sdk.Coins) (*sdk.Result, error)
+	RequestRedemptionFn     func(ctx sdk.Context, owner, liquidityProvider sdk.AccAddress, amount sdk.Coin, bankDetailsHash string) (uint64, *sdk.Result, error)
+	FulfillRedemptionFn     func(ctx sdk.Context, signer sdk.AccAddress, id uint64, reference string) (*sdk.Result, error)
+	RejectRedemptionFn      func(ctx sdk.Context, signer sdk.AccAddress, id uint64, reason string) (*sdk.Result, error)
 }
 
 func (m lpKeeperMock) MintTokens(ctx sdk.Context, liquidityProvider sdk.AccAddress, amount sdk.Coins) (*sdk.Result, error) {
@@ -210,6 +213,27 @@ func (m lpKeeperMock) BurnTokensFromBalance(ctx sdk.Context, liquidityProvider s
 	return m.BurnTokensFromBalanceFn(ctx, liquidityProvider, amount)
 }
 
+func (m lpKeeperMock) RequestRedemption(ctx sdk.Context, owner, liquidityProvider sdk.AccAddress, amount sdk.Coin, bankDetailsHash string) (uint64, *sdk.Result, error) {
+	if m.RequestRedemptionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.RequestRedemptionFn(ctx, owner, liquidityProvider, amount, bankDetailsHash)
+}
+
+func (m lpKeeperMock) FulfillRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reference string) (*sdk.Result, error) {
+	if m.FulfillRedemptionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.FulfillRedemptionFn(ctx, signer, id, reference)
+}
+
+func (m lpKeeperMock) RejectRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reason string) (*sdk.Result, error) {
+	if m.RejectRedemptionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.RejectRedemptionFn(ctx, signer, id, reason)
+}
+
 func randomAddress() string {
 	return sdk.AccAddress(rand.Bytes(legacyAddrLen)).String()
 }
@@ -0,0 +1,210 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// RequestRedemption moves the amount from the owner into escrow in the module account, pending an off-chain payout
+// by the liquidity provider.
+func (k Keeper) RequestRedemption(ctx sdk.Context, owner, liquidityProvider sdk.AccAddress, amount sdk.Coin, bankDetailsHash string) (uint64, *sdk.Result, error) {
+	prov := k.GetLiquidityProviderAccount(ctx, liquidityProvider)
+	if prov == nil {
+		return 0, nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "account %s is not a liquidity provider", liquidityProvider)
+	}
+	if prov.AllowanceOf(amount.Denom) == nil && !prov.Mintable.AmountOf(amount.Denom).IsPositive() {
+		return 0, nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "liquidity provider %s does not handle %s", liquidityProvider, amount.Denom)
+	}
+	if err := types.ValidateBankDetailsHash(bankDetailsHash); err != nil {
+		return 0, nil, err
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	redemption := types.Redemption{
+		ID:                k.NextRedemptionID(ctx),
+		Owner:             owner.String(),
+		LiquidityProvider: liquidityProvider.String(),
+		Amount:            amount,
+		BankDetailsHash:   bankDetailsHash,
+		Status:            types.RedemptionStatus_Pending,
+		RequestedAt:       ctx.BlockTime(),
+	}
+	k.SetRedemption(ctx, redemption)
+	k.SetNextRedemptionID(ctx, redemption.ID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionRequested,
+			sdk.NewAttribute(types.AttributeKeyRedemptionID, fmt.Sprint(redemption.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, redemption.Owner),
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, redemption.LiquidityProvider),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return redemption.ID, &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// FulfillRedemption burns the escrow of a redemption once the payout has been made. As with a burn by the liquidity
+// provider, the amount is credited back to its mintable amount.
+func (k Keeper) FulfillRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reference string) (*sdk.Result, error) {
+	redemption, prov, err := k.pendingRedemption(ctx, signer, id)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoins(redemption.Amount)
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return nil, err
+	}
+
+	if prov != nil {
+		if allowance := prov.AllowanceOf(redemption.Amount.Denom); allowance != nil {
+			allowance.Mintable = allowance.Mintable.Add(amount...)
+		}
+		prov.Mintable = prov.Mintable.Add(amount...)
+		k.SetLiquidityProviderAccount(ctx, prov)
+	}
+
+	k.resolveRedemption(ctx, redemption, types.RedemptionStatus_Fulfilled, signer, reference)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// RejectRedemption refunds the escrow of a redemption to its owner.
+func (k Keeper) RejectRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64, reason string) (*sdk.Result, error) {
+	redemption, _, err := k.pendingRedemption(ctx, signer, id)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(redemption.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(redemption.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	k.resolveRedemption(ctx, redemption, types.RedemptionStatus_Rejected, signer, reason)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// pendingRedemption loads a redemption awaiting resolution, provided the signer is its liquidity provider or the
+// issuer that granted the liquidity provider the denomination.
+func (k Keeper) pendingRedemption(ctx sdk.Context, signer sdk.AccAddress, id uint64) (*types.Redemption, *types.LiquidityProviderAccount, error) {
+	redemption := k.GetRedemption(ctx, id)
+	if redemption == nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrRedemptionNotFound, "%d", id)
+	}
+	if !redemption.IsPending() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrRedemptionResolved, "redemption %d is %s", id, redemption.Status)
+	}
+
+	lpAcc, err := sdk.AccAddressFromBech32(redemption.LiquidityProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prov := k.GetLiquidityProviderAccount(ctx, lpAcc)
+	if signer.Equals(lpAcc) {
+		return redemption, prov, nil
+	}
+	if prov != nil {
+		if allowance := prov.AllowanceOf(redemption.Amount.Denom); allowance != nil && allowance.Issuer == signer.String() {
+			return redemption, prov, nil
+		}
+	}
+
+	return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot resolve redemption %d", signer, id)
+}
+
+func (k Keeper) resolveRedemption(ctx sdk.Context, redemption *types.Redemption, status types.RedemptionStatus, signer sdk.AccAddress, memo string) {
+	now := ctx.BlockTime()
+	redemption.Status = status
+	redemption.ResolvedAt = &now
+	redemption.ResolvedBy = signer.String()
+	redemption.Memo = memo
+	k.SetRedemption(ctx, *redemption)
+
+	eventType := types.EventTypeRedemptionFulfilled
+	if status == types.RedemptionStatus_Rejected {
+		eventType = types.EventTypeRedemptionRejected
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyRedemptionID, fmt.Sprint(redemption.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, redemption.Owner),
+			sdk.NewAttribute(types.AttributeKeyAmount, redemption.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyResolvedBy, redemption.ResolvedBy),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+}
+
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) *types.Redemption {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRedemptionKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var redemption types.Redemption
+	k.cdc.MustUnmarshal(bz, &redemption)
+	return &redemption
+}
+
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) {
+	ctx.KVStore(k.storeKey).Set(types.GetRedemptionKey(redemption.ID), k.cdc.MustMarshal(&redemption))
+}
+
+// IterateRedemptions calls cb for all redemptions in the order they were requested until cb returns true.
+func (k Keeper) IterateRedemptions(ctx sdk.Context, cb func(redemption types.Redemption) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionKeyPrefix).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+
+		if cb(redemption) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAllRedemptions(ctx sdk.Context) []types.Redemption {
+	res := make([]types.Redemption, 0)
+	k.IterateRedemptions(ctx, func(redemption types.Redemption) (stop bool) {
+		res = append(res, redemption)
+		return false
+	})
+	return res
+}
+
+// NextRedemptionID returns the ID assigned to the next redemption request.
+func (k Keeper) NextRedemptionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextRedemptionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextRedemptionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextRedemptionIDKey, sdk.Uint64ToBigEndian(id))
+}
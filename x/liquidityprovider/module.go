@@ -98,12 +98,17 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	genAccs := make([]types.GenesisAcc, len(allLPs))
 	for i, lp := range allLPs {
 		genAccs[i] = types.GenesisAcc{
-			Address:  lp.Address,
-			Mintable: lp.Mintable,
+			Address:    lp.Address,
+			Mintable:   lp.Mintable,
+			Allowances: lp.Allowances,
 		}
 	}
 
-	gs := types.GenesisState{Accounts: genAccs}
+	gs := types.GenesisState{
+		Accounts:         genAccs,
+		Redemptions:      am.keeper.GetAllRedemptions(ctx),
+		NextRedemptionId: am.keeper.NextRedemptionID(ctx),
+	}
 	return cdc.MustMarshalJSON(&gs)
 }
 
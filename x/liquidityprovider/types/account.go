@@ -6,6 +6,8 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -30,9 +32,228 @@ func (acc LiquidityProviderAccount) Validate() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, acc.Address)
 	}
 
+	attributed := sdk.NewCoins()
+	for _, a := range acc.Allowances {
+		if _, err := sdk.AccAddressFromBech32(a.Issuer); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, a.Issuer)
+		}
+		if err := a.Mintable.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "allowance of %s", a.Issuer)
+		}
+		if a.RateLimit != nil {
+			if err := a.RateLimit.Validate(); err != nil {
+				return sdkerrors.Wrapf(err, "rate limit of %s", a.Issuer)
+			}
+		}
+		attributed = attributed.Add(a.Mintable...)
+	}
+
+	if !acc.Mintable.IsAllGTE(attributed) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "allowances exceed mintable: %s > %s", attributed, acc.Mintable)
+	}
+
+	return nil
+}
+
+// GetAllowance returns the allowance granted by the issuer or nil if there is none.
+func (acc *LiquidityProviderAccount) GetAllowance(issuer string) *Allowance {
+	for i := range acc.Allowances {
+		if acc.Allowances[i].Issuer == issuer {
+			return &acc.Allowances[i]
+		}
+	}
 	return nil
 }
 
+// AllowanceOf returns the allowance covering the denomination or nil if the denomination is unattributed.
+func (acc *LiquidityProviderAccount) AllowanceOf(denom string) *Allowance {
+	for i := range acc.Allowances {
+		if acc.Allowances[i].Covers(denom) {
+			return &acc.Allowances[i]
+		}
+	}
+	return nil
+}
+
+// AvailableMintable returns the mintable amount that is not held back by expired allowances.
+func (acc LiquidityProviderAccount) AvailableMintable(now time.Time) sdk.Coins {
+	available := acc.Mintable
+	for _, a := range acc.Allowances {
+		if a.IsExpired(now) {
+			available = available.Sub(a.Mintable)
+		}
+	}
+	return available
+}
+
+func (a Allowance) Covers(denom string) bool {
+	for _, d := range a.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Allowance) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
+}
+
+// RecordMint checks a mint against the rate limit of the allowance and tracks it within the rate limit window.
+func (a *Allowance) RecordMint(coin sdk.Coin, now time.Time) error {
+	if a.RateLimit == nil {
+		return nil
+	}
+
+	if max := a.RateLimit.MaxPerTx.AmountOf(coin.Denom); max.IsPositive() && coin.Amount.GT(max) {
+		return sdkerrors.Wrapf(ErrMintRateLimitExceeded, "%s exceeds maximum of %s%s per transaction", coin, max, coin.Denom)
+	}
+
+	if a.RateLimit.Window <= 0 {
+		return nil
+	}
+
+	windowStart := now.Add(-a.RateLimit.Window)
+	recent := make([]MintRecord, 0, len(a.RecentMints)+1)
+	minted := coin.Amount
+	for _, r := range a.RecentMints {
+		if r.Time.After(windowStart) {
+			recent = append(recent, r)
+			minted = minted.Add(r.Amount.AmountOf(coin.Denom))
+		}
+	}
+
+	if max := a.RateLimit.MaxPerWindow.AmountOf(coin.Denom); max.IsPositive() && minted.GT(max) {
+		return sdkerrors.Wrapf(ErrMintRateLimitExceeded, "%s%s exceeds maximum of %s%s per %s", minted, coin.Denom, max, coin.Denom, a.RateLimit.Window)
+	}
+
+	if n := len(recent); n > 0 && recent[n-1].Time.Equal(now) {
+		recent[n-1].Amount = recent[n-1].Amount.Add(coin)
+	} else {
+		recent = append(recent, MintRecord{Time: now, Amount: sdk.NewCoins(coin)})
+	}
+	a.RecentMints = recent
+	return nil
+}
+
+// SplitRateLimit removes the limits and recent mints of the denomination from the allowance and returns them.
+func (a *Allowance) SplitRateLimit(denom string) (*MintRateLimit, []MintRecord) {
+	if a.RateLimit == nil {
+		return nil, nil
+	}
+
+	split := MintRateLimit{
+		MaxPerTx:     sdk.NewCoins(sdk.NewCoin(denom, a.RateLimit.MaxPerTx.AmountOf(denom))),
+		MaxPerWindow: sdk.NewCoins(sdk.NewCoin(denom, a.RateLimit.MaxPerWindow.AmountOf(denom))),
+		Window:       a.RateLimit.Window,
+	}
+	a.RateLimit.MaxPerTx = coinsWithout(a.RateLimit.MaxPerTx, denom)
+	a.RateLimit.MaxPerWindow = coinsWithout(a.RateLimit.MaxPerWindow, denom)
+
+	var mints, remaining []MintRecord
+	for _, r := range a.RecentMints {
+		if amount := r.Amount.AmountOf(denom); amount.IsPositive() {
+			mints = append(mints, MintRecord{Time: r.Time, Amount: sdk.NewCoins(sdk.NewCoin(denom, amount))})
+		}
+		if rest := coinsWithout(r.Amount, denom); !rest.Empty() {
+			remaining = append(remaining, MintRecord{Time: r.Time, Amount: rest})
+		}
+	}
+	a.RecentMints = remaining
+
+	if a.RateLimit.IsEmpty() {
+		a.RateLimit = nil
+		a.RecentMints = nil
+	}
+
+	if split.IsEmpty() {
+		return nil, nil
+	}
+	return &split, mints
+}
+
+// MergeRateLimit adds limits and recent mints split from another allowance. The lower cap applies when both limit a
+// denomination, and the longer of the two windows is kept.
+func (a *Allowance) MergeRateLimit(limit *MintRateLimit, mints []MintRecord) {
+	if limit == nil {
+		return
+	}
+
+	if a.RateLimit == nil {
+		a.RateLimit = &MintRateLimit{}
+	}
+	a.RateLimit.MaxPerTx = minCoins(a.RateLimit.MaxPerTx, limit.MaxPerTx)
+	a.RateLimit.MaxPerWindow = minCoins(a.RateLimit.MaxPerWindow, limit.MaxPerWindow)
+	if limit.Window > a.RateLimit.Window {
+		a.RateLimit.Window = limit.Window
+	}
+
+	merged := append(append([]MintRecord{}, a.RecentMints...), mints...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Time.Before(merged[j].Time)
+	})
+
+	a.RecentMints = make([]MintRecord, 0, len(merged))
+	for _, r := range merged {
+		if n := len(a.RecentMints); n > 0 && a.RecentMints[n-1].Time.Equal(r.Time) {
+			a.RecentMints[n-1].Amount = a.RecentMints[n-1].Amount.Add(r.Amount...)
+			continue
+		}
+		a.RecentMints = append(a.RecentMints, r)
+	}
+}
+
+func coinsWithout(coins sdk.Coins, denom string) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, c := range coins {
+		if c.Denom != denom {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// minCoins combines the coins, taking the lower amount of denominations present in both.
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	res := a
+	for _, c := range b {
+		if existing := a.AmountOf(c.Denom); existing.IsPositive() {
+			if c.Amount.LT(existing) {
+				res = coinsWithout(res, c.Denom).Add(c)
+			}
+			continue
+		}
+		res = res.Add(c)
+	}
+	return res
+}
+
+func (l MintRateLimit) Validate() error {
+	if err := l.MaxPerTx.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "max per transaction")
+	}
+	if err := l.MaxPerWindow.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "max per window")
+	}
+	if l.Window < 0 || (l.Window == 0 && !l.MaxPerWindow.Empty()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid rate limit window: %s", l.Window)
+	}
+	return nil
+}
+
+func (l MintRateLimit) IsEmpty() bool {
+	return l.MaxPerTx.Empty() && l.MaxPerWindow.Empty()
+}
+
+// Denoms returns the denominations that are limited.
+func (l MintRateLimit) Denoms() []string {
+	var res []string
+	for _, c := range l.MaxPerTx.Add(l.MaxPerWindow...) {
+		res = append(res, c.Denom)
+	}
+	return res
+}
+
 func (acc *LiquidityProviderAccount) IncreaseMintableAmount(increase sdk.Coins) {
 	acc.Mintable = acc.Mintable.Add(increase...)
 }
@@ -52,8 +273,9 @@ func (acc *LiquidityProviderAccount) DecreaseMintableAmount(decrease sdk.Coins)
 func (acc LiquidityProviderAccount) String() string {
 	return fmt.Sprintf(`Account:
   Address:       %s
-  Mintable:      %s`,
-		acc.Address, acc.Mintable)
+  Mintable:      %s
+  Allowances:    %v`,
+		acc.Address, acc.Mintable, acc.Allowances)
 }
 
 func (acc *LiquidityProviderAccount) GetAccAddress() (sdk.AccAddress, error) {
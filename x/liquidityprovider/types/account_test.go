@@ -6,6 +6,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -31,6 +32,37 @@ func TestBasic(t *testing.T) {
 	assert.Equal(t, sdk.NewInt(400), lpAcc.Mintable.AmountOf("ejpy"))
 }
 
+func TestRecordMint(t *testing.T) {
+	var (
+		now       = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		allowance = Allowance{
+			Denoms: []string{"eeur"},
+			RateLimit: &MintRateLimit{
+				MaxPerTx:     MustParseCoins("100eeur"),
+				MaxPerWindow: MustParseCoins("250eeur"),
+				Window:       time.Hour,
+			},
+		}
+	)
+
+	require.ErrorIs(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(101)), now), ErrMintRateLimitExceeded)
+
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(100)), now))
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(100)), now))
+	require.Len(t, allowance.RecentMints, 1)
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(50)), now.Add(30*time.Minute)))
+	require.ErrorIs(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(1)), now.Add(59*time.Minute)), ErrMintRateLimitExceeded)
+
+	// Mints leave the rolling window after its duration
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(100)), now.Add(time.Hour)))
+	require.Len(t, allowance.RecentMints, 2)
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(100)), now.Add(time.Hour)))
+	require.ErrorIs(t, allowance.RecordMint(sdk.NewCoin("eeur", sdk.NewInt(1)), now.Add(time.Hour)), ErrMintRateLimitExceeded)
+
+	// Denominations without limits are unrestricted
+	require.NoError(t, allowance.RecordMint(sdk.NewCoin("ejpy", sdk.NewInt(1000000)), now.Add(time.Hour)))
+}
+
 func TestDecreaseMintable(t *testing.T) {
 	priv := ed25519.GenPrivKey()
 	addr := sdk.AccAddress(priv.PubKey().Address())
@@ -24,12 +24,18 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintTokens{}, "e-money/MsgMintTokens", nil)
 	cdc.RegisterConcrete(&MsgBurnTokens{}, "e-money/MsgBurnTokens", nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "e-money/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgFulfillRedemption{}, "e-money/MsgFulfillRedemption", nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, "e-money/MsgRejectRedemption", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintTokens{},
 		&MsgBurnTokens{},
+		&MsgRequestRedemption{},
+		&MsgFulfillRedemption{},
+		&MsgRejectRedemption{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -8,4 +8,12 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var ErrAccountDoesNotExist = sdkerrors.Register(ModuleName, 1, "account does not exist")
+var (
+	ErrAccountDoesNotExist   = sdkerrors.Register(ModuleName, 1, "account does not exist")
+	ErrAllowanceExpired      = sdkerrors.Register(ModuleName, 2, "allowance expired")
+	ErrInsufficientMintable  = sdkerrors.Register(ModuleName, 3, "insufficient mintable amount")
+	ErrMintRateLimitExceeded = sdkerrors.Register(ModuleName, 4, "mint rate limit exceeded")
+	ErrRedemptionNotFound    = sdkerrors.Register(ModuleName, 5, "redemption not found")
+	ErrRedemptionResolved    = sdkerrors.Register(ModuleName, 6, "redemption already resolved")
+	ErrInvalidBankDetails    = sdkerrors.Register(ModuleName, 7, "invalid bank details hash")
+)
@@ -0,0 +1,19 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+// Liquidity provider module event types
+const (
+	EventTypeRedemptionRequested = "redemption_requested"
+	EventTypeRedemptionFulfilled = "redemption_fulfilled"
+	EventTypeRedemptionRejected  = "redemption_rejected"
+
+	AttributeKeyRedemptionID      = "redemption_id"
+	AttributeKeyOwner             = "owner"
+	AttributeKeyLiquidityProvider = "liquidity_provider"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyResolvedBy        = "resolved_by"
+	AttributeKeyMemo              = "memo"
+)
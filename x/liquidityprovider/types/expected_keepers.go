@@ -15,3 +15,8 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// SupplyCaps limits the amounts liquidity providers can mint.
+type SupplyCaps interface {
+	ValidateMint(ctx sdk.Context, amount sdk.Coins) error
+}
@@ -26,7 +26,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Accounts []GenesisAcc `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts" yaml:"accounts"`
+	Accounts         []GenesisAcc `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts" yaml:"accounts"`
+	Redemptions      []Redemption `protobuf:"bytes,2,rep,name=redemptions,proto3" json:"redemptions" yaml:"redemptions"`
+	NextRedemptionId uint64       `protobuf:"varint,3,opt,name=next_redemption_id,json=nextRedemptionId,proto3" json:"next_redemption_id,omitempty" yaml:"next_redemption_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,9 +71,24 @@ func (m *GenesisState) GetAccounts() []GenesisAcc {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRedemptionId() uint64 {
+	if m != nil {
+		return m.NextRedemptionId
+	}
+	return 0
+}
+
 type GenesisAcc struct {
-	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
-	Mintable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	Address    string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Mintable   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	Allowances []Allowance                              `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances" yaml:"allowances"`
 }
 
 func (m *GenesisAcc) Reset()         { *m = GenesisAcc{} }
@@ -121,6 +138,13 @@ func (m *GenesisAcc) GetMintable() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *GenesisAcc) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.liquidityprovider.v1.GenesisState")
 	proto.RegisterType((*GenesisAcc)(nil), "em.liquidityprovider.v1.GenesisAcc")
@@ -131,29 +155,36 @@ func init() {
 }
 
 var fileDescriptor_9c3178f2f43e8df2 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0x7b, 0xe1, 0xde, 0x3a, 0x8a, 0x42, 0x10, 0x5a, 0xbb, 0x98, 0x48, 0x44, 0xe8,
-	0xc2, 0xce, 0x10, 0x05, 0x17, 0xee, 0x4c, 0x17, 0x6e, 0xa5, 0x6e, 0xc4, 0xdd, 0x64, 0x72, 0x48,
-	0x07, 0x33, 0x99, 0x9a, 0x99, 0x06, 0xe3, 0x53, 0xf8, 0x1c, 0x3e, 0x80, 0xcf, 0xd0, 0x65, 0x97,
-	0xae, 0xaa, 0xb4, 0x6f, 0xd0, 0x27, 0x90, 0x26, 0x69, 0x55, 0xd4, 0x55, 0x02, 0xe7, 0xff, 0xbf,
-	0xff, 0xfc, 0x67, 0xd0, 0x21, 0x48, 0x9a, 0x88, 0xbb, 0x91, 0x88, 0x84, 0x29, 0x86, 0x99, 0xca,
-	0x45, 0x04, 0x19, 0xcd, 0x7d, 0x1a, 0x43, 0x0a, 0x5a, 0x68, 0x32, 0xcc, 0x94, 0x51, 0x4e, 0x13,
-	0x24, 0xf9, 0x26, 0x23, 0xb9, 0xdf, 0xde, 0x8d, 0x55, 0xac, 0x4a, 0x0d, 0x5d, 0xfe, 0x55, 0xf2,
-	0x36, 0xe6, 0x4a, 0x4b, 0xa5, 0x69, 0xc8, 0x34, 0xd0, 0xdc, 0x0f, 0xc1, 0x30, 0x9f, 0x72, 0x25,
-	0xd2, 0x6a, 0xee, 0x0d, 0xd0, 0xd6, 0x45, 0xc5, 0xbf, 0x32, 0xcc, 0x80, 0x73, 0x8d, 0x1a, 0x8c,
-	0x73, 0x35, 0x4a, 0x8d, 0x6e, 0xd9, 0xfb, 0x7f, 0x3b, 0x9b, 0xc7, 0x07, 0xe4, 0x97, 0x44, 0x52,
-	0x1b, 0xcf, 0x39, 0x0f, 0x9a, 0xe3, 0xa9, 0x6b, 0x2d, 0xa6, 0xee, 0x4e, 0xc1, 0x64, 0x72, 0xe6,
-	0xad, 0x10, 0x5e, 0x7f, 0x4d, 0xf3, 0x9e, 0x6d, 0x84, 0x3e, 0x1c, 0xce, 0x11, 0xfa, 0xcf, 0xa2,
-	0x28, 0x03, 0xbd, 0xcc, 0xb1, 0x3b, 0x1b, 0x81, 0xb3, 0x98, 0xba, 0xdb, 0xb5, 0xbd, 0x1a, 0x78,
-	0xfd, 0x95, 0xc4, 0x79, 0x40, 0x0d, 0x29, 0x52, 0xc3, 0xc2, 0x04, 0x5a, 0x7f, 0xca, 0xb5, 0xf6,
-	0x48, 0xd5, 0x8c, 0x2c, 0x9b, 0x91, 0xba, 0x19, 0xe9, 0x29, 0x91, 0x06, 0xbd, 0xaf, 0xcb, 0xac,
-	0x8c, 0xde, 0xd3, 0xab, 0xdb, 0x89, 0x85, 0x19, 0x8c, 0x42, 0xc2, 0x95, 0xa4, 0xf5, 0x65, 0xaa,
-	0x4f, 0x57, 0x47, 0xb7, 0xd4, 0x14, 0x43, 0xd0, 0x25, 0x43, 0xf7, 0xd7, 0x79, 0xc1, 0xe5, 0x78,
-	0x86, 0xed, 0xc9, 0x0c, 0xdb, 0x6f, 0x33, 0x6c, 0x3f, 0xce, 0xb1, 0x35, 0x99, 0x63, 0xeb, 0x65,
-	0x8e, 0xad, 0x9b, 0xd3, 0x4f, 0x34, 0xe8, 0x4a, 0x95, 0x42, 0x41, 0x41, 0x76, 0x13, 0x88, 0x62,
-	0xc8, 0xe8, 0xfd, 0x0f, 0xcf, 0x59, 0x26, 0x84, 0xff, 0xca, 0xdb, 0x9f, 0xbc, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x54, 0xaf, 0x1b, 0xf3, 0x01, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xaa, 0x13, 0x31,
+	0x14, 0xc6, 0x3b, 0xad, 0xe8, 0x35, 0x57, 0xfc, 0x13, 0x84, 0xdb, 0x16, 0x9c, 0xb9, 0x44, 0x84,
+	0x2e, 0x6c, 0x42, 0x15, 0x5c, 0xb8, 0xbb, 0x73, 0x17, 0x22, 0x6e, 0x64, 0xdc, 0x88, 0x20, 0x97,
+	0xcc, 0xe4, 0x30, 0x06, 0x27, 0x49, 0x9d, 0xa4, 0xf5, 0xd6, 0xa7, 0x70, 0x23, 0xf8, 0x0c, 0x3e,
+	0xc9, 0x5d, 0x76, 0xe9, 0xaa, 0x4a, 0xfb, 0x06, 0x7d, 0x02, 0xe9, 0x4c, 0xfa, 0x47, 0x6a, 0x71,
+	0x35, 0x43, 0xf2, 0x9d, 0xdf, 0x77, 0xbe, 0x73, 0x82, 0x1e, 0x81, 0x62, 0x85, 0xfc, 0x34, 0x92,
+	0x42, 0xba, 0xc9, 0xb0, 0x34, 0x63, 0x29, 0xa0, 0x64, 0xe3, 0x01, 0xcb, 0x41, 0x83, 0x95, 0x96,
+	0x0e, 0x4b, 0xe3, 0x0c, 0x3e, 0x01, 0x45, 0xf7, 0x64, 0x74, 0x3c, 0xe8, 0xde, 0xcf, 0x4d, 0x6e,
+	0x2a, 0x0d, 0x5b, 0xfd, 0xd5, 0xf2, 0x6e, 0x98, 0x19, 0xab, 0x8c, 0x65, 0x29, 0xb7, 0xc0, 0xc6,
+	0x83, 0x14, 0x1c, 0x1f, 0xb0, 0xcc, 0x48, 0xed, 0xef, 0xd9, 0x21, 0xd7, 0x7d, 0x8f, 0xaa, 0x80,
+	0x7c, 0x6f, 0xa2, 0x5b, 0x2f, 0xea, 0x8e, 0xde, 0x38, 0xee, 0x00, 0xbf, 0x45, 0x47, 0x3c, 0xcb,
+	0xcc, 0x48, 0x3b, 0xdb, 0x0e, 0x4e, 0x5b, 0xbd, 0xe3, 0x27, 0x0f, 0xe9, 0x81, 0x1e, 0xa9, 0x2f,
+	0x3c, 0xcb, 0xb2, 0xf8, 0xe4, 0x6a, 0x16, 0x35, 0x96, 0xb3, 0xe8, 0xce, 0x84, 0xab, 0xe2, 0x39,
+	0x59, 0x23, 0x48, 0xb2, 0xa1, 0x61, 0x8e, 0x8e, 0x4b, 0x10, 0xa0, 0x86, 0x4e, 0x1a, 0x6d, 0xdb,
+	0xcd, 0xff, 0xc0, 0x93, 0x8d, 0x36, 0xee, 0x7a, 0x38, 0xae, 0xe1, 0x3b, 0x14, 0x92, 0xec, 0x32,
+	0xf1, 0x2b, 0x84, 0x35, 0x5c, 0xba, 0x8b, 0xed, 0xd9, 0x85, 0x14, 0xed, 0xd6, 0x69, 0xd0, 0xbb,
+	0x16, 0x3f, 0x58, 0xce, 0xa2, 0x4e, 0x0d, 0xd8, 0xd7, 0x90, 0xe4, 0xee, 0xea, 0x70, 0xeb, 0xf9,
+	0x52, 0x90, 0x6f, 0x4d, 0x84, 0xb6, 0x09, 0xf1, 0x63, 0x74, 0x83, 0x0b, 0x51, 0x82, 0x5d, 0xcd,
+	0x25, 0xe8, 0xdd, 0x8c, 0xf1, 0x72, 0x16, 0xdd, 0xf6, 0x71, 0xeb, 0x0b, 0x92, 0xac, 0x25, 0xf8,
+	0x0b, 0x3a, 0x52, 0x52, 0x3b, 0x9e, 0x16, 0xe0, 0x93, 0x76, 0x68, 0xbd, 0x3b, 0xba, 0xda, 0x1d,
+	0xf5, 0xbb, 0xa3, 0xe7, 0x46, 0xea, 0xf8, 0xfc, 0xef, 0xe1, 0xad, 0x0b, 0xc9, 0x8f, 0x5f, 0x51,
+	0x2f, 0x97, 0xee, 0xc3, 0x28, 0xa5, 0x99, 0x51, 0xcc, 0xef, 0xbe, 0xfe, 0xf4, 0xad, 0xf8, 0xc8,
+	0xdc, 0x64, 0x08, 0xb6, 0x62, 0xd8, 0x64, 0xe3, 0x87, 0xdf, 0x23, 0xc4, 0x8b, 0xc2, 0x7c, 0xe6,
+	0x3a, 0x03, 0xdb, 0x6e, 0x55, 0xee, 0xe4, 0xe0, 0x9c, 0xcf, 0xd6, 0xd2, 0xb8, 0xe3, 0xdb, 0xb8,
+	0xe7, 0x43, 0x6d, 0x18, 0x24, 0xd9, 0x01, 0xc6, 0xaf, 0xaf, 0xe6, 0x61, 0x30, 0x9d, 0x87, 0xc1,
+	0xef, 0x79, 0x18, 0x7c, 0x5d, 0x84, 0x8d, 0xe9, 0x22, 0x6c, 0xfc, 0x5c, 0x84, 0x8d, 0x77, 0xcf,
+	0x76, 0x9a, 0x85, 0xbe, 0x32, 0x1a, 0x26, 0x0c, 0x54, 0xbf, 0x00, 0x91, 0x43, 0xc9, 0x2e, 0xff,
+	0xf1, 0x32, 0xab, 0x00, 0xe9, 0xf5, 0xea, 0x2d, 0x3e, 0xfd, 0x33, 0x00, 0x14, 0x03, 0x31, 0xc7,
+	0x34, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +207,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRedemptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedemptionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -213,6 +263,20 @@ func (m *GenesisAcc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Mintable) > 0 {
 		for iNdEx := len(m.Mintable) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -260,6 +324,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedemptionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRedemptionId))
+	}
 	return n
 }
 
@@ -279,6 +352,12 @@ func (m *GenesisAcc) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -351,6 +430,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedemptionId", wireType)
+			}
+			m.NextRedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -467,6 +599,40 @@ func (m *GenesisAcc) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
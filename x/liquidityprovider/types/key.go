@@ -4,6 +4,8 @@
 
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName   = "liquidityprovider"
 	QuerierRoute = ModuleName
@@ -16,4 +18,10 @@ var (
 	ProviderKeyPrefix = []byte{0x00}
 	// Perhaps needed for future access
 	// MintableKeyPrefix   = []byte{0x01}
+	RedemptionKeyPrefix = []byte{0x02}
+	NextRedemptionIDKey = []byte{0x03}
 )
+
+func GetRedemptionKey(id uint64) []byte {
+	return append(RedemptionKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
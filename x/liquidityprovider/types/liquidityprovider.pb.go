@@ -10,16 +10,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +32,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type RedemptionStatus int32
+
+const (
+	RedemptionStatus_Pending   RedemptionStatus = 0
+	RedemptionStatus_Fulfilled RedemptionStatus = 1
+	RedemptionStatus_Rejected  RedemptionStatus = 2
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_PENDING",
+	1: "REDEMPTION_STATUS_FULFILLED",
+	2: "REDEMPTION_STATUS_REJECTED",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_PENDING":   0,
+	"REDEMPTION_STATUS_FULFILLED": 1,
+	"REDEMPTION_STATUS_REJECTED":  2,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_90aea87a4022d1af, []int{0}
+}
+
 type LiquidityProviderAccount struct {
 	// Any string address representation with the accompanying supporting encoding
 	// and validation functions starting with bech32. However, in the
@@ -35,6 +68,9 @@ type LiquidityProviderAccount struct {
 	// fit nicely with this loosely defined provider identity specifier.
 	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Mintable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	// Mintable amounts attributed to the issuers that granted them. Any part of
+	// mintable not covered by an allowance predates per-issuer tracking.
+	Allowances []Allowance `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances" yaml:"allowances"`
 }
 
 func (m *LiquidityProviderAccount) Reset()      { *m = LiquidityProviderAccount{} }
@@ -69,8 +105,351 @@ func (m *LiquidityProviderAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidityProviderAccount proto.InternalMessageInfo
 
+// Allowance is the mintable amount granted to a liquidity provider by a single
+// issuer.
+type Allowance struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty" yaml:"issuer"`
+	// Denominations covered by the allowance. Burned tokens of these
+	// denominations are credited back to the allowance.
+	Denoms    []string                                 `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	Mintable  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	GrantedAt time.Time                                `protobuf:"bytes,4,opt,name=granted_at,json=grantedAt,proto3,stdtime" json:"granted_at" yaml:"granted_at"`
+	// Optional point in time after which the allowance can no longer be used
+	// for minting.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+	// Optional limits on how fast the allowance can be minted.
+	RateLimit *MintRateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty" yaml:"rate_limit"`
+	// Mints within the current rate limit window.
+	RecentMints []MintRecord `protobuf:"bytes,7,rep,name=recent_mints,json=recentMints,proto3" json:"recent_mints" yaml:"recent_mints"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90aea87a4022d1af, []int{1}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+func (m *Allowance) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Allowance) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *Allowance) GetMintable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Mintable
+	}
+	return nil
+}
+
+func (m *Allowance) GetGrantedAt() time.Time {
+	if m != nil {
+		return m.GrantedAt
+	}
+	return time.Time{}
+}
+
+func (m *Allowance) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Allowance) GetRateLimit() *MintRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *Allowance) GetRecentMints() []MintRecord {
+	if m != nil {
+		return m.RecentMints
+	}
+	return nil
+}
+
+// MintRateLimit caps the amounts a liquidity provider can mint from an
+// allowance. Denominations without a cap are unlimited.
+type MintRateLimit struct {
+	MaxPerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_per_tx,json=maxPerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_per_tx" yaml:"max_per_tx"`
+	// Maximum amount minted within any window of the given duration.
+	MaxPerWindow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_per_window,json=maxPerWindow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_per_window" yaml:"max_per_window"`
+	Window       time.Duration                            `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90aea87a4022d1af, []int{2}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetMaxPerTx() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxPerTx
+	}
+	return nil
+}
+
+func (m *MintRateLimit) GetMaxPerWindow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxPerWindow
+	}
+	return nil
+}
+
+func (m *MintRateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type MintRecord struct {
+	Time   time.Time                                `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90aea87a4022d1af, []int{3}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Redemption is a request to exchange tokens for an off-chain payout by a
+// liquidity provider. The tokens are held in escrow until the request is
+// fulfilled or rejected.
+type Redemption struct {
+	ID                uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Owner             string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LiquidityProvider string     `protobuf:"bytes,3,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider,omitempty" yaml:"liquidity_provider"`
+	Amount            types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// Hex encoded SHA-256 hash of the bank details the payout is made to. The
+	// details themselves are exchanged off-chain.
+	BankDetailsHash string           `protobuf:"bytes,5,opt,name=bank_details_hash,json=bankDetailsHash,proto3" json:"bank_details_hash,omitempty" yaml:"bank_details_hash"`
+	Status          RedemptionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=em.liquidityprovider.v1.RedemptionStatus" json:"status,omitempty" yaml:"status"`
+	RequestedAt     time.Time        `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3,stdtime" json:"requested_at" yaml:"requested_at"`
+	ResolvedAt      *time.Time       `protobuf:"bytes,8,opt,name=resolved_at,json=resolvedAt,proto3,stdtime" json:"resolved_at,omitempty" yaml:"resolved_at"`
+	// Account that fulfilled or rejected the request.
+	ResolvedBy string `protobuf:"bytes,9,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty" yaml:"resolved_by"`
+	// Payout reference of a fulfilled request or reason for a rejected one.
+	Memo string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90aea87a4022d1af, []int{4}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Redemption) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Redemption) GetLiquidityProvider() string {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return ""
+}
+
+func (m *Redemption) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Redemption) GetBankDetailsHash() string {
+	if m != nil {
+		return m.BankDetailsHash
+	}
+	return ""
+}
+
+func (m *Redemption) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RedemptionStatus_Pending
+}
+
+func (m *Redemption) GetRequestedAt() time.Time {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return time.Time{}
+}
+
+func (m *Redemption) GetResolvedAt() *time.Time {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return nil
+}
+
+func (m *Redemption) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return ""
+}
+
+func (m *Redemption) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("em.liquidityprovider.v1.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
 	proto.RegisterType((*LiquidityProviderAccount)(nil), "em.liquidityprovider.v1.LiquidityProviderAccount")
+	proto.RegisterType((*Allowance)(nil), "em.liquidityprovider.v1.Allowance")
+	proto.RegisterType((*MintRateLimit)(nil), "em.liquidityprovider.v1.MintRateLimit")
+	proto.RegisterType((*MintRecord)(nil), "em.liquidityprovider.v1.MintRecord")
+	proto.RegisterType((*Redemption)(nil), "em.liquidityprovider.v1.Redemption")
 }
 
 func init() {
@@ -78,29 +457,77 @@ func init() {
 }
 
 var fileDescriptor_90aea87a4022d1af = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbd, 0x4e, 0xfb, 0x30,
-	0x14, 0xc5, 0x93, 0xff, 0x5f, 0xe2, 0x23, 0x20, 0x90, 0x22, 0x24, 0xda, 0x0e, 0x71, 0x95, 0xa9,
-	0x03, 0x8d, 0x15, 0x90, 0x18, 0xba, 0xd1, 0xae, 0x0c, 0x55, 0x47, 0x16, 0x94, 0x8f, 0x4b, 0xb0,
-	0x88, 0x73, 0x4b, 0xec, 0x44, 0x84, 0x27, 0x60, 0x64, 0x64, 0xec, 0xcc, 0x93, 0x74, 0xac, 0x98,
-	0x98, 0x0a, 0x6a, 0x17, 0xe6, 0x3e, 0x01, 0x6a, 0xe2, 0x20, 0xa4, 0x32, 0xd9, 0xd7, 0xe7, 0xdc,
-	0x73, 0x7f, 0xb6, 0x0d, 0x0a, 0x9c, 0xc6, 0xec, 0x3e, 0x63, 0x21, 0x93, 0xc5, 0x38, 0xc5, 0x9c,
-	0x85, 0x90, 0xd2, 0xdc, 0xdd, 0x3c, 0x74, 0xc6, 0x29, 0x4a, 0x34, 0x8f, 0x81, 0x3b, 0x9b, 0x5a,
-	0xee, 0xb6, 0x8e, 0x22, 0x8c, 0xb0, 0xf4, 0xd0, 0xf5, 0xae, 0xb2, 0xb7, 0x9a, 0x01, 0x0a, 0x8e,
-	0xe2, 0xba, 0x12, 0xaa, 0x42, 0x49, 0x56, 0x55, 0x51, 0xdf, 0x13, 0x40, 0x73, 0xd7, 0x07, 0xe9,
-	0xb9, 0x34, 0x40, 0x96, 0xd4, 0xad, 0x11, 0x62, 0x14, 0x03, 0x2d, 0x2b, 0x3f, 0xbb, 0xa1, 0x5e,
-	0x52, 0x54, 0x92, 0xfd, 0xa6, 0x1b, 0x8d, 0xcb, 0x1a, 0x62, 0xa8, 0x20, 0x2e, 0x82, 0x00, 0xb3,
-	0x44, 0x9a, 0x27, 0xc6, 0xb6, 0x17, 0x86, 0x29, 0x08, 0xd1, 0xd0, 0xdb, 0x7a, 0x67, 0xb7, 0x6f,
-	0xae, 0xe6, 0xe4, 0xa0, 0xf0, 0x78, 0xdc, 0xb3, 0x95, 0x60, 0x8f, 0x6a, 0x8b, 0xf9, 0x68, 0xec,
-	0x70, 0x96, 0x48, 0xcf, 0x8f, 0xa1, 0xf1, 0xaf, 0xfd, 0xbf, 0xb3, 0x77, 0xda, 0x74, 0x14, 0xe6,
-	0x1a, 0xcc, 0x51, 0x60, 0xce, 0x00, 0x59, 0xd2, 0x1f, 0x4c, 0xe7, 0x44, 0x5b, 0xcd, 0xc9, 0x61,
-	0x95, 0x56, 0x37, 0xda, 0xaf, 0x1f, 0xa4, 0x13, 0x31, 0x79, 0x9b, 0xf9, 0x4e, 0x80, 0x5c, 0x5d,
-	0x53, 0x2d, 0x5d, 0x11, 0xde, 0x51, 0x59, 0x8c, 0x41, 0x94, 0x19, 0x62, 0xf4, 0x33, 0xaf, 0xb7,
-	0xff, 0x34, 0x21, 0xda, 0xcb, 0x84, 0x68, 0x5f, 0x13, 0xa2, 0xf5, 0x87, 0xd3, 0x85, 0xa5, 0xcf,
-	0x16, 0x96, 0xfe, 0xb9, 0xb0, 0xf4, 0xe7, 0xa5, 0xa5, 0xcd, 0x96, 0x96, 0xf6, 0xbe, 0xb4, 0xb4,
-	0xab, 0xf3, 0x5f, 0xd9, 0xd0, 0xe5, 0x98, 0x40, 0x41, 0x81, 0x77, 0x63, 0x08, 0x23, 0x48, 0xe9,
-	0xc3, 0x1f, 0x1f, 0x58, 0xce, 0xf3, 0xb7, 0xca, 0xd7, 0x3a, 0xfb, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0xb8, 0xaa, 0x64, 0xe5, 0x01, 0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1a, 0xc7,
+	0x1b, 0x66, 0x31, 0xc6, 0x66, 0xf0, 0xdf, 0xc9, 0xcf, 0xbf, 0x2c, 0xa4, 0x61, 0xd1, 0x5a, 0x8a,
+	0x9c, 0x28, 0xde, 0x95, 0x5d, 0xa9, 0x95, 0x72, 0x03, 0x83, 0x63, 0x57, 0xd8, 0x45, 0x6b, 0xa2,
+	0x54, 0x55, 0x5b, 0xb4, 0xb0, 0x13, 0xbc, 0xcd, 0xee, 0x0e, 0xd9, 0x19, 0x6c, 0xe8, 0xa1, 0xe7,
+	0xca, 0xa7, 0x1c, 0x73, 0xb1, 0x64, 0x55, 0x3d, 0x44, 0xbd, 0xf5, 0x5b, 0x44, 0xea, 0xc5, 0xc7,
+	0x9e, 0x48, 0x65, 0x5f, 0xaa, 0x1e, 0xf9, 0x04, 0xd5, 0xce, 0xcc, 0xb2, 0xc6, 0xd8, 0x45, 0xbe,
+	0xf4, 0x04, 0xf3, 0xce, 0xf3, 0x3c, 0x33, 0xef, 0xf3, 0xbe, 0xf3, 0x02, 0xd0, 0x91, 0xab, 0x3b,
+	0xf6, 0x9b, 0x8e, 0x6d, 0xd9, 0xb4, 0xd7, 0xf6, 0xf1, 0x91, 0x6d, 0x21, 0x5f, 0x3f, 0xda, 0x18,
+	0x0f, 0x6a, 0x6d, 0x1f, 0x53, 0x0c, 0xef, 0x23, 0x57, 0x1b, 0xdf, 0x3b, 0xda, 0xc8, 0xfe, 0xaf,
+	0x85, 0x5b, 0x98, 0x61, 0xf4, 0xe0, 0x1b, 0x87, 0x67, 0x33, 0x4d, 0x4c, 0x5c, 0x4c, 0xea, 0x7c,
+	0x83, 0x2f, 0xc4, 0x56, 0x8e, 0xaf, 0xf4, 0x86, 0x49, 0x90, 0x7e, 0xb4, 0xd1, 0x40, 0xd4, 0xdc,
+	0xd0, 0x9b, 0xd8, 0xf6, 0x42, 0x6a, 0x0b, 0xe3, 0x96, 0x83, 0x74, 0xb6, 0x6a, 0x74, 0x5e, 0xe9,
+	0xa6, 0xd7, 0x13, 0x5b, 0xca, 0xf5, 0x2d, 0x6a, 0xbb, 0x88, 0x50, 0xd3, 0x6d, 0x87, 0xda, 0xd7,
+	0x01, 0x56, 0xc7, 0x37, 0xa9, 0x8d, 0x85, 0xb6, 0xfa, 0x5b, 0x1c, 0xc8, 0x95, 0x30, 0x8b, 0xaa,
+	0xc8, 0xa2, 0xd0, 0x6c, 0xe2, 0x8e, 0x47, 0xe1, 0x53, 0x30, 0x63, 0x5a, 0x96, 0x8f, 0x08, 0x91,
+	0xa5, 0xbc, 0xb4, 0x96, 0x2a, 0xc2, 0x41, 0x5f, 0x59, 0xe8, 0x99, 0xae, 0xf3, 0x4c, 0x15, 0x1b,
+	0xaa, 0x11, 0x42, 0xe0, 0x0f, 0x60, 0xd6, 0xb5, 0x3d, 0x6a, 0x36, 0x1c, 0x24, 0xc7, 0xf3, 0x53,
+	0x6b, 0xe9, 0xcd, 0x8c, 0x26, 0xf2, 0x0c, 0x32, 0xd3, 0x44, 0x66, 0xda, 0x16, 0xb6, 0xbd, 0xe2,
+	0xd6, 0x87, 0xbe, 0x12, 0x1b, 0xf4, 0x95, 0x45, 0xae, 0x16, 0x12, 0xd5, 0x5f, 0x3f, 0x2a, 0x6b,
+	0x2d, 0x9b, 0x1e, 0x76, 0x1a, 0x5a, 0x13, 0xbb, 0xc2, 0x27, 0xf1, 0xb1, 0x4e, 0xac, 0xd7, 0x3a,
+	0xed, 0xb5, 0x11, 0x61, 0x1a, 0xc4, 0x18, 0x9e, 0x07, 0xbf, 0x05, 0xc0, 0x74, 0x1c, 0x7c, 0x6c,
+	0x7a, 0x4d, 0x44, 0xe4, 0x29, 0x76, 0xba, 0xaa, 0xdd, 0x52, 0x21, 0xad, 0x10, 0x42, 0x8b, 0x19,
+	0x71, 0x8d, 0x65, 0x91, 0xd4, 0x50, 0x43, 0x35, 0xae, 0x08, 0x3e, 0x9b, 0xfb, 0xe9, 0x4c, 0x89,
+	0xbd, 0x3b, 0x53, 0x62, 0x7f, 0x9d, 0x29, 0x31, 0xf5, 0x3c, 0x01, 0x52, 0x43, 0x09, 0xf8, 0x18,
+	0x24, 0x6d, 0x42, 0x3a, 0xc8, 0x17, 0x1e, 0x2d, 0x0f, 0xfa, 0xca, 0x3c, 0x97, 0xe3, 0x71, 0xd5,
+	0x10, 0x80, 0x00, 0x6a, 0x21, 0x0f, 0xbb, 0x84, 0xf9, 0x33, 0x02, 0xe5, 0x71, 0xd5, 0x10, 0x80,
+	0x11, 0x33, 0xa7, 0xfe, 0x63, 0x33, 0xbf, 0x02, 0xa0, 0xe5, 0x9b, 0x1e, 0x45, 0x56, 0xdd, 0xa4,
+	0x72, 0x22, 0x2f, 0xad, 0xa5, 0x37, 0xb3, 0x1a, 0x6f, 0x24, 0x2d, 0x6c, 0x24, 0xad, 0x16, 0x76,
+	0x5a, 0xf1, 0xe1, 0xa8, 0x89, 0x11, 0x57, 0x7d, 0xfb, 0x51, 0x91, 0x8c, 0x94, 0x08, 0x14, 0x28,
+	0xac, 0x01, 0x80, 0xba, 0x6d, 0xdb, 0x47, 0x24, 0x50, 0x9e, 0x9e, 0xa8, 0x9c, 0x89, 0x54, 0x23,
+	0x9e, 0x50, 0x15, 0x81, 0x02, 0x85, 0xdf, 0x00, 0xe0, 0x9b, 0x14, 0xd5, 0x1d, 0xdb, 0xb5, 0xa9,
+	0x9c, 0x64, 0xaa, 0x8f, 0x6e, 0x2d, 0xfe, 0x9e, 0xed, 0x51, 0xc3, 0xa4, 0xa8, 0x12, 0xa0, 0x8b,
+	0x2b, 0xd1, 0x09, 0x91, 0x86, 0x6a, 0xa4, 0xfc, 0x10, 0x01, 0x9b, 0x60, 0xce, 0x47, 0x4d, 0xe4,
+	0xd1, 0x7a, 0x60, 0x10, 0x91, 0x67, 0x58, 0x35, 0x56, 0xff, 0x5d, 0x1f, 0x35, 0xb1, 0x6f, 0x15,
+	0x1f, 0x08, 0x63, 0xee, 0x89, 0x03, 0xae, 0xc8, 0xa8, 0x46, 0x9a, 0x2f, 0xf7, 0xd8, 0xea, 0xef,
+	0x38, 0x98, 0x1f, 0xb9, 0x18, 0xfc, 0x11, 0x00, 0xd7, 0xec, 0xd6, 0xdb, 0xc8, 0xaf, 0xd3, 0xae,
+	0x2c, 0x4d, 0x6a, 0x81, 0xf2, 0x68, 0x0d, 0x22, 0xea, 0x5d, 0x9b, 0xc0, 0xec, 0x56, 0x91, 0x5f,
+	0xeb, 0xc2, 0x13, 0x09, 0x2c, 0x84, 0x2a, 0xc7, 0xb6, 0x67, 0xe1, 0xe3, 0xc9, 0x8f, 0x7a, 0x57,
+	0x5c, 0x62, 0x65, 0xf4, 0x12, 0x9c, 0x7e, 0xb7, 0x8b, 0xcc, 0xf1, 0x8b, 0xbc, 0x64, 0x54, 0x58,
+	0x01, 0x49, 0x71, 0x87, 0x29, 0x56, 0xdd, 0xcc, 0x58, 0xcf, 0x94, 0xc4, 0x58, 0x1b, 0xbe, 0x68,
+	0xf1, 0xae, 0xc4, 0xd9, 0xef, 0x82, 0x96, 0x11, 0x1a, 0xea, 0xef, 0x12, 0x00, 0x51, 0x95, 0xe0,
+	0x73, 0x90, 0x08, 0xa6, 0xa6, 0x2c, 0x4d, 0x6c, 0xc7, 0xfb, 0x42, 0x3b, 0xcd, 0xb5, 0x03, 0x16,
+	0x6f, 0x46, 0x26, 0x00, 0x29, 0x48, 0x9a, 0x6e, 0x30, 0x38, 0x27, 0x3b, 0x55, 0x18, 0xbd, 0x25,
+	0xa7, 0xdd, 0xcd, 0x21, 0x71, 0x96, 0xfa, 0x7e, 0x1a, 0x00, 0x03, 0x59, 0xc8, 0x6d, 0x07, 0xf9,
+	0xc3, 0x55, 0x10, 0xb7, 0x2d, 0x96, 0x4b, 0xa2, 0x78, 0xef, 0xa2, 0xaf, 0xc4, 0x77, 0x4b, 0x83,
+	0xbe, 0x92, 0xe2, 0xe7, 0xd8, 0x96, 0x6a, 0xc4, 0x6d, 0x0b, 0x3e, 0x02, 0xd3, 0xf8, 0xd8, 0x43,
+	0xbe, 0x1c, 0x67, 0x23, 0x6b, 0x69, 0xd0, 0x57, 0xe6, 0x38, 0x82, 0x85, 0x55, 0x83, 0x6f, 0xc3,
+	0x0a, 0x80, 0xc3, 0x1e, 0xaf, 0x87, 0x4d, 0xce, 0x6a, 0x90, 0x2a, 0x3e, 0x1c, 0xf4, 0x95, 0x0c,
+	0x27, 0x8d, 0x63, 0x54, 0x63, 0xd9, 0xb9, 0xfe, 0xab, 0x02, 0x77, 0x86, 0xfe, 0x24, 0x44, 0x15,
+	0x6f, 0xf5, 0x67, 0xe5, 0x46, 0x7f, 0xc2, 0x9c, 0xe1, 0x0e, 0x58, 0x6e, 0x98, 0xde, 0xeb, 0xba,
+	0x85, 0xa8, 0x69, 0x3b, 0xa4, 0x7e, 0x68, 0x92, 0x43, 0x36, 0x4e, 0x52, 0xc5, 0x4f, 0x06, 0x7d,
+	0x45, 0xe6, 0xac, 0x31, 0x88, 0x6a, 0x2c, 0x06, 0xb1, 0x12, 0x0f, 0xed, 0x98, 0xe4, 0x10, 0xd6,
+	0x40, 0x92, 0x50, 0x93, 0x76, 0x08, 0x9b, 0x1b, 0x0b, 0x9b, 0x8f, 0x6f, 0x7d, 0xd7, 0x91, 0xc7,
+	0x07, 0x8c, 0x70, 0x75, 0x7a, 0x73, 0x09, 0xd5, 0x10, 0x5a, 0xf0, 0xbb, 0x60, 0x66, 0xbc, 0xe9,
+	0x20, 0x22, 0x66, 0xe8, 0xcc, 0xc4, 0xd6, 0x52, 0xae, 0x8f, 0x8a, 0x88, 0xcd, 0x5b, 0x2c, 0x3d,
+	0x0c, 0x15, 0x28, 0x7c, 0x09, 0xd2, 0x3e, 0x22, 0xd8, 0x39, 0xe2, 0xf2, 0xb3, 0x13, 0xe5, 0xb3,
+	0x83, 0xbe, 0x02, 0x43, 0xe9, 0x21, 0x91, 0x2b, 0x83, 0x30, 0x52, 0xa0, 0xf0, 0xf3, 0x2b, 0xc2,
+	0x8d, 0x9e, 0x9c, 0x62, 0x96, 0xfe, 0xff, 0x06, 0x72, 0xa3, 0xa7, 0x46, 0xc4, 0x62, 0x0f, 0xae,
+	0x82, 0x84, 0x8b, 0x5c, 0x2c, 0x03, 0xc6, 0x58, 0x8c, 0x1e, 0x49, 0x10, 0x55, 0x0d, 0xb6, 0xf9,
+	0xe4, 0x67, 0x09, 0x2c, 0x5d, 0xb7, 0x11, 0x3e, 0x01, 0x19, 0xa3, 0x5c, 0x2a, 0xef, 0x55, 0x6b,
+	0xbb, 0x5f, 0xee, 0xd7, 0x0f, 0x6a, 0x85, 0xda, 0x8b, 0x83, 0x7a, 0xb5, 0xbc, 0x5f, 0xda, 0xdd,
+	0x7f, 0xbe, 0x14, 0xcb, 0xa6, 0x4f, 0x4e, 0xf3, 0x33, 0x55, 0xe4, 0x59, 0xb6, 0xd7, 0x82, 0x1a,
+	0x78, 0x30, 0x8e, 0xdd, 0x7e, 0x51, 0xd9, 0xde, 0xad, 0x54, 0xca, 0xa5, 0x25, 0x29, 0x3b, 0x7f,
+	0x72, 0x9a, 0x4f, 0x6d, 0x77, 0x9c, 0x57, 0xb6, 0xe3, 0x20, 0x0b, 0x3e, 0x05, 0xd9, 0x71, 0xbc,
+	0x51, 0xfe, 0xa2, 0xbc, 0x55, 0x2b, 0x97, 0x96, 0xe2, 0xd9, 0xb9, 0x93, 0xd3, 0xfc, 0xac, 0x81,
+	0xbe, 0x47, 0x4d, 0x8a, 0xac, 0x6c, 0xe2, 0xfd, 0x2f, 0x39, 0xa9, 0x58, 0xfd, 0x70, 0x91, 0x93,
+	0xce, 0x2f, 0x72, 0xd2, 0x9f, 0x17, 0x39, 0xe9, 0xed, 0x65, 0x2e, 0x76, 0x7e, 0x99, 0x8b, 0xfd,
+	0x71, 0x99, 0x8b, 0x7d, 0xfd, 0xd9, 0x95, 0xb7, 0x89, 0xd6, 0x5d, 0xec, 0xa1, 0x9e, 0x8e, 0xdc,
+	0x75, 0x07, 0x59, 0x2d, 0xe4, 0xeb, 0xdd, 0x1b, 0xfe, 0x3e, 0xb2, 0xf7, 0xda, 0x48, 0xb2, 0x82,
+	0x7c, 0xfa, 0xcf, 0x00, 0x6e, 0x0c, 0xa2, 0x2d, 0x63, 0x0a, 0x00, 0x00,
 }
 
 func (m *LiquidityProviderAccount) Marshal() (dAtA []byte, err error) {
@@ -123,6 +550,20 @@ func (m *LiquidityProviderAccount) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Mintable) > 0 {
 		for iNdEx := len(m.Mintable) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -147,42 +588,469 @@ func (m *LiquidityProviderAccount) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidityprovider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidityprovider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *LiquidityProviderAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidityprovider(uint64(l))
+	if len(m.RecentMints) > 0 {
+		for iNdEx := len(m.RecentMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
+	if m.ExpiresAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.GrantedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.GrantedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLiquidityprovider(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Mintable) > 0 {
-		for _, e := range m.Mintable {
-			l = e.Size()
-			n += 1 + l + sovLiquidityprovider(uint64(l))
+		for iNdEx := len(m.Mintable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mintable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovLiquidityprovider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidityprovider(x uint64) (n int) {
-	return sovLiquidityprovider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintLiquidityprovider(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MaxPerWindow) > 0 {
+		for iNdEx := len(m.MaxPerWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxPerWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MaxPerTx) > 0 {
+		for iNdEx := len(m.MaxPerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxPerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLiquidityprovider(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ResolvedAt != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ResolvedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolvedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RequestedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLiquidityprovider(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	if m.Status != 0 {
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BankDetailsHash) > 0 {
+		i -= len(m.BankDetailsHash)
+		copy(dAtA[i:], m.BankDetailsHash)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.BankDetailsHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LiquidityProvider) > 0 {
+		i -= len(m.LiquidityProvider)
+		copy(dAtA[i:], m.LiquidityProvider)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.LiquidityProvider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintLiquidityprovider(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidityprovider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidityprovider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidityProviderAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	if len(m.Mintable) > 0 {
+		for _, e := range m.Mintable {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	if len(m.Mintable) > 0 {
+		for _, e := range m.Mintable {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.GrantedAt)
+	n += 1 + l + sovLiquidityprovider(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	if len(m.RecentMints) > 0 {
+		for _, e := range m.RecentMints {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxPerTx) > 0 {
+		for _, e := range m.MaxPerTx {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	if len(m.MaxPerWindow) > 0 {
+		for _, e := range m.MaxPerWindow {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovLiquidityprovider(uint64(l))
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidityprovider(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLiquidityprovider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLiquidityprovider(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidityprovider(uint64(l))
+	l = len(m.BankDetailsHash)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidityprovider(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedAt)
+	n += 1 + l + sovLiquidityprovider(uint64(l))
+	if m.ResolvedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolvedAt)
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovLiquidityprovider(uint64(l))
+	}
+	return n
+}
+
+func sovLiquidityprovider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidityprovider(x uint64) (n int) {
+	return sovLiquidityprovider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *LiquidityProviderAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -278,6 +1146,945 @@ func (m *LiquidityProviderAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidityprovider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidityprovider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mintable = append(m.Mintable, types.Coin{})
+			if err := m.Mintable[len(m.Mintable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.GrantedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &MintRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentMints = append(m.RecentMints, MintRecord{})
+			if err := m.RecentMints[len(m.RecentMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidityprovider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidityprovider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPerTx = append(m.MaxPerTx, types.Coin{})
+			if err := m.MaxPerTx[len(m.MaxPerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPerWindow = append(m.MaxPerWindow, types.Coin{})
+			if err := m.MaxPerWindow[len(m.MaxPerWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidityprovider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidityprovider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidityprovider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidityprovider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDetailsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDetailsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedAt == nil {
+				m.ResolvedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ResolvedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidityprovider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidityprovider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidityprovider(dAtA[iNdEx:])
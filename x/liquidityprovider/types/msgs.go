@@ -12,6 +12,9 @@ import (
 var (
 	_ sdk.Msg = &MsgMintTokens{}
 	_ sdk.Msg = &MsgBurnTokens{}
+	_ sdk.Msg = &MsgRequestRedemption{}
+	_ sdk.Msg = &MsgFulfillRedemption{}
+	_ sdk.Msg = &MsgRejectRedemption{}
 )
 
 func (msg MsgBurnTokens) Route() string { return RouterKey }
@@ -71,3 +74,83 @@ func (msg MsgMintTokens) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+func (msg MsgRequestRedemption) Route() string { return RouterKey }
+
+func (msg MsgRequestRedemption) Type() string { return "request_redemption" }
+
+func (msg MsgRequestRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.LiquidityProvider); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidity provider address (%s)", err)
+	}
+
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return ValidateBankDetailsHash(msg.BankDetailsHash)
+}
+
+func (msg MsgRequestRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgFulfillRedemption) Route() string { return RouterKey }
+
+func (msg MsgFulfillRedemption) Type() string { return "fulfill_redemption" }
+
+func (msg MsgFulfillRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgFulfillRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFulfillRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgRejectRedemption) Route() string { return RouterKey }
+
+func (msg MsgRejectRedemption) Type() string { return "reject_redemption" }
+
+func (msg MsgRejectRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgRejectRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRejectRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
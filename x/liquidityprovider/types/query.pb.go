@@ -32,6 +32,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryListRequest struct {
+	// issuer optionally restricts the list to liquidity providers holding an
+	// allowance granted by the issuer.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *QueryListRequest) Reset()         { *m = QueryListRequest{} }
@@ -67,6 +70,13 @@ func (m *QueryListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryListRequest proto.InternalMessageInfo
 
+func (m *QueryListRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 type QueryListResponse struct {
 	LiquidityProviders []LiquidityProviderAccount `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers" yaml:"liquidity_providers"`
 }
@@ -157,7 +167,8 @@ func (m *QueryMintableRequest) GetAddress() string {
 }
 
 type QueryMintableResponse struct {
-	Mintable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	Mintable   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=mintable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mintable" yaml:"mintable"`
+	Allowances []Allowance                              `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances" yaml:"allowances"`
 }
 
 func (m *QueryMintableResponse) Reset()         { *m = QueryMintableResponse{} }
@@ -200,11 +211,219 @@ func (m *QueryMintableResponse) GetMintable() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+func (m *QueryMintableResponse) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+type QueryRedemptionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRedemptionRequest) Reset()         { *m = QueryRedemptionRequest{} }
+func (m *QueryRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRequest) ProtoMessage()    {}
+func (*QueryRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb0e5094409d525, []int{4}
+}
+func (m *QueryRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryRedemptionResponse struct {
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption" yaml:"redemption"`
+}
+
+func (m *QueryRedemptionResponse) Reset()         { *m = QueryRedemptionResponse{} }
+func (m *QueryRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionResponse) ProtoMessage()    {}
+func (*QueryRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb0e5094409d525, []int{5}
+}
+func (m *QueryRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionResponse.Merge(m, src)
+}
+func (m *QueryRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionResponse) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
+	}
+	return Redemption{}
+}
+
+type QueryRedemptionsRequest struct {
+	// owner optionally restricts the list to redemptions requested by the
+	// account.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// liquidity_provider optionally restricts the list to redemptions directed
+	// at the liquidity provider.
+	LiquidityProvider string `protobuf:"bytes,2,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider,omitempty"`
+	// pending_only restricts the list to redemptions awaiting resolution.
+	PendingOnly bool `protobuf:"varint,3,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+}
+
+func (m *QueryRedemptionsRequest) Reset()         { *m = QueryRedemptionsRequest{} }
+func (m *QueryRedemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsRequest) ProtoMessage()    {}
+func (*QueryRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb0e5094409d525, []int{6}
+}
+func (m *QueryRedemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsRequest.Merge(m, src)
+}
+func (m *QueryRedemptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionsRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRedemptionsRequest) GetLiquidityProvider() string {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return ""
+}
+
+func (m *QueryRedemptionsRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+type QueryRedemptionsResponse struct {
+	Redemptions []Redemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions" yaml:"redemptions"`
+}
+
+func (m *QueryRedemptionsResponse) Reset()         { *m = QueryRedemptionsResponse{} }
+func (m *QueryRedemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsResponse) ProtoMessage()    {}
+func (*QueryRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb0e5094409d525, []int{7}
+}
+func (m *QueryRedemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsResponse.Merge(m, src)
+}
+func (m *QueryRedemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionsResponse) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryListRequest)(nil), "em.liquidityprovider.v1.QueryListRequest")
 	proto.RegisterType((*QueryListResponse)(nil), "em.liquidityprovider.v1.QueryListResponse")
 	proto.RegisterType((*QueryMintableRequest)(nil), "em.liquidityprovider.v1.QueryMintableRequest")
 	proto.RegisterType((*QueryMintableResponse)(nil), "em.liquidityprovider.v1.QueryMintableResponse")
+	proto.RegisterType((*QueryRedemptionRequest)(nil), "em.liquidityprovider.v1.QueryRedemptionRequest")
+	proto.RegisterType((*QueryRedemptionResponse)(nil), "em.liquidityprovider.v1.QueryRedemptionResponse")
+	proto.RegisterType((*QueryRedemptionsRequest)(nil), "em.liquidityprovider.v1.QueryRedemptionsRequest")
+	proto.RegisterType((*QueryRedemptionsResponse)(nil), "em.liquidityprovider.v1.QueryRedemptionsResponse")
 }
 
 func init() {
@@ -212,38 +431,52 @@ func init() {
 }
 
 var fileDescriptor_9fb0e5094409d525 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbd, 0x6e, 0xd4, 0x40,
-	0x10, 0xc7, 0x6f, 0xc3, 0x57, 0x58, 0x0a, 0x60, 0x09, 0x22, 0x58, 0xc8, 0x87, 0x16, 0x8a, 0x23,
-	0xd2, 0xed, 0xc6, 0x87, 0x84, 0x10, 0x1d, 0x97, 0x36, 0x48, 0xe1, 0x4a, 0x1a, 0xe4, 0x8f, 0x91,
-	0x59, 0x61, 0xef, 0xfa, 0xbc, 0xeb, 0x13, 0x06, 0xd1, 0x50, 0x20, 0xd1, 0x21, 0xa5, 0xa5, 0xa3,
-	0xe3, 0x0d, 0x78, 0x83, 0x94, 0x91, 0x68, 0xa8, 0x02, 0xba, 0xe3, 0x09, 0x78, 0x82, 0xc8, 0xf6,
-	0x3a, 0x8a, 0x2e, 0x71, 0x94, 0xca, 0xf6, 0xec, 0xcc, 0x7f, 0x7e, 0x33, 0x7f, 0x2f, 0x7e, 0x00,
-	0x29, 0x4f, 0xc4, 0xb4, 0x10, 0x91, 0x30, 0x65, 0x96, 0xab, 0x99, 0x88, 0x20, 0xe7, 0x33, 0x8f,
-	0x4f, 0x0b, 0xc8, 0x4b, 0x96, 0xe5, 0xca, 0x28, 0x72, 0x07, 0x52, 0x76, 0x22, 0x89, 0xcd, 0x3c,
-	0x67, 0x2d, 0x56, 0xb1, 0xaa, 0x73, 0x78, 0xf5, 0xd6, 0xa4, 0x3b, 0x6e, 0xa8, 0x74, 0xaa, 0x34,
-	0x0f, 0x7c, 0x0d, 0x7c, 0xe6, 0x05, 0x60, 0x7c, 0x8f, 0x87, 0x4a, 0x48, 0x7b, 0x7e, 0x2f, 0x56,
-	0x2a, 0x4e, 0x80, 0xfb, 0x99, 0xe0, 0xbe, 0x94, 0xca, 0xf8, 0x46, 0x28, 0xa9, 0xed, 0x29, 0xef,
-	0x22, 0x3a, 0x49, 0x50, 0x17, 0x50, 0x82, 0x6f, 0xbc, 0xac, 0x60, 0xb7, 0x85, 0x36, 0x13, 0x98,
-	0x16, 0xa0, 0x0d, 0xfd, 0x86, 0xf0, 0xcd, 0x63, 0x41, 0x9d, 0x29, 0xa9, 0x81, 0x7c, 0x46, 0xf8,
-	0xd6, 0x91, 0xca, 0xeb, 0x56, 0x46, 0xaf, 0xa3, 0xfb, 0x17, 0x06, 0xd7, 0x46, 0x1e, 0xeb, 0x18,
-	0x93, 0x6d, 0xb7, 0xc1, 0x1d, 0x1b, 0x7c, 0x1e, 0x86, 0xaa, 0x90, 0x66, 0x4c, 0xf7, 0x0e, 0xfa,
-	0xbd, 0xff, 0x07, 0x7d, 0xa7, 0xf4, 0xd3, 0xe4, 0x19, 0x3d, 0x45, 0x9b, 0x4e, 0x48, 0xb2, 0x5c,
-	0xad, 0xe9, 0x26, 0x5e, 0xab, 0xe9, 0x5e, 0x08, 0x69, 0xfc, 0x20, 0x01, 0x8b, 0x4d, 0xd6, 0xf1,
-	0x15, 0x3f, 0x8a, 0x72, 0xd0, 0x15, 0x13, 0x1a, 0x5c, 0x9d, 0xb4, 0x9f, 0x74, 0x17, 0xe1, 0xdb,
-	0x4b, 0x25, 0x76, 0xa8, 0xf7, 0x78, 0x35, 0xb5, 0x31, 0x3b, 0xc8, 0x5d, 0xd6, 0x18, 0xc0, 0x2a,
-	0x03, 0x98, 0x35, 0x80, 0x6d, 0x29, 0x21, 0xc7, 0x5b, 0x16, 0xf8, 0x7a, 0x03, 0xdc, 0x16, 0xd2,
-	0x1f, 0x7f, 0xfa, 0x83, 0x58, 0x98, 0x37, 0x45, 0xc0, 0x42, 0x95, 0x72, 0x6b, 0x60, 0xf3, 0x18,
-	0xea, 0xe8, 0x2d, 0x37, 0x65, 0x06, 0xba, 0xd6, 0xd0, 0x93, 0xa3, 0x7e, 0xa3, 0x9f, 0x2b, 0xf8,
-	0x52, 0x4d, 0x45, 0xbe, 0x20, 0x7c, 0xb1, 0xda, 0x35, 0x79, 0xd4, 0xb9, 0xc5, 0x65, 0x93, 0x9c,
-	0x8d, 0xf3, 0xa4, 0x36, 0x53, 0xd2, 0x8d, 0x4f, 0xbf, 0xfe, 0xed, 0xae, 0x3c, 0x24, 0x94, 0xc3,
-	0x30, 0x55, 0x12, 0xca, 0xae, 0x7f, 0x44, 0x1b, 0xf2, 0x1d, 0xe1, 0xd5, 0x76, 0x4d, 0x64, 0x78,
-	0x76, 0x93, 0x25, 0x07, 0x1c, 0x76, 0xde, 0x74, 0xcb, 0xf5, 0xb4, 0xe6, 0x1a, 0x91, 0xcd, 0xb3,
-	0xb9, 0xda, 0x8d, 0xf1, 0x0f, 0xd6, 0xd0, 0x8f, 0xe3, 0x9d, 0xbd, 0xb9, 0x8b, 0xf6, 0xe7, 0x2e,
-	0xfa, 0x3b, 0x77, 0xd1, 0xd7, 0x85, 0xdb, 0xdb, 0x5f, 0xb8, 0xbd, 0xdf, 0x0b, 0xb7, 0xf7, 0xea,
-	0xc9, 0x31, 0x27, 0x5a, 0x55, 0x48, 0x87, 0x09, 0x44, 0x31, 0xe4, 0xfc, 0xdd, 0x29, 0x1d, 0x6a,
-	0x77, 0x82, 0xcb, 0xf5, 0x7d, 0x78, 0x7c, 0x18, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x3e, 0x5e, 0x85,
-	0xd4, 0x03, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0x3b, 0xe5, 0x45, 0x9c, 0x1a, 0x95, 0x11, 0xa1, 0x6c, 0x4c, 0xc1, 0xc1, 0x43, 0xc1,
+	0x74, 0xa7, 0xad, 0x09, 0x31, 0xde, 0x28, 0x57, 0x8c, 0xb8, 0x47, 0x13, 0x25, 0xdb, 0xee, 0xa4,
+	0x4e, 0xdc, 0x9d, 0x59, 0x76, 0xb6, 0xc5, 0x95, 0x70, 0xd0, 0x83, 0x89, 0xf1, 0x62, 0xe2, 0xd5,
+	0x9b, 0x31, 0x26, 0x7e, 0x12, 0x8e, 0x24, 0x5e, 0x3c, 0xa1, 0x01, 0x3f, 0x01, 0x9f, 0xc0, 0x74,
+	0x76, 0xb6, 0xdd, 0x74, 0x69, 0x53, 0x4f, 0x30, 0xb3, 0xcf, 0xf3, 0x7f, 0x7e, 0xcf, 0xdb, 0x14,
+	0xae, 0x51, 0x8f, 0xb8, 0x6c, 0xbf, 0xc3, 0x1c, 0x16, 0x46, 0x7e, 0x20, 0xba, 0xcc, 0xa1, 0x01,
+	0xe9, 0xd6, 0xc8, 0x7e, 0x87, 0x06, 0x91, 0xe9, 0x07, 0x22, 0x14, 0x68, 0x89, 0x7a, 0x66, 0xc6,
+	0xc8, 0xec, 0xd6, 0x8c, 0x85, 0xb6, 0x68, 0x0b, 0x65, 0x43, 0x7a, 0xff, 0xc5, 0xe6, 0x46, 0xa9,
+	0x25, 0xa4, 0x27, 0x24, 0x69, 0xda, 0x92, 0x92, 0x6e, 0xad, 0x49, 0x43, 0xbb, 0x46, 0x5a, 0x82,
+	0x71, 0xfd, 0xfd, 0x4e, 0x5b, 0x88, 0xb6, 0x4b, 0x89, 0xed, 0x33, 0x62, 0x73, 0x2e, 0x42, 0x3b,
+	0x64, 0x82, 0x4b, 0xfd, 0x95, 0x8c, 0x22, 0xca, 0x12, 0x28, 0x07, 0xbc, 0x01, 0x6f, 0x3e, 0xed,
+	0xc1, 0xee, 0x30, 0x19, 0x5a, 0x74, 0xbf, 0x43, 0x65, 0x88, 0x16, 0xe1, 0x2c, 0x93, 0xb2, 0x43,
+	0x83, 0x22, 0x58, 0x05, 0xe5, 0xab, 0x96, 0x3e, 0xe1, 0x2f, 0x00, 0xce, 0xa7, 0x8c, 0xa5, 0x2f,
+	0xb8, 0xa4, 0xe8, 0x3d, 0x80, 0xb7, 0xfa, 0xea, 0x7b, 0x89, 0xbc, 0x2c, 0x82, 0xd5, 0xa9, 0x72,
+	0xa1, 0x5e, 0x33, 0x47, 0xa4, 0x6f, 0xee, 0x24, 0x97, 0xbb, 0xfa, 0x72, 0xab, 0xd5, 0x12, 0x1d,
+	0x1e, 0x36, 0xf0, 0xf1, 0xe9, 0x4a, 0xee, 0xe2, 0x74, 0xc5, 0x88, 0x6c, 0xcf, 0x7d, 0x84, 0x2f,
+	0xd1, 0xc6, 0x16, 0x72, 0x87, 0xbd, 0x25, 0xae, 0xc2, 0x05, 0x45, 0xf7, 0x98, 0xf1, 0xd0, 0x6e,
+	0xba, 0x34, 0x49, 0xa7, 0x08, 0xaf, 0xd8, 0x8e, 0x13, 0x50, 0x29, 0x75, 0x3e, 0xc9, 0x11, 0x5f,
+	0x00, 0x78, 0x7b, 0xc8, 0x45, 0x27, 0xf5, 0x06, 0xce, 0x79, 0xfa, 0x4e, 0x27, 0xb2, 0x6c, 0xc6,
+	0x8d, 0x31, 0x7b, 0x8d, 0x31, 0x75, 0x63, 0xcc, 0x6d, 0xc1, 0x78, 0x63, 0x5b, 0x03, 0xdf, 0x88,
+	0x81, 0x13, 0x47, 0xfc, 0xe3, 0xf7, 0x4a, 0xb9, 0xcd, 0xc2, 0x97, 0x9d, 0xa6, 0xd9, 0x12, 0x1e,
+	0xd1, 0x8d, 0x8d, 0xff, 0x54, 0xa4, 0xf3, 0x8a, 0x84, 0x91, 0x4f, 0xa5, 0xd2, 0x90, 0x56, 0x3f,
+	0x1e, 0x7a, 0x0e, 0xa1, 0xed, 0xba, 0xe2, 0xc0, 0xe6, 0x2d, 0x2a, 0x8b, 0x79, 0x15, 0x1d, 0x8f,
+	0x2c, 0xe3, 0x56, 0x62, 0xda, 0x58, 0xd6, 0x18, 0xf3, 0x31, 0xc6, 0x40, 0x03, 0x5b, 0x29, 0x41,
+	0x5c, 0x86, 0x8b, 0x2a, 0x67, 0x8b, 0x3a, 0xd4, 0xf3, 0x7b, 0xc3, 0x93, 0x14, 0xea, 0x3a, 0xcc,
+	0x33, 0x47, 0xd5, 0x68, 0xda, 0xca, 0x33, 0x07, 0x47, 0x70, 0x29, 0x63, 0xa9, 0xeb, 0xf3, 0x02,
+	0xc2, 0xa0, 0x7f, 0xab, 0x5c, 0x0a, 0xf5, 0xb5, 0x91, 0x8c, 0x03, 0x81, 0x61, 0xc8, 0x81, 0x08,
+	0xb6, 0x52, 0x8a, 0xf8, 0x2d, 0xc8, 0xc4, 0x96, 0x09, 0xe6, 0x02, 0x9c, 0x11, 0x07, 0xbc, 0x3f,
+	0x9d, 0xf1, 0x01, 0x55, 0x20, 0xca, 0x4e, 0x4a, 0x31, 0xaf, 0x4c, 0xe6, 0x33, 0xd3, 0x82, 0xee,
+	0xc2, 0x6b, 0x3e, 0xe5, 0x0e, 0xe3, 0xed, 0x3d, 0xc1, 0xdd, 0xa8, 0x38, 0xb5, 0x0a, 0xca, 0x73,
+	0x56, 0x41, 0xdf, 0x3d, 0xe1, 0x6e, 0x84, 0x8f, 0x60, 0x31, 0x8b, 0xa0, 0xf3, 0xb7, 0x61, 0x61,
+	0x40, 0x9b, 0xcc, 0xfa, 0x44, 0x05, 0x30, 0x74, 0x01, 0xd0, 0x70, 0x01, 0x24, 0xb6, 0xd2, 0x9a,
+	0xf5, 0x8f, 0x33, 0x70, 0x46, 0xc5, 0x47, 0x1f, 0x00, 0x9c, 0xee, 0xad, 0x1c, 0x5a, 0x1f, 0x19,
+	0x60, 0x78, 0x87, 0x8d, 0x8d, 0x49, 0x4c, 0xe3, 0x64, 0xf0, 0xc6, 0xbb, 0x9f, 0x7f, 0x3f, 0xe7,
+	0xef, 0x21, 0x4c, 0x68, 0xc5, 0x13, 0x9c, 0x46, 0xa3, 0x9e, 0x10, 0x19, 0xa2, 0xaf, 0x00, 0xce,
+	0x25, 0xdb, 0x82, 0x2a, 0xe3, 0x83, 0x0c, 0x2d, 0xa2, 0x61, 0x4e, 0x6a, 0xae, 0xb9, 0x1e, 0x2a,
+	0xae, 0x3a, 0xaa, 0x8e, 0xe7, 0x4a, 0x16, 0x87, 0x1c, 0xea, 0xbd, 0x3e, 0x42, 0xdf, 0x01, 0x84,
+	0x83, 0x9a, 0x23, 0x32, 0x3e, 0x70, 0x66, 0x13, 0x8c, 0xea, 0xe4, 0x0e, 0x9a, 0x75, 0x53, 0xb1,
+	0x56, 0x91, 0x39, 0x9e, 0x35, 0xd5, 0x60, 0x72, 0xc8, 0x9c, 0x23, 0xf4, 0x0d, 0xc0, 0x42, 0x6a,
+	0xc0, 0xd0, 0xc4, 0x91, 0x93, 0x75, 0x30, 0x6a, 0xff, 0xe1, 0xa1, 0x61, 0x6b, 0x0a, 0xf6, 0x3e,
+	0x5a, 0x9f, 0x18, 0xb6, 0xb1, 0x7b, 0x7c, 0x56, 0x02, 0x27, 0x67, 0x25, 0xf0, 0xe7, 0xac, 0x04,
+	0x3e, 0x9d, 0x97, 0x72, 0x27, 0xe7, 0xa5, 0xdc, 0xaf, 0xf3, 0x52, 0xee, 0xd9, 0x66, 0xea, 0x89,
+	0x4b, 0xe4, 0xa8, 0x57, 0x71, 0xa9, 0xd3, 0xa6, 0x01, 0x79, 0x7d, 0x89, 0xb4, 0x7a, 0xf6, 0x9a,
+	0xb3, 0xea, 0x07, 0xe8, 0xc1, 0xbf, 0x01, 0x00, 0xcc, 0x42, 0x9c, 0x5e, 0x45, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +493,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	List(ctx context.Context, in *QueryListRequest, opts ...grpc.CallOption) (*QueryListResponse, error)
 	Mintable(ctx context.Context, in *QueryMintableRequest, opts ...grpc.CallOption) (*QueryMintableResponse, error)
+	Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error)
+	Redemptions(ctx context.Context, in *QueryRedemptionsRequest, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error)
 }
 
 type queryClient struct {
@@ -288,10 +523,30 @@ func (c *queryClient) Mintable(ctx context.Context, in *QueryMintableRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error) {
+	out := new(QueryRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/em.liquidityprovider.v1.Query/Redemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemptions(ctx context.Context, in *QueryRedemptionsRequest, opts ...grpc.CallOption) (*QueryRedemptionsResponse, error) {
+	out := new(QueryRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/em.liquidityprovider.v1.Query/Redemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	List(context.Context, *QueryListRequest) (*QueryListResponse, error)
 	Mintable(context.Context, *QueryMintableRequest) (*QueryMintableResponse, error)
+	Redemption(context.Context, *QueryRedemptionRequest) (*QueryRedemptionResponse, error)
+	Redemptions(context.Context, *QueryRedemptionsRequest) (*QueryRedemptionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -304,6 +559,12 @@ func (*UnimplementedQueryServer) List(ctx context.Context, req *QueryListRequest
 func (*UnimplementedQueryServer) Mintable(ctx context.Context, req *QueryMintableRequest) (*QueryMintableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mintable not implemented")
 }
+func (*UnimplementedQueryServer) Redemption(ctx context.Context, req *QueryRedemptionRequest) (*QueryRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+func (*UnimplementedQueryServer) Redemptions(ctx context.Context, req *QueryRedemptionsRequest) (*QueryRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemptions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -345,6 +606,42 @@ func _Query_Mintable_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.liquidityprovider.v1.Query/Redemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.liquidityprovider.v1.Query/Redemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemptions(ctx, req.(*QueryRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.liquidityprovider.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -357,6 +654,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Mintable",
 			Handler:    _Query_Mintable_Handler,
 		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+		{
+			MethodName: "Redemptions",
+			Handler:    _Query_Redemptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/liquidityprovider/v1/query.proto",
@@ -382,6 +687,13 @@ func (m *QueryListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -472,6 +784,20 @@ func (m *QueryMintableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Mintable) > 0 {
 		for iNdEx := len(m.Mintable) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -489,75 +815,288 @@ func (m *QueryMintableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMintableRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMintableResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Mintable) > 0 {
-		for _, e := range m.Mintable {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryRedemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingOnly {
+		i--
+		if m.PendingOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LiquidityProvider) > 0 {
+		i -= len(m.LiquidityProvider)
+		copy(dAtA[i:], m.LiquidityProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidityProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mintable) > 0 {
+		for _, e := range m.Mintable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRedemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -587,6 +1126,38 @@ func (m *QueryListRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -837,6 +1408,410 @@ func (m *QueryMintableResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HashBankDetails returns the hex encoded SHA-256 hash under which bank details are recorded on-chain.
+func HashBankDetails(details string) string {
+	hash := sha256.Sum256([]byte(details))
+	return hex.EncodeToString(hash[:])
+}
+
+func ValidateBankDetailsHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != sha256.Size {
+		return sdkerrors.Wrap(ErrInvalidBankDetails, "expected a hex encoded SHA-256 hash")
+	}
+	return nil
+}
+
+func (r Redemption) IsPending() bool {
+	return r.Status == RedemptionStatus_Pending
+}
+
+func (r Redemption) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, r.Owner)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.LiquidityProvider); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, r.LiquidityProvider)
+	}
+	if err := r.Amount.Validate(); err != nil || !r.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, r.Amount.String())
+	}
+	if err := ValidateBankDetailsHash(r.BankDetailsHash); err != nil {
+		return err
+	}
+	if _, ok := RedemptionStatus_name[int32(r.Status)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown redemption status %d", r.Status)
+	}
+	if !r.IsPending() && r.ResolvedAt == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "redemption %d resolved without a time", r.ID)
+	}
+	return nil
+}
@@ -206,41 +206,378 @@ func (m *MsgBurnTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnTokensResponse proto.InternalMessageInfo
 
+// MsgRequestRedemption escrows tokens for an off-chain payout by a liquidity
+// provider.
+type MsgRequestRedemption struct {
+	Owner             string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LiquidityProvider string     `protobuf:"bytes,2,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider,omitempty" yaml:"liquidity_provider"`
+	Amount            types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// Hex encoded SHA-256 hash of the bank details to pay out to.
+	BankDetailsHash string `protobuf:"bytes,4,opt,name=bank_details_hash,json=bankDetailsHash,proto3" json:"bank_details_hash,omitempty" yaml:"bank_details_hash"`
+}
+
+func (m *MsgRequestRedemption) Reset()         { *m = MsgRequestRedemption{} }
+func (m *MsgRequestRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemption) ProtoMessage()    {}
+func (*MsgRequestRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{4}
+}
+func (m *MsgRequestRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemption.Merge(m, src)
+}
+func (m *MsgRequestRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRedemption proto.InternalMessageInfo
+
+func (m *MsgRequestRedemption) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRequestRedemption) GetLiquidityProvider() string {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return ""
+}
+
+func (m *MsgRequestRedemption) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRequestRedemption) GetBankDetailsHash() string {
+	if m != nil {
+		return m.BankDetailsHash
+	}
+	return ""
+}
+
+type MsgRequestRedemptionResponse struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRequestRedemptionResponse) Reset()         { *m = MsgRequestRedemptionResponse{} }
+func (m *MsgRequestRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRedemptionResponse) ProtoMessage()    {}
+func (*MsgRequestRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{5}
+}
+func (m *MsgRequestRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRequestRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgRequestRedemptionResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// MsgFulfillRedemption burns the escrow of a redemption once the payout has
+// been made. Signed by the liquidity provider or the issuer of the
+// denomination.
+type MsgFulfillRedemption struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ID     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Reference of the off-chain payout.
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty" yaml:"reference"`
+}
+
+func (m *MsgFulfillRedemption) Reset()         { *m = MsgFulfillRedemption{} }
+func (m *MsgFulfillRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillRedemption) ProtoMessage()    {}
+func (*MsgFulfillRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{6}
+}
+func (m *MsgFulfillRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillRedemption.Merge(m, src)
+}
+func (m *MsgFulfillRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillRedemption proto.InternalMessageInfo
+
+func (m *MsgFulfillRedemption) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgFulfillRedemption) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgFulfillRedemption) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type MsgFulfillRedemptionResponse struct {
+}
+
+func (m *MsgFulfillRedemptionResponse) Reset()         { *m = MsgFulfillRedemptionResponse{} }
+func (m *MsgFulfillRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillRedemptionResponse) ProtoMessage()    {}
+func (*MsgFulfillRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{7}
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillRedemptionResponse.Merge(m, src)
+}
+func (m *MsgFulfillRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillRedemptionResponse proto.InternalMessageInfo
+
+// MsgRejectRedemption refunds the escrow of a redemption to its owner. Signed
+// by the liquidity provider or the issuer of the denomination.
+type MsgRejectRedemption struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ID     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *MsgRejectRedemption) Reset()         { *m = MsgRejectRedemption{} }
+func (m *MsgRejectRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemption) ProtoMessage()    {}
+func (*MsgRejectRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{8}
+}
+func (m *MsgRejectRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemption.Merge(m, src)
+}
+func (m *MsgRejectRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectRedemption proto.InternalMessageInfo
+
+func (m *MsgRejectRedemption) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRejectRedemption) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgRejectRedemption) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgRejectRedemptionResponse struct {
+}
+
+func (m *MsgRejectRedemptionResponse) Reset()         { *m = MsgRejectRedemptionResponse{} }
+func (m *MsgRejectRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionResponse) ProtoMessage()    {}
+func (*MsgRejectRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98978ada1f5f3138, []int{9}
+}
+func (m *MsgRejectRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectRedemptionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintTokens)(nil), "em.liquidityprovider.v1.MsgMintTokens")
 	proto.RegisterType((*MsgMintTokensResponse)(nil), "em.liquidityprovider.v1.MsgMintTokensResponse")
 	proto.RegisterType((*MsgBurnTokens)(nil), "em.liquidityprovider.v1.MsgBurnTokens")
 	proto.RegisterType((*MsgBurnTokensResponse)(nil), "em.liquidityprovider.v1.MsgBurnTokensResponse")
+	proto.RegisterType((*MsgRequestRedemption)(nil), "em.liquidityprovider.v1.MsgRequestRedemption")
+	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "em.liquidityprovider.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgFulfillRedemption)(nil), "em.liquidityprovider.v1.MsgFulfillRedemption")
+	proto.RegisterType((*MsgFulfillRedemptionResponse)(nil), "em.liquidityprovider.v1.MsgFulfillRedemptionResponse")
+	proto.RegisterType((*MsgRejectRedemption)(nil), "em.liquidityprovider.v1.MsgRejectRedemption")
+	proto.RegisterType((*MsgRejectRedemptionResponse)(nil), "em.liquidityprovider.v1.MsgRejectRedemptionResponse")
 }
 
 func init() { proto.RegisterFile("em/liquidityprovider/v1/tx.proto", fileDescriptor_98978ada1f5f3138) }
 
 var fileDescriptor_98978ada1f5f3138 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0xcb, 0x4a, 0xfb, 0x40,
-	0x14, 0xc6, 0x93, 0x7f, 0xa1, 0xf0, 0x1f, 0xe9, 0xc2, 0xa0, 0xf4, 0x02, 0x26, 0x25, 0x0b, 0xe9,
-	0xa6, 0x33, 0xa4, 0x82, 0x0b, 0x77, 0xc6, 0xad, 0x85, 0x52, 0x5c, 0xb9, 0x91, 0xa4, 0x19, 0xe2,
-	0xd0, 0xce, 0x4c, 0xcc, 0x4c, 0x42, 0xf3, 0x16, 0x3e, 0x87, 0x4f, 0xd2, 0x65, 0xc5, 0x8d, 0xab,
-	0x2a, 0xed, 0x1b, 0xf4, 0x09, 0xa4, 0xb9, 0xf4, 0x42, 0xb5, 0xb8, 0x76, 0x95, 0x70, 0xf2, 0xe5,
-	0x7c, 0xe7, 0xf7, 0xcd, 0x1c, 0xd0, 0xc4, 0x14, 0x8d, 0xc8, 0x53, 0x44, 0x3c, 0x22, 0x93, 0x20,
-	0xe4, 0x31, 0xf1, 0x70, 0x88, 0x62, 0x0b, 0xc9, 0x31, 0x0c, 0x42, 0x2e, 0xb9, 0x56, 0xc5, 0x14,
-	0xee, 0x29, 0x60, 0x6c, 0x35, 0x4e, 0x7c, 0xee, 0xf3, 0x54, 0x83, 0x56, 0x6f, 0x99, 0xbc, 0xa1,
-	0x0f, 0xb8, 0xa0, 0x5c, 0x20, 0xd7, 0x11, 0x18, 0xc5, 0x96, 0x8b, 0xa5, 0x63, 0xa1, 0x01, 0x27,
-	0x2c, 0xfb, 0x6e, 0xbe, 0xa9, 0xa0, 0xd2, 0x15, 0x7e, 0x97, 0x30, 0x79, 0xc7, 0x87, 0x98, 0x09,
-	0xed, 0x16, 0x68, 0xeb, 0xfe, 0x0f, 0x85, 0x41, 0x4d, 0x6d, 0xaa, 0xad, 0xff, 0xf6, 0xd9, 0x72,
-	0x66, 0xd4, 0x13, 0x87, 0x8e, 0xae, 0xcc, 0x7d, 0x8d, 0xd9, 0x3f, 0x5e, 0x17, 0x7b, 0x79, 0x4d,
-	0x93, 0xa0, 0xec, 0x50, 0x1e, 0x31, 0x59, 0xfb, 0xd7, 0x2c, 0xb5, 0x8e, 0x3a, 0x75, 0x98, 0x0d,
-	0x04, 0x57, 0x03, 0xc1, 0x7c, 0x20, 0x78, 0xc3, 0x09, 0xb3, 0xaf, 0x27, 0x33, 0x43, 0x59, 0xce,
-	0x8c, 0x4a, 0x66, 0x90, 0xfd, 0x66, 0xbe, 0x7c, 0x18, 0x2d, 0x9f, 0xc8, 0xc7, 0xc8, 0x85, 0x03,
-	0x4e, 0x51, 0x8e, 0x93, 0x3d, 0xda, 0xc2, 0x1b, 0x22, 0x99, 0x04, 0x58, 0xa4, 0x1d, 0x44, 0x3f,
-	0xf7, 0x32, 0xab, 0xe0, 0x74, 0x07, 0xaa, 0x8f, 0x45, 0xc0, 0x99, 0xc0, 0x05, 0xae, 0x1d, 0x85,
-	0xec, 0xcf, 0xe1, 0x6e, 0xa0, 0x0a, 0xdc, 0xce, 0xab, 0x0a, 0x4a, 0x5d, 0xe1, 0x6b, 0x1e, 0x00,
-	0x5b, 0x27, 0x7c, 0x0e, 0x7f, 0xb8, 0x43, 0x70, 0x27, 0xb4, 0x06, 0xfc, 0x9d, 0xae, 0x70, 0x5b,
-	0xb9, 0x6c, 0x05, 0x7b, 0xd0, 0x65, 0xa3, 0x3b, 0xec, 0xb2, 0xcf, 0x64, 0xf7, 0x26, 0x73, 0x5d,
-	0x9d, 0xce, 0x75, 0xf5, 0x73, 0xae, 0xab, 0xcf, 0x0b, 0x5d, 0x99, 0x2e, 0x74, 0xe5, 0x7d, 0xa1,
-	0x2b, 0xf7, 0x97, 0x5b, 0xc1, 0xe1, 0x36, 0xe5, 0x0c, 0x27, 0x08, 0xd3, 0xf6, 0x08, 0x7b, 0x3e,
-	0x0e, 0xd1, 0xf8, 0x9b, 0xc5, 0x4a, 0xc3, 0x74, 0xcb, 0xe9, 0x2a, 0x5c, 0x7c, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x32, 0x16, 0xea, 0x39, 0x7d, 0x03, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x86, 0x63, 0x87, 0x1b, 0x29, 0x73, 0x2f, 0xba, 0xc4, 0xc0, 0x25, 0xf8, 0x82, 0x1d, 0x4d,
+	0x25, 0x04, 0x52, 0x63, 0x2b, 0xb4, 0xb0, 0xe8, 0xae, 0x29, 0xaa, 0xa8, 0xd4, 0x48, 0xc8, 0xea,
+	0xaa, 0x1b, 0xe4, 0xc4, 0x07, 0x33, 0xc5, 0x9e, 0x09, 0x9e, 0x49, 0x4a, 0xde, 0x82, 0x75, 0x57,
+	0x5d, 0xf7, 0x25, 0xba, 0x65, 0x89, 0xd4, 0x4d, 0x57, 0x6e, 0x15, 0xde, 0x20, 0x4f, 0x50, 0xc5,
+	0x93, 0x38, 0xa1, 0x0e, 0x11, 0x54, 0xea, 0xa6, 0x2b, 0xd0, 0x99, 0xcf, 0xe7, 0x3f, 0xff, 0x9f,
+	0xf1, 0x31, 0xaa, 0x40, 0x68, 0x07, 0xe4, 0xbc, 0x43, 0x3c, 0x22, 0x7a, 0xed, 0x88, 0x75, 0x89,
+	0x07, 0x91, 0xdd, 0xad, 0xd9, 0xe2, 0xc2, 0x6a, 0x47, 0x4c, 0x30, 0x6d, 0x0d, 0x42, 0x2b, 0x43,
+	0x58, 0xdd, 0x9a, 0xbe, 0xe2, 0x33, 0x9f, 0x25, 0x8c, 0x3d, 0xfc, 0x4f, 0xe2, 0xba, 0xd1, 0x62,
+	0x3c, 0x64, 0xdc, 0x6e, 0xba, 0x1c, 0xec, 0x6e, 0xad, 0x09, 0xc2, 0xad, 0xd9, 0x2d, 0x46, 0xa8,
+	0x3c, 0xc7, 0x5f, 0x14, 0xb4, 0xd8, 0xe0, 0x7e, 0x83, 0x50, 0xf1, 0x86, 0x9d, 0x01, 0xe5, 0xda,
+	0x6b, 0xa4, 0xa5, 0xfd, 0x8f, 0xc7, 0x02, 0x65, 0xa5, 0xa2, 0x6c, 0x17, 0xeb, 0x9b, 0x83, 0xd8,
+	0x5c, 0xef, 0xb9, 0x61, 0xf0, 0x0c, 0x67, 0x19, 0xec, 0x94, 0xd2, 0xe2, 0xd1, 0xa8, 0xa6, 0x09,
+	0x54, 0x70, 0x43, 0xd6, 0xa1, 0xa2, 0xac, 0x56, 0xf2, 0xdb, 0x7f, 0xef, 0xae, 0x5b, 0x72, 0x20,
+	0x6b, 0x38, 0x90, 0x35, 0x1a, 0xc8, 0x7a, 0xc1, 0x08, 0xad, 0x3f, 0xbf, 0x8a, 0xcd, 0xdc, 0x20,
+	0x36, 0x17, 0xa5, 0x80, 0x7c, 0x0c, 0x7f, 0xfa, 0x66, 0x6e, 0xfb, 0x44, 0x9c, 0x76, 0x9a, 0x56,
+	0x8b, 0x85, 0xf6, 0xc8, 0x8e, 0xfc, 0x53, 0xe5, 0xde, 0x99, 0x2d, 0x7a, 0x6d, 0xe0, 0x49, 0x07,
+	0xee, 0x8c, 0xb4, 0xf0, 0x1a, 0x5a, 0xbd, 0x65, 0xca, 0x01, 0xde, 0x66, 0x94, 0xc3, 0xd8, 0x6e,
+	0xbd, 0x13, 0xd1, 0x3f, 0xce, 0xee, 0xc4, 0x54, 0x6a, 0xf7, 0xa3, 0x8a, 0x56, 0x1a, 0xdc, 0x77,
+	0xe0, 0xbc, 0x03, 0x5c, 0x38, 0xe0, 0x41, 0xd8, 0x16, 0x84, 0x51, 0x6d, 0x0b, 0xfd, 0xc5, 0xde,
+	0xd3, 0xd4, 0xe8, 0xd2, 0x20, 0x36, 0xff, 0x91, 0x73, 0x24, 0x65, 0xec, 0xc8, 0xe3, 0x3b, 0xd2,
+	0x51, 0x7f, 0x31, 0x9d, 0xc3, 0x34, 0x9d, 0x7c, 0x45, 0x99, 0x9f, 0xce, 0xea, 0xcc, 0x74, 0xc6,
+	0x8e, 0xb5, 0x43, 0x54, 0x6a, 0xba, 0xf4, 0xec, 0xd8, 0x03, 0xe1, 0x92, 0x80, 0x1f, 0x9f, 0xba,
+	0xfc, 0xb4, 0xbc, 0x90, 0x8c, 0xb5, 0x31, 0x88, 0xcd, 0xb2, 0x7c, 0x2a, 0x83, 0x60, 0xe7, 0xdf,
+	0x61, 0xed, 0x40, 0x96, 0x0e, 0x87, 0x95, 0x7d, 0xb4, 0x31, 0x2b, 0xa1, 0x71, 0x84, 0xda, 0x7f,
+	0x48, 0x25, 0x5e, 0x12, 0xd3, 0x42, 0xbd, 0xd0, 0x8f, 0x4d, 0xf5, 0xd5, 0x81, 0xa3, 0x12, 0x0f,
+	0x7f, 0x50, 0x92, 0x68, 0x5f, 0x76, 0x82, 0x13, 0x12, 0x04, 0x53, 0xd1, 0xee, 0xa0, 0x02, 0x27,
+	0xfe, 0x24, 0xdb, 0xd2, 0xc4, 0x85, 0xac, 0x63, 0x67, 0x04, 0x68, 0x8f, 0x92, 0xde, 0x6a, 0xd2,
+	0x7b, 0x59, 0xf6, 0x1e, 0xc4, 0x66, 0x51, 0xc2, 0xc4, 0xc3, 0x43, 0x21, 0x6d, 0x17, 0x15, 0x23,
+	0x38, 0x81, 0x08, 0x68, 0x0b, 0x92, 0xdc, 0x8a, 0xf5, 0x95, 0x41, 0x6c, 0x2e, 0x49, 0x2a, 0x3d,
+	0xc2, 0xce, 0x04, 0xc3, 0x06, 0xda, 0x98, 0x35, 0x5b, 0x7a, 0x2f, 0x2e, 0x15, 0xb4, 0x9c, 0xb8,
+	0x7e, 0x07, 0x2d, 0xf1, 0x1b, 0x67, 0xdf, 0x41, 0x85, 0x08, 0x5c, 0xce, 0x68, 0x39, 0xff, 0x73,
+	0x3f, 0x59, 0xc7, 0xce, 0x08, 0xc0, 0x9b, 0xe8, 0xff, 0x19, 0x13, 0x8d, 0x27, 0xde, 0xfd, 0xbc,
+	0x80, 0xf2, 0x0d, 0xee, 0x6b, 0x1e, 0x42, 0x53, 0xbb, 0x6a, 0xcb, 0xba, 0x63, 0x1b, 0x5a, 0xb7,
+	0x5e, 0x7f, 0xdd, 0xba, 0x1f, 0x97, 0xfe, 0xe8, 0x1e, 0x42, 0x53, 0x2b, 0x62, 0xae, 0xca, 0x84,
+	0xd3, 0xad, 0xfb, 0x71, 0xa9, 0x4a, 0x0f, 0x95, 0xb2, 0x6f, 0x66, 0x75, 0x5e, 0x93, 0x0c, 0xae,
+	0xef, 0x3d, 0x08, 0x9f, 0x96, 0xce, 0xde, 0xdc, 0xb9, 0xd2, 0x19, 0x5c, 0xdf, 0x7b, 0x10, 0x9e,
+	0x4a, 0x77, 0xd1, 0x52, 0xe6, 0xde, 0x3d, 0x9e, 0xef, 0xe2, 0x36, 0xad, 0x3f, 0x7d, 0x08, 0x3d,
+	0xd6, 0xad, 0x1f, 0x5d, 0xf5, 0x0d, 0xe5, 0xba, 0x6f, 0x28, 0xdf, 0xfb, 0x86, 0x72, 0x79, 0x63,
+	0xe4, 0xae, 0x6f, 0x8c, 0xdc, 0xd7, 0x1b, 0x23, 0xf7, 0x76, 0x7f, 0x6a, 0xe1, 0x42, 0x35, 0x64,
+	0x14, 0x7a, 0x36, 0x84, 0xd5, 0x00, 0x3c, 0x1f, 0x22, 0xfb, 0x62, 0xc6, 0x07, 0x39, 0x59, 0xc2,
+	0xcd, 0x42, 0xf2, 0x09, 0x7d, 0xf2, 0x63, 0x00, 0x4e, 0xae, 0xfb, 0x3b, 0xb5, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +594,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	MintTokens(ctx context.Context, in *MsgMintTokens, opts ...grpc.CallOption) (*MsgMintTokensResponse, error)
 	BurnTokens(ctx context.Context, in *MsgBurnTokens, opts ...grpc.CallOption) (*MsgBurnTokensResponse, error)
+	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	FulfillRedemption(ctx context.Context, in *MsgFulfillRedemption, opts ...grpc.CallOption) (*MsgFulfillRedemptionResponse, error)
+	RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error)
 }
 
 type msgClient struct {
@@ -285,10 +625,40 @@ func (c *msgClient) BurnTokens(ctx context.Context, in *MsgBurnTokens, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error) {
+	out := new(MsgRequestRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/em.liquidityprovider.v1.Msg/RequestRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillRedemption(ctx context.Context, in *MsgFulfillRedemption, opts ...grpc.CallOption) (*MsgFulfillRedemptionResponse, error) {
+	out := new(MsgFulfillRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/em.liquidityprovider.v1.Msg/FulfillRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectRedemption(ctx context.Context, in *MsgRejectRedemption, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error) {
+	out := new(MsgRejectRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/em.liquidityprovider.v1.Msg/RejectRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	MintTokens(context.Context, *MsgMintTokens) (*MsgMintTokensResponse, error)
 	BurnTokens(context.Context, *MsgBurnTokens) (*MsgBurnTokensResponse, error)
+	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	FulfillRedemption(context.Context, *MsgFulfillRedemption) (*MsgFulfillRedemptionResponse, error)
+	RejectRedemption(context.Context, *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -301,6 +671,15 @@ func (*UnimplementedMsgServer) MintTokens(ctx context.Context, req *MsgMintToken
 func (*UnimplementedMsgServer) BurnTokens(ctx context.Context, req *MsgBurnTokens) (*MsgBurnTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnTokens not implemented")
 }
+func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
+}
+func (*UnimplementedMsgServer) FulfillRedemption(ctx context.Context, req *MsgFulfillRedemption) (*MsgFulfillRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillRedemption not implemented")
+}
+func (*UnimplementedMsgServer) RejectRedemption(ctx context.Context, req *MsgRejectRedemption) (*MsgRejectRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRedemption not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -342,6 +721,60 @@ func _Msg_BurnTokens_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.liquidityprovider.v1.Msg/RequestRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRedemption(ctx, req.(*MsgRequestRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.liquidityprovider.v1.Msg/FulfillRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillRedemption(ctx, req.(*MsgFulfillRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.liquidityprovider.v1.Msg/RejectRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRedemption(ctx, req.(*MsgRejectRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.liquidityprovider.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -354,6 +787,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BurnTokens",
 			Handler:    _Msg_BurnTokens_Handler,
 		},
+		{
+			MethodName: "RequestRedemption",
+			Handler:    _Msg_RequestRedemption_Handler,
+		},
+		{
+			MethodName: "FulfillRedemption",
+			Handler:    _Msg_FulfillRedemption_Handler,
+		},
+		{
+			MethodName: "RejectRedemption",
+			Handler:    _Msg_RejectRedemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/liquidityprovider/v1/tx.proto",
@@ -456,117 +901,1002 @@ func (m *MsgBurnTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LiquidityProvider) > 0 {
+		i -= len(m.LiquidityProvider)
+		copy(dAtA[i:], m.LiquidityProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidityProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BankDetailsHash) > 0 {
+		i -= len(m.BankDetailsHash)
+		copy(dAtA[i:], m.BankDetailsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankDetailsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LiquidityProvider) > 0 {
+		i -= len(m.LiquidityProvider)
+		copy(dAtA[i:], m.LiquidityProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidityProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMintTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BankDetailsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgFulfillRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDetailsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDetailsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.LiquidityProvider) > 0 {
-		i -= len(m.LiquidityProvider)
-		copy(dAtA[i:], m.LiquidityProvider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidityProvider)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgBurnTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBurnTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgMintTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LiquidityProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *MsgMintTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgBurnTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LiquidityProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgBurnTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillRedemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -589,15 +1919,15 @@ func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillRedemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -625,13 +1955,32 @@ func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -641,25 +1990,23 @@ func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -682,7 +2029,7 @@ func (m *MsgMintTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,10 +2052,10 @@ func (m *MsgMintTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -732,7 +2079,7 @@ func (m *MsgMintTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -755,15 +2102,15 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -791,13 +2138,32 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -807,25 +2173,23 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -848,7 +2212,7 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,10 +2235,10 @@ func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
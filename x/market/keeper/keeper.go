@@ -38,6 +38,8 @@ type Keeper struct {
 
 	// accountOrders types.Orders
 	appstateInit *sync.Once
+
+	tradeListeners []func(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin)
 }
 
 func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
@@ -55,6 +57,11 @@ func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authK
 	return k
 }
 
+// AddTradeListener registers a function that is called with the amounts exchanged by each party of a trade.
+func (k *Keeper) AddTradeListener(l func(ctx sdk.Context, owner sdk.AccAddress, sold, bought sdk.Coin)) {
+	k.tradeListeners = append(k.tradeListeners, l)
+}
+
 func (k *Keeper) createExecutionPlan(ctx sdk.Context, SourceDenom, DestinationDenom string) types.ExecutionPlan {
 	bestPlan := types.ExecutionPlan{
 		Price: sdk.NewDec(math.MaxInt64),
@@ -199,6 +206,11 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		return sdkerrors.Wrapf(types.ErrAccountBalanceInsufficientForInstrument, "")
 	}
 
+	// Verify that the issuers of the instruments allow the owner to trade them
+	if err := k.validateTransferRestrictions(ctx, owner, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom); err != nil {
+		return err
+	}
+
 	// Verify uniqueness of client order id among active orders
 	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) {
 		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
@@ -226,6 +238,19 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			break
 		}
 
+		if k.cancelRestrictedOrders(ctx, plan) {
+			// Passive orders were removed from the book. Find the next best plan.
+			continue
+		}
+
+		if plan.SecondOrder != nil {
+			// The owner of the aggressive order receives and sends the intermediate instrument of a synthetic plan.
+			intermediate := plan.SecondOrder.Source.Denom
+			if k.validateTransferRestrictions(ctx, owner, intermediate, intermediate) != nil {
+				break
+			}
+		}
+
 		// All variables are named from the perspective of the passive order
 
 		stepDestinationFilled := plan.DestinationCapacity()
@@ -357,6 +382,37 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 	return nil
 }
 
+// validateTransferRestrictions verifies that the account may send the src and receive the dst denomination.
+func (k Keeper) validateTransferRestrictions(ctx sdk.Context, account sdk.AccAddress, src, dst string) error {
+	if err := k.bk.ValidateSend(ctx, account, sdk.NewCoins(sdk.NewCoin(src, sdk.OneInt()))); err != nil {
+		return err
+	}
+
+	return k.bk.ValidateReceive(ctx, account, sdk.NewCoins(sdk.NewCoin(dst, sdk.OneInt())))
+}
+
+// cancelRestrictedOrders removes the passive orders of the plan whose owners may no longer trade their instruments.
+func (k *Keeper) cancelRestrictedOrders(ctx sdk.Context, plan types.ExecutionPlan) (cancelled bool) {
+	for _, passiveOrder := range []*types.Order{plan.FirstOrder, plan.SecondOrder} {
+		if passiveOrder == nil {
+			continue
+		}
+
+		owner, err := sdk.AccAddressFromBech32(passiveOrder.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		if k.validateTransferRestrictions(ctx, owner, passiveOrder.Source.Denom, passiveOrder.Destination.Denom) != nil {
+			types.EmitExpireEvent(ctx, *passiveOrder)
+			k.deleteOrder(ctx, passiveOrder)
+			cancelled = true
+		}
+	}
+
+	return
+}
+
 // Check whether an asset even exists on the chain at the moment.
 func (k Keeper) assetExists(ctx sdk.Context, asset sdk.Coin) bool {
 	instr := k.bk.GetSupply(ctx, asset.Denom)
@@ -660,7 +716,18 @@ func (k Keeper) transferTradedAmounts(ctx sdk.Context, sourceFilled, destination
 		{Address: passiveAccountAddr, Coins: sdk.NewCoins(sourceFilled)},
 	}
 
-	return k.bk.InputOutputCoins(ctx, inputs, outputs)
+	if err := k.bk.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	aggressiveAccount, _ := sdk.AccAddressFromBech32(aggressiveAccountAddr)
+	passiveAccount, _ := sdk.AccAddressFromBech32(passiveAccountAddr)
+	for _, l := range k.tradeListeners {
+		l(ctx, aggressiveAccount, sourceFilled, destinationFilled)
+		l(ctx, passiveAccount, destinationFilled, sourceFilled)
+	}
+
+	return nil
 }
 
 func (k Keeper) getNextOrderNumber(ctx sdk.Context) uint64 {
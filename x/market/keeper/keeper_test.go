@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -1138,6 +1139,33 @@ func TestUnknownAsset(t *testing.T) {
 	require.Equal(t, gasPriceNewOrder, gasMeter.GasConsumed())
 }
 
+func TestTransferRestrictions(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "7400usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "7400usd")
+
+	restrictions := denylistMock{}
+	bk.SetTransferRestrictions(restrictions)
+
+	passiveOrder := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, passiveOrder))
+
+	// Aggressive orders of denylisted accounts are rejected
+	restrictions[acc2.GetAddress().String()+"eur"] = true
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur"))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// Passive orders of denylisted accounts are removed from the book instead of being matched
+	restrictions[acc1.GetAddress().String()+"usd"] = true
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), 1)
+	require.Equal(t, "5000eur", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "7400usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+}
+
 func TestVestingAccount(t *testing.T) {
 	ctx, keeper, ak, bk := createTestComponents(t)
 	account := createAccount(ctx, ak, bk, randomAddress(), "110000eur")
@@ -1531,6 +1559,26 @@ func coins(s string) sdk.Coins {
 	return coins
 }
 
+// denylistMock restricts transfers of the denominations keyed by bech32 address and denomination.
+type denylistMock map[string]bool
+
+func (m denylistMock) ValidateSend(_ sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error {
+	return m.validate(sender, amt)
+}
+
+func (m denylistMock) ValidateReceive(_ sdk.Context, recipient sdk.AccAddress, amt sdk.Coins) error {
+	return m.validate(recipient, amt)
+}
+
+func (m denylistMock) validate(addr sdk.AccAddress, amt sdk.Coins) error {
+	for _, c := range amt {
+		if m[addr.String()+c.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s restricted", c.Denom)
+		}
+	}
+	return nil
+}
+
 func order(createdTm time.Time, account authtypes.AccountI, src, dst string) types.Order {
 	o, err := types.NewOrder(
 		createdTm, types.TimeInForce_GoodTillCancel, coin(src), coin(dst),
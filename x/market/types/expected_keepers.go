@@ -23,5 +23,7 @@ type (
 		GetSupply(ctx sdk.Context, denom string) sdk.Coin
 		GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress))
+		ValidateSend(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error
+		ValidateReceive(ctx sdk.Context, recipient sdk.AccAddress, amt sdk.Coins) error
 	}
 )
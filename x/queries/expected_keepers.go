@@ -1,9 +1,12 @@
 package queries
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/e-money/em-ledger/x/slashing/types"
 )
 
 type AccountKeeper interface {
@@ -20,4 +23,7 @@ type BankKeeper interface {
 
 type SlashingKeeper interface {
 	GetMissedBlocks(ctx sdk.Context, consAddr sdk.ConsAddress) (int64, int64)
+	GetMissedBlocksTimeline(ctx sdk.Context, consAddr sdk.ConsAddress) slashingtypes.MissedBlocksTimeline
+	SignedBlocksWindowDuration(ctx sdk.Context) time.Duration
+	MinSignedPerWindow(ctx sdk.Context) sdk.Dec
 }
@@ -123,3 +123,25 @@ func (k Querier) MissedBlocks(c context.Context, req *types.QueryMissedBlocksReq
 		},
 	}, nil
 }
+
+func (k Querier) MissedBlocksTimeline(c context.Context, req *types.QueryMissedBlocksTimelineRequest) (*types.QueryMissedBlocksTimelineResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator consensus address: "+err.Error())
+	}
+
+	timeline := k.sk.GetMissedBlocksTimeline(ctx, consAddr)
+	return &types.QueryMissedBlocksTimelineResponse{
+		ConsAddress:        req.ConsAddress,
+		MissedBlocks:       timeline.MissedBlocks,
+		TotalBlocksCounter: timeline.TotalBlocks,
+		SignedBlocksWindow: k.sk.SignedBlocksWindowDuration(ctx),
+		MissedRatio:        timeline.MissedRatio,
+		MinSignedPerWindow: k.sk.MinSignedPerWindow(ctx),
+		ProjectedJailTime:  timeline.ProjectedJailTime,
+	}, nil
+}
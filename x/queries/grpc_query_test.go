@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -11,11 +12,17 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/e-money/em-ledger/x/queries/types"
+	slashingtypes "github.com/e-money/em-ledger/x/slashing/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 )
 
+var (
+	missedBlockTime   = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	projectedJailTime = missedBlockTime.Add(50 * time.Minute)
+)
+
 func newQServer() (context.Context, sdk.Context, types.QueryClient, bankKeeperMock) {
 	sdkCtx := sdk.Context{}.WithContext(context.Background())
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -48,6 +55,14 @@ func newQServer() (context.Context, sdk.Context, types.QueryClient, bankKeeperMo
 				TotalBlocksCounter:  10,
 			},
 		},
+		timelines: map[string]slashingtypes.MissedBlocksTimeline{
+			"cosmosvalcons1g0t3yc0twz8d2ex05ek0gsv57edgmx6mnxkzlu": {
+				MissedBlocks:      []time.Time{missedBlockTime},
+				TotalBlocks:       10,
+				MissedRatio:       sdk.NewDecWithPrec(1, 1),
+				ProjectedJailTime: &projectedJailTime,
+			},
+		},
 	}
 
 	types.RegisterQueryServer(
@@ -96,6 +111,26 @@ func TestMissedBlocks(t *testing.T) {
 	assert.Equal(t, int64(10), gotMBRsp.MissedBlocksInfo.TotalBlocksCounter)
 }
 
+func TestMissedBlocksTimeline(t *testing.T) {
+	ctx, _, queryClient, _ := newQServer()
+
+	res, err := queryClient.MissedBlocksTimeline(
+		ctx, &types.QueryMissedBlocksTimelineRequest{
+			ConsAddress: "cosmosvalcons1g0t3yc0twz8d2ex05ek0gsv57edgmx6mnxkzlu",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{missedBlockTime}, res.MissedBlocks)
+	assert.Equal(t, int64(10), res.TotalBlocksCounter)
+	assert.Equal(t, time.Hour, res.SignedBlocksWindow)
+	assert.Equal(t, sdk.NewDecWithPrec(1, 1), res.MissedRatio)
+	assert.Equal(t, sdk.NewDecWithPrec(1, 1), res.MinSignedPerWindow)
+	assert.Equal(t, &projectedJailTime, res.ProjectedJailTime)
+
+	_, err = queryClient.MissedBlocksTimeline(ctx, &types.QueryMissedBlocksTimelineRequest{ConsAddress: "invalid"})
+	require.Error(t, err)
+}
+
 func mustParseCoins(s string) sdk.Coins {
 	if c, err := sdk.ParseCoinsNormalized(s); err == nil {
 		return c
@@ -112,6 +147,7 @@ func (a accountKeeperMock) GetModuleAccount(_ sdk.Context, moduleName string) au
 
 type slashingKeeperMock struct {
 	missedBlocksMap map[string]types.MissedBlocksInfo
+	timelines       map[string]slashingtypes.MissedBlocksTimeline
 }
 
 func (s slashingKeeperMock) GetMissedBlocks(_ sdk.Context, consAddr sdk.ConsAddress) (int64, int64) {
@@ -119,6 +155,18 @@ func (s slashingKeeperMock) GetMissedBlocks(_ sdk.Context, consAddr sdk.ConsAddr
 		s.missedBlocksMap[consAddr.String()].TotalBlocksCounter
 }
 
+func (s slashingKeeperMock) GetMissedBlocksTimeline(_ sdk.Context, consAddr sdk.ConsAddress) slashingtypes.MissedBlocksTimeline {
+	return s.timelines[consAddr.String()]
+}
+
+func (s slashingKeeperMock) SignedBlocksWindowDuration(sdk.Context) time.Duration {
+	return time.Hour
+}
+
+func (s slashingKeeperMock) MinSignedPerWindow(sdk.Context) sdk.Dec {
+	return sdk.NewDecWithPrec(1, 1)
+}
+
 type bankKeeperMock struct {
 	balances map[string]sdk.Coins
 	vesting  sdk.Coins
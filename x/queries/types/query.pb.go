@@ -11,20 +11,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -352,6 +356,132 @@ func (m *MissedBlocksInfo) GetTotalBlocksCounter() int64 {
 	return 0
 }
 
+type QueryMissedBlocksTimelineRequest struct {
+	// cons_address is the address of the validator to query the timeline for
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksTimelineRequest) Reset()         { *m = QueryMissedBlocksTimelineRequest{} }
+func (m *QueryMissedBlocksTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksTimelineRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{7}
+}
+func (m *QueryMissedBlocksTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksTimelineRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksTimelineRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksTimelineRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+type QueryMissedBlocksTimelineResponse struct {
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty" yaml:"cons_address"`
+	// Block times of the blocks missed within the signed blocks window
+	MissedBlocks       []time.Time                            `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3,stdtime" json:"missed_blocks" yaml:"missed_blocks"`
+	TotalBlocksCounter int64                                  `protobuf:"varint,3,opt,name=total_blocks_counter,json=totalBlocksCounter,proto3" json:"total_blocks_counter,omitempty" yaml:"total_blocks_counter"`
+	SignedBlocksWindow time.Duration                          `protobuf:"bytes,4,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3,stdduration" json:"signed_blocks_window" yaml:"signed_blocks_window"`
+	MissedRatio        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=missed_ratio,json=missedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"missed_ratio" yaml:"missed_ratio"`
+	MinSignedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	// Time at which the validator is jailed if it keeps missing blocks. Not set
+	// if the validator is jailed already or would not be jailed.
+	ProjectedJailTime *time.Time `protobuf:"bytes,7,opt,name=projected_jail_time,json=projectedJailTime,proto3,stdtime" json:"projected_jail_time,omitempty" yaml:"projected_jail_time"`
+}
+
+func (m *QueryMissedBlocksTimelineResponse) Reset()         { *m = QueryMissedBlocksTimelineResponse{} }
+func (m *QueryMissedBlocksTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksTimelineResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{8}
+}
+func (m *QueryMissedBlocksTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksTimelineResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksTimelineResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksTimelineResponse) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryMissedBlocksTimelineResponse) GetMissedBlocks() []time.Time {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *QueryMissedBlocksTimelineResponse) GetTotalBlocksCounter() int64 {
+	if m != nil {
+		return m.TotalBlocksCounter
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksTimelineResponse) GetSignedBlocksWindow() time.Duration {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksTimelineResponse) GetProjectedJailTime() *time.Time {
+	if m != nil {
+		return m.ProjectedJailTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCirculatingRequest)(nil), "em.queries.v1.QueryCirculatingRequest")
 	proto.RegisterType((*QueryCirculatingResponse)(nil), "em.queries.v1.QueryCirculatingResponse")
@@ -360,55 +490,75 @@ func init() {
 	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "em.queries.v1.QueryMissedBlocksRequest")
 	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "em.queries.v1.QueryMissedBlocksResponse")
 	proto.RegisterType((*MissedBlocksInfo)(nil), "em.queries.v1.MissedBlocksInfo")
+	proto.RegisterType((*QueryMissedBlocksTimelineRequest)(nil), "em.queries.v1.QueryMissedBlocksTimelineRequest")
+	proto.RegisterType((*QueryMissedBlocksTimelineResponse)(nil), "em.queries.v1.QueryMissedBlocksTimelineResponse")
 }
 
 func init() { proto.RegisterFile("em/queries/v1/query.proto", fileDescriptor_2c8a9303ec3ad728) }
 
 var fileDescriptor_2c8a9303ec3ad728 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xcf, 0x35, 0xdf, 0x7e, 0x4b, 0x2f, 0x05, 0x55, 0x47, 0x0b, 0x49, 0xa8, 0xec, 0x60, 0x95,
-	0x92, 0xa1, 0xf5, 0x91, 0xb0, 0xa0, 0x4a, 0x48, 0xe0, 0x0a, 0x24, 0x06, 0x86, 0xa6, 0x4c, 0x2c,
-	0xd5, 0xd9, 0xb9, 0xba, 0x56, 0xed, 0x3b, 0x37, 0x77, 0x29, 0x44, 0x15, 0x0b, 0x42, 0x6c, 0x48,
-	0x20, 0x16, 0x24, 0x96, 0xce, 0xec, 0xfc, 0x0f, 0x1d, 0x2b, 0xb1, 0x30, 0x05, 0xd4, 0x32, 0x74,
-	0xce, 0x5f, 0x80, 0x7c, 0xbe, 0x54, 0xce, 0x0f, 0x14, 0x98, 0xe2, 0xbc, 0xf7, 0xb9, 0xcf, 0xfb,
-	0x7c, 0xde, 0xbd, 0x77, 0xb0, 0x44, 0x23, 0xbc, 0xdf, 0xa6, 0xad, 0x80, 0x0a, 0x7c, 0x50, 0x53,
-	0x9f, 0x1d, 0x3b, 0x6e, 0x71, 0xc9, 0xd1, 0x65, 0x1a, 0xd9, 0x3a, 0x65, 0x1f, 0xd4, 0xca, 0x0b,
-	0x3e, 0xf7, 0xb9, 0xca, 0xe0, 0xe4, 0x2b, 0x05, 0x95, 0x0d, 0x8f, 0x8b, 0x88, 0x0b, 0xec, 0x12,
-	0x41, 0xf1, 0x41, 0xcd, 0xa5, 0x92, 0xd4, 0xb0, 0xc7, 0x03, 0xa6, 0xf3, 0x4b, 0x3e, 0xe7, 0x7e,
-	0x48, 0x31, 0x89, 0x03, 0x4c, 0x18, 0xe3, 0x92, 0xc8, 0x80, 0x33, 0xa1, 0xb3, 0xa6, 0xce, 0xaa,
-	0x7f, 0x6e, 0x7b, 0x07, 0xcb, 0x20, 0xa2, 0x42, 0x92, 0x28, 0x4e, 0x01, 0x56, 0x09, 0x5e, 0xdf,
-	0x4c, 0x24, 0x6d, 0x04, 0x2d, 0xaf, 0x1d, 0x12, 0x19, 0x30, 0xbf, 0x41, 0xf7, 0xdb, 0x54, 0x48,
-	0xeb, 0x1d, 0x80, 0xc5, 0xd1, 0x9c, 0x88, 0x39, 0x13, 0x14, 0xed, 0xc3, 0x69, 0xc9, 0x25, 0x09,
-	0x8b, 0xa0, 0x92, 0xaf, 0x16, 0xea, 0x25, 0x3b, 0x95, 0x69, 0x27, 0x32, 0x6d, 0x2d, 0xd3, 0xde,
-	0xe0, 0x01, 0x73, 0x1e, 0x1c, 0x77, 0xcd, 0x5c, 0xaf, 0x6b, 0xce, 0x75, 0x48, 0x14, 0xae, 0x5b,
-	0xea, 0x94, 0xf5, 0xe5, 0x87, 0x59, 0xf5, 0x03, 0xb9, 0xdb, 0x76, 0x6d, 0x8f, 0x47, 0x58, 0x7b,
-	0x4c, 0x7f, 0xd6, 0x44, 0x73, 0x0f, 0xcb, 0x4e, 0x4c, 0x85, 0x22, 0x10, 0x8d, 0xb4, 0x92, 0xf5,
-	0x08, 0x2e, 0x2a, 0x39, 0x5b, 0x31, 0x65, 0x4d, 0xe2, 0x86, 0x54, 0x0b, 0x45, 0xab, 0x70, 0x86,
-	0x34, 0x9b, 0x2d, 0x2a, 0x44, 0x11, 0x54, 0x40, 0x75, 0xd6, 0x41, 0xbd, 0xae, 0x79, 0x25, 0x2d,
-	0xa7, 0x13, 0x56, 0xa3, 0x0f, 0xb1, 0x3e, 0x00, 0x78, 0x6d, 0x98, 0x47, 0x9b, 0x7a, 0x01, 0x67,
-	0x5c, 0x12, 0x12, 0xe6, 0xd1, 0xc9, 0xb6, 0x1c, 0x6d, 0x4b, 0xd7, 0xd1, 0xe7, 0xfe, 0xcd, 0x58,
-	0xbf, 0x9a, 0x75, 0x5f, 0x77, 0xfa, 0x69, 0x20, 0x04, 0x6d, 0x3a, 0x21, 0xf7, 0xf6, 0x44, 0xdf,
-	0xdd, 0x4d, 0x38, 0xe7, 0x71, 0x26, 0xb6, 0x07, 0x2c, 0x36, 0x0a, 0x49, 0xec, 0xa1, 0xb6, 0x14,
-	0xc3, 0xd2, 0x98, 0xe3, 0xda, 0xd4, 0x16, 0x44, 0x91, 0x8a, 0x6f, 0xbb, 0x2a, 0xb1, 0x1d, 0xb0,
-	0x1d, 0xae, 0x58, 0x0a, 0x75, 0xd3, 0x1e, 0x18, 0x41, 0x3b, 0x4b, 0xf0, 0x84, 0xed, 0x70, 0xe7,
-	0xbf, 0xc4, 0x65, 0x63, 0x3e, 0x1a, 0x8a, 0x5b, 0xe7, 0x00, 0xce, 0x0f, 0x83, 0xff, 0x42, 0x29,
-	0x7a, 0x06, 0x17, 0x07, 0xc5, 0x78, 0xbc, 0xcd, 0x24, 0x6d, 0x15, 0xa7, 0x2a, 0xa0, 0x9a, 0x77,
-	0x2a, 0xbd, 0xae, 0xb9, 0x94, 0x36, 0x74, 0x2c, 0xcc, 0x6a, 0x5c, 0xcd, 0x4a, 0xd9, 0x48, 0xa3,
-	0x68, 0x13, 0x2e, 0xa8, 0x11, 0x19, 0x26, 0xcd, 0x2b, 0x52, 0xb3, 0xd7, 0x35, 0x6f, 0x64, 0x86,
-	0x6f, 0x84, 0x13, 0xa9, 0xf0, 0x00, 0xe5, 0xfa, 0xa5, 0x4f, 0x47, 0x26, 0x38, 0x3f, 0x32, 0x41,
-	0xfd, 0x6b, 0x1e, 0x4e, 0xab, 0xee, 0xa2, 0x37, 0x00, 0x16, 0x32, 0xbb, 0x80, 0x56, 0x86, 0xba,
-	0xf7, 0x87, 0x45, 0x2a, 0xdf, 0x9e, 0x88, 0x4b, 0xaf, 0xca, 0x5a, 0x7e, 0xfd, 0xed, 0xd7, 0xc7,
-	0x29, 0x03, 0x2d, 0x61, 0xba, 0x16, 0x71, 0x46, 0x3b, 0xd8, 0x25, 0x6c, 0x2f, 0x79, 0x36, 0xbc,
-	0x4c, 0xd9, 0xcf, 0x00, 0xce, 0x65, 0x7b, 0x8f, 0xc6, 0xf2, 0x8f, 0x19, 0xa5, 0x72, 0x75, 0x32,
-	0x50, 0x2b, 0xb9, 0xa7, 0x94, 0xd4, 0xd1, 0x9d, 0x0b, 0x25, 0x22, 0x24, 0x62, 0x37, 0x60, 0x7e,
-	0xa2, 0x26, 0xbd, 0x83, 0xb4, 0x8d, 0xf8, 0x30, 0x7b, 0xe7, 0xaf, 0xd0, 0x5b, 0x00, 0x67, 0x2f,
-	0x36, 0x0b, 0x2d, 0x8f, 0xab, 0x38, 0xbc, 0xc0, 0xe5, 0x5b, 0x13, 0x50, 0x5a, 0xd4, 0xaa, 0x12,
-	0xb5, 0x82, 0x96, 0x47, 0xda, 0x23, 0xfa, 0x58, 0x7c, 0xd8, 0x17, 0xe2, 0x3c, 0x3e, 0x3e, 0x35,
-	0xc0, 0xc9, 0xa9, 0x01, 0x7e, 0x9e, 0x1a, 0xe0, 0xfd, 0x99, 0x91, 0x3b, 0x39, 0x33, 0x72, 0xdf,
-	0xcf, 0x8c, 0xdc, 0xf3, 0xd5, 0xcc, 0x82, 0xf6, 0x99, 0x68, 0xb4, 0x16, 0xd2, 0xa6, 0x4f, 0x5b,
-	0xf8, 0xe5, 0xc5, 0x73, 0xad, 0x56, 0xd5, 0xfd, 0x5f, 0x3d, 0x94, 0x77, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x52, 0xe0, 0xfc, 0xc9, 0x05, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xe4, 0x47, 0xf3, 0xed, 0x38, 0xfd, 0xaa, 0x4c, 0x5c, 0x6a, 0x9b, 0xc8, 0xeb, 0x8e,
+	0x42, 0xea, 0x43, 0xb2, 0xdb, 0x04, 0x0e, 0x28, 0x12, 0x12, 0x6c, 0x1a, 0x24, 0x90, 0x40, 0x74,
+	0x13, 0x09, 0x89, 0x8b, 0x35, 0x5e, 0x4f, 0x9c, 0x69, 0x76, 0x67, 0x9c, 0x9d, 0x75, 0x42, 0x54,
+	0x71, 0x00, 0x21, 0x6e, 0x48, 0x45, 0x5c, 0x2a, 0x71, 0xe9, 0x99, 0x0b, 0xff, 0x46, 0x8f, 0x95,
+	0xb8, 0x20, 0x0e, 0x2e, 0x4a, 0x90, 0x28, 0x57, 0xff, 0x05, 0x68, 0x67, 0x66, 0xdd, 0xf5, 0x7a,
+	0xc1, 0xc9, 0x81, 0x93, 0x77, 0xe6, 0xbd, 0xf9, 0xbc, 0xcf, 0xe7, 0xcd, 0x7b, 0x6f, 0x0c, 0xab,
+	0x34, 0x74, 0x8e, 0xfb, 0x34, 0x62, 0x54, 0x3a, 0x27, 0x9b, 0xea, 0xf3, 0xcc, 0xee, 0x45, 0x22,
+	0x16, 0xe8, 0x06, 0x0d, 0x6d, 0x63, 0xb2, 0x4f, 0x36, 0x6b, 0xe5, 0xae, 0xe8, 0x0a, 0x65, 0x71,
+	0x92, 0x2f, 0xed, 0x54, 0xab, 0xfb, 0x42, 0x86, 0x42, 0x3a, 0x6d, 0x22, 0xa9, 0x73, 0xb2, 0xd9,
+	0xa6, 0x31, 0xd9, 0x74, 0x7c, 0xc1, 0xb8, 0xb1, 0xaf, 0x74, 0x85, 0xe8, 0x06, 0xd4, 0x21, 0x3d,
+	0xe6, 0x10, 0xce, 0x45, 0x4c, 0x62, 0x26, 0xb8, 0x4c, 0x4f, 0x1b, 0xab, 0x5a, 0xb5, 0xfb, 0x07,
+	0x4e, 0xa7, 0x1f, 0x29, 0x07, 0x63, 0xb7, 0xf2, 0xf6, 0x98, 0x85, 0x54, 0xc6, 0x24, 0xec, 0x69,
+	0x07, 0x5c, 0x85, 0xb7, 0x1f, 0x24, 0x94, 0x77, 0x58, 0xe4, 0xf7, 0x03, 0x12, 0x33, 0xde, 0xf5,
+	0xe8, 0x71, 0x9f, 0xca, 0x18, 0x7f, 0x07, 0x60, 0x65, 0xd2, 0x26, 0x7b, 0x82, 0x4b, 0x8a, 0x8e,
+	0xe1, 0x42, 0x2c, 0x62, 0x12, 0x54, 0x40, 0x63, 0xae, 0x59, 0xda, 0xaa, 0xda, 0x5a, 0x86, 0x9d,
+	0xc8, 0xb0, 0x8d, 0x0c, 0x7b, 0x47, 0x30, 0xee, 0xbe, 0xf7, 0x6c, 0x60, 0xcd, 0x0c, 0x07, 0xd6,
+	0xd2, 0x19, 0x09, 0x83, 0x6d, 0xac, 0x4e, 0xe1, 0x9f, 0x5e, 0x58, 0xcd, 0x2e, 0x8b, 0x0f, 0xfb,
+	0x6d, 0xdb, 0x17, 0xa1, 0x63, 0x72, 0xa0, 0x7f, 0x36, 0x64, 0xe7, 0xc8, 0x89, 0xcf, 0x7a, 0x54,
+	0x2a, 0x00, 0xe9, 0xe9, 0x48, 0x78, 0x17, 0xde, 0x52, 0x74, 0xf6, 0x7a, 0x94, 0x77, 0x48, 0x3b,
+	0xa0, 0x86, 0x28, 0x5a, 0x87, 0x8b, 0xa4, 0xd3, 0x89, 0xa8, 0x94, 0x15, 0xd0, 0x00, 0xcd, 0xeb,
+	0x2e, 0x1a, 0x0e, 0xac, 0xff, 0xeb, 0x70, 0xc6, 0x80, 0xbd, 0xd4, 0x05, 0x7f, 0x0f, 0xe0, 0xeb,
+	0x79, 0x1c, 0x23, 0xea, 0x14, 0x2e, 0xb6, 0x49, 0x40, 0xb8, 0x4f, 0xa7, 0xcb, 0x72, 0x8d, 0x2c,
+	0x13, 0xc7, 0x9c, 0xbb, 0x9a, 0xb0, 0x34, 0x1a, 0x7e, 0xd7, 0x64, 0xfa, 0x63, 0x26, 0x25, 0xed,
+	0xb8, 0x81, 0xf0, 0x8f, 0x64, 0xaa, 0xee, 0x0e, 0x5c, 0xf2, 0x05, 0x97, 0xad, 0x31, 0x89, 0x5e,
+	0x29, 0xd9, 0x7b, 0xdf, 0x48, 0xea, 0xc1, 0x6a, 0xc1, 0x71, 0x23, 0x6a, 0x0f, 0xa2, 0x50, 0xed,
+	0xb7, 0xda, 0xca, 0xd0, 0x62, 0xfc, 0x40, 0x28, 0x94, 0xd2, 0x96, 0x65, 0x8f, 0x95, 0xa8, 0x9d,
+	0x05, 0xf8, 0x90, 0x1f, 0x08, 0x77, 0x3e, 0x51, 0xe9, 0xdd, 0x0c, 0x73, 0xfb, 0xf8, 0x25, 0x80,
+	0x37, 0xf3, 0xce, 0x97, 0x60, 0x8a, 0xf6, 0xe1, 0xad, 0x71, 0x32, 0xbe, 0xe8, 0xf3, 0x98, 0x46,
+	0x95, 0xd9, 0x06, 0x68, 0xce, 0xb9, 0x8d, 0xe1, 0xc0, 0x5a, 0xd1, 0x09, 0x2d, 0x74, 0xc3, 0xde,
+	0x72, 0x96, 0xca, 0x8e, 0xde, 0x45, 0x0f, 0x60, 0x59, 0x95, 0x48, 0x1e, 0x74, 0x4e, 0x81, 0x5a,
+	0xc3, 0x81, 0xf5, 0x46, 0xa6, 0xf8, 0x26, 0x30, 0x91, 0xda, 0x1e, 0x83, 0xdc, 0xfe, 0xdf, 0x93,
+	0xa7, 0x16, 0x78, 0xf9, 0xd4, 0x02, 0x78, 0x17, 0x36, 0x26, 0x92, 0xbb, 0xcf, 0x42, 0x1a, 0x30,
+	0x4e, 0xaf, 0x70, 0x47, 0x7f, 0x2d, 0xc0, 0x3b, 0xff, 0x82, 0x63, 0x2e, 0x6b, 0xbb, 0x08, 0xc8,
+	0xbd, 0x3d, 0x1c, 0x58, 0xcb, 0x5a, 0x41, 0xd6, 0x8a, 0xc7, 0x73, 0x4b, 0xe0, 0x8d, 0xb1, 0xa4,
+	0x55, 0x66, 0x55, 0x0d, 0xd7, 0x6c, 0x3d, 0x03, 0xec, 0x74, 0x06, 0xd8, 0xfb, 0xe9, 0x0c, 0x70,
+	0x1b, 0xa6, 0x88, 0xcb, 0x05, 0x39, 0xc7, 0x8f, 0x5f, 0x58, 0xc0, 0x5b, 0xca, 0xe6, 0xfb, 0x3f,
+	0x48, 0x34, 0x8a, 0x61, 0x59, 0xb2, 0x2e, 0x7f, 0x75, 0xd5, 0xa7, 0x8c, 0x77, 0xc4, 0x69, 0x65,
+	0x5e, 0x15, 0x68, 0x75, 0x82, 0xfc, 0x7d, 0x33, 0xe0, 0xdc, 0xbb, 0x86, 0xbb, 0x89, 0x58, 0x04,
+	0x82, 0x9f, 0x24, 0x12, 0x90, 0x36, 0xe9, 0xb0, 0x9f, 0x29, 0x03, 0x3a, 0x84, 0x46, 0x58, 0x4b,
+	0xa1, 0x55, 0x16, 0x54, 0x9e, 0x77, 0x13, 0xc8, 0xdf, 0x06, 0xd6, 0xda, 0x25, 0x3a, 0xf8, 0x3e,
+	0xf5, 0x5f, 0xdd, 0x4a, 0x16, 0x0b, 0x7b, 0x25, 0xbd, 0xf4, 0x92, 0x15, 0xfa, 0x0a, 0x24, 0x25,
+	0xcf, 0x5b, 0x86, 0x5f, 0x8f, 0x46, 0xa9, 0xc2, 0x6b, 0x2a, 0xe6, 0x27, 0x57, 0x8e, 0x39, 0x6a,
+	0x90, 0x02, 0x50, 0xec, 0xa1, 0x90, 0xf1, 0x3d, 0xb5, 0xfd, 0x29, 0x8d, 0x8c, 0x5a, 0x0e, 0x97,
+	0x7b, 0x91, 0x78, 0x48, 0xfd, 0x98, 0x76, 0x5a, 0x0f, 0x09, 0x0b, 0x5a, 0xc9, 0x33, 0x50, 0x59,
+	0x6c, 0x80, 0x29, 0xf5, 0x81, 0x87, 0x03, 0xab, 0xa6, 0xc3, 0x15, 0x00, 0xe8, 0x0a, 0x79, 0x6d,
+	0x64, 0xf9, 0x88, 0xb0, 0x20, 0x39, 0xbb, 0xf5, 0xe7, 0x3c, 0x5c, 0x50, 0xb5, 0x8e, 0xbe, 0x01,
+	0xb0, 0x94, 0x79, 0x3e, 0xd0, 0x5a, 0x6e, 0xe0, 0xfc, 0xc3, 0xdb, 0x53, 0xbb, 0x3b, 0xd5, 0x4f,
+	0x37, 0x0c, 0x5e, 0xfd, 0xfa, 0x97, 0x3f, 0x7e, 0x98, 0xad, 0xa3, 0x15, 0x87, 0x6e, 0x84, 0x82,
+	0xd3, 0x33, 0xa7, 0x4d, 0xf8, 0x51, 0xf2, 0x12, 0xfb, 0x99, 0xb0, 0x3f, 0x02, 0xb8, 0x94, 0xed,
+	0x3b, 0x54, 0x88, 0x5f, 0x30, 0x7d, 0x6b, 0xcd, 0xe9, 0x8e, 0x86, 0xc9, 0x3b, 0x8a, 0xc9, 0x16,
+	0xba, 0x37, 0x62, 0x22, 0x03, 0x22, 0x0f, 0x19, 0xef, 0x26, 0x6c, 0x74, 0x49, 0xe8, 0xea, 0x74,
+	0x1e, 0x65, 0xbb, 0xf8, 0x4b, 0xf4, 0x33, 0x80, 0xe5, 0xa2, 0xa9, 0x80, 0x9c, 0x69, 0xc1, 0x73,
+	0x73, 0xa8, 0x76, 0xef, 0xf2, 0x07, 0x0c, 0xeb, 0xb7, 0x15, 0x6b, 0x1b, 0xad, 0x17, 0xb2, 0x8e,
+	0x8d, 0x7b, 0x9e, 0xf1, 0xb7, 0x00, 0x5e, 0x1f, 0x3d, 0x9f, 0x68, 0xb5, 0x28, 0x6a, 0xfe, 0x95,
+	0xae, 0xbd, 0x39, 0xc5, 0xcb, 0x10, 0x5a, 0x57, 0x84, 0xd6, 0xd0, 0xea, 0xc4, 0x85, 0xca, 0xd4,
+	0xd7, 0x79, 0x94, 0x12, 0x71, 0x3f, 0x78, 0x76, 0x5e, 0x07, 0xcf, 0xcf, 0xeb, 0xe0, 0xf7, 0xf3,
+	0x3a, 0x78, 0x7c, 0x51, 0x9f, 0x79, 0x7e, 0x51, 0x9f, 0xf9, 0xf5, 0xa2, 0x3e, 0xf3, 0xf9, 0x7a,
+	0xa6, 0x9f, 0x52, 0x24, 0x1a, 0x6e, 0x04, 0xb4, 0xd3, 0xa5, 0x91, 0xf3, 0xc5, 0xe8, 0x3f, 0x9b,
+	0xea, 0xac, 0xf6, 0x35, 0x55, 0xfc, 0x6f, 0xfd, 0x3d, 0x00, 0x70, 0x47, 0xe4, 0x12, 0xce, 0x09,
+	0x00, 0x00,
 }
 
 func (this *MissedBlocksInfo) Equal(that interface{}) bool {
@@ -456,6 +606,7 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	Circulating(ctx context.Context, in *QueryCirculatingRequest, opts ...grpc.CallOption) (*QueryCirculatingResponse, error)
 	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	MissedBlocksTimeline(ctx context.Context, in *QueryMissedBlocksTimelineRequest, opts ...grpc.CallOption) (*QueryMissedBlocksTimelineResponse, error)
 	Spendable(ctx context.Context, in *QuerySpendableRequest, opts ...grpc.CallOption) (*QuerySpendableResponse, error)
 }
 
@@ -485,6 +636,15 @@ func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocksTimeline(ctx context.Context, in *QueryMissedBlocksTimelineRequest, opts ...grpc.CallOption) (*QueryMissedBlocksTimelineResponse, error) {
+	out := new(QueryMissedBlocksTimelineResponse)
+	err := c.cc.Invoke(ctx, "/em.queries.v1.Query/MissedBlocksTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Spendable(ctx context.Context, in *QuerySpendableRequest, opts ...grpc.CallOption) (*QuerySpendableResponse, error) {
 	out := new(QuerySpendableResponse)
 	err := c.cc.Invoke(ctx, "/em.queries.v1.Query/Spendable", in, out, opts...)
@@ -498,6 +658,7 @@ func (c *queryClient) Spendable(ctx context.Context, in *QuerySpendableRequest,
 type QueryServer interface {
 	Circulating(context.Context, *QueryCirculatingRequest) (*QueryCirculatingResponse, error)
 	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	MissedBlocksTimeline(context.Context, *QueryMissedBlocksTimelineRequest) (*QueryMissedBlocksTimelineResponse, error)
 	Spendable(context.Context, *QuerySpendableRequest) (*QuerySpendableResponse, error)
 }
 
@@ -511,6 +672,9 @@ func (*UnimplementedQueryServer) Circulating(ctx context.Context, req *QueryCirc
 func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocksTimeline(ctx context.Context, req *QueryMissedBlocksTimelineRequest) (*QueryMissedBlocksTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocksTimeline not implemented")
+}
 func (*UnimplementedQueryServer) Spendable(ctx context.Context, req *QuerySpendableRequest) (*QuerySpendableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Spendable not implemented")
 }
@@ -555,6 +719,24 @@ func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocksTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocksTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.queries.v1.Query/MissedBlocksTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocksTimeline(ctx, req.(*QueryMissedBlocksTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Spendable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySpendableRequest)
 	if err := dec(in); err != nil {
@@ -585,6 +767,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MissedBlocks",
 			Handler:    _Query_MissedBlocks_Handler,
 		},
+		{
+			MethodName: "MissedBlocksTimeline",
+			Handler:    _Query_MissedBlocksTimeline_Handler,
+		},
 		{
 			MethodName: "Spendable",
 			Handler:    _Query_Spendable_Handler,
@@ -824,6 +1010,121 @@ func (m *MissedBlocksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMissedBlocksTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectedJailTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ProjectedJailTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ProjectedJailTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MissedRatio.Size()
+		i -= size
+		if _, err := m.MissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SignedBlocksWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SignedBlocksWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.TotalBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.MissedBlocks[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.MissedBlocks[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -930,6 +1231,51 @@ func (m *MissedBlocksInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryMissedBlocksTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBlocksCounter))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SignedBlocksWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MissedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProjectedJailTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ProjectedJailTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1521,6 +1867,360 @@ func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMissedBlocksTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksTimelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksTimelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.MissedBlocks[len(m.MissedBlocks)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocksCounter", wireType)
+			}
+			m.TotalBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SignedBlocksWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedJailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectedJailTime == nil {
+				m.ProjectedJailTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ProjectedJailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
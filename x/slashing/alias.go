@@ -17,10 +17,12 @@ const (
 )
 
 var (
-	NewKeeper    = keeper.NewKeeper
-	BeginBlocker = keeper.BeginBlocker
+	NewKeeper      = keeper.NewKeeper
+	NewSnapshotter = keeper.NewSnapshotter
+	BeginBlocker   = keeper.BeginBlocker
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper      = keeper.Keeper
+	Snapshotter = keeper.Snapshotter
 )
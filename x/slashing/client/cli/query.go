@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkcli "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+	"github.com/e-money/em-ledger/x/queries/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the SDK slashing query commands extended with the e-money specific ones.
+func GetQueryCmd() *cobra.Command {
+	cmd := sdkcli.GetQueryCmd()
+	cmd.AddCommand(GetTimelineCmd())
+	return cmd
+}
+
+func GetTimelineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeline [validator-cons-address]",
+		Short: "Query the blocks missed by a validator within the signed blocks window",
+		Long: `Query the block times of the blocks missed by a validator within the signed blocks window, the
+ratio of missed blocks and the time the validator would be jailed if it keeps missing blocks.`,
+		Example: "emd query slashing timeline emoneyvalcons1weskc6tyv96x7ujlvdhkuum9deeh2u6ld8erde",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MissedBlocksTimeline(cmd.Context(), &types.QueryMissedBlocksTimelineRequest{
+				ConsAddress: consAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
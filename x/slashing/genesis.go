@@ -2,29 +2,70 @@ package slashing
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	sdkslashing "github.com/cosmos/cosmos-sdk/x/slashing"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/slashing/keeper"
+	"github.com/e-money/em-ledger/x/slashing/types"
 )
 
+// InitGenesis initializes the SDK slashing state and the liveness state kept outside of the IAVL store
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper sdkstakingkeeper.Keeper, data *types.GenesisState) {
+	sdkslashing.InitGenesis(ctx, keeper.Keeper, stakingKeeper, data.SDKGenesisState())
+	keeper.SetLivenessWarningThresholds(ctx, data.LivenessWarningThresholds)
+	keeper.SetDowntimePenaltyCurve(ctx, data.DowntimePenaltyCurve)
+	keeper.SetDowntimeOffencePeriod(ctx, data.DowntimeOffencePeriod)
+
+	batch := apptypes.GetCurrentBatch(ctx)
+	if batch == nil {
+		panic("batch object not found")
+	}
+
+	if err := keeper.ImportLivenessState(batch, data.Liveness); err != nil {
+		panic(err)
+	}
+
+	for _, history := range data.DowntimeOffences {
+		keeper.SetDowntimeOffences(ctx, history)
+	}
+}
+
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisState) {
 	params := keeper.GetParams(ctx)
 
-	signingInfos := make([]types.SigningInfo, 0)
+	signingInfos := make([]sdkslashingtypes.SigningInfo, 0)
 
-	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info sdkslashingtypes.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
-		signingInfos = append(signingInfos, types.SigningInfo{
+		signingInfos = append(signingInfos, sdkslashingtypes.SigningInfo{
 			Address:              bechAddr,
 			ValidatorSigningInfo: info,
 		})
 
-		// Note: Omitting missed blocks in export.
+		// Note: Missed blocks are exported with their block times in the liveness state.
 
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, nil)
+	downtimeOffences := make([]types.ValidatorDowntimeOffences, 0)
+	keeper.IterateDowntimeOffences(ctx, func(history types.ValidatorDowntimeOffences) (stop bool) {
+		downtimeOffences = append(downtimeOffences, history)
+		return false
+	})
+
+	return &types.GenesisState{
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     []sdkslashingtypes.ValidatorMissedBlocks{},
+		Liveness:         keeper.ExportLivenessState(),
+		DowntimeOffences: downtimeOffences,
+
+		LivenessWarningThresholds: keeper.LivenessWarningThresholds(ctx),
+		DowntimePenaltyCurve:      keeper.DowntimePenaltyCurve(ctx),
+		DowntimeOffencePeriod:     keeper.DowntimeOffencePeriod(ctx),
+	}
 }
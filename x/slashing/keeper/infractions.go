@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	emtypes "github.com/e-money/em-ledger/x/slashing/types"
 	"github.com/tendermint/tendermint/crypto"
 	db "github.com/tendermint/tm-db"
 )
@@ -48,17 +49,21 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 
 	k.setMissingBlocksForValidator(batch, consAddr, missedBlocks)
 
+	missedBlockCount := sdk.NewInt(int64(len(missedBlocks))).ToDec()
+	missedRatio := missedBlockCount.QuoInt64(blockCount)
+	minSignedPerWindow := k.MinSignedPerWindow(ctx)
+	maxMissedRatio := sdk.OneDec().Sub(minSignedPerWindow)
+
+	// Warn while the signed block window fills as well
+	k.updateLivenessWarning(ctx, batch, consAddr, len(missedBlocks), missedRatio, maxMissedRatio)
+
 	// Validator is only slashable if the signed block window is full (was truncated)
 	if !slashable {
 		return
 	}
 
-	missedBlockCount := sdk.NewInt(int64(len(missedBlocks))).ToDec()
-	missedRatio := missedBlockCount.QuoInt64(blockCount)
-	minSignedPerWindow := k.MinSignedPerWindow(ctx)
-
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
-	if sdk.OneDec().Sub(minSignedPerWindow).LT(missedRatio) {
+	if maxMissedRatio.LT(missedRatio) {
 		validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
 		if validator != nil && !validator.IsJailed() {
 			// Downtime confirmed: slash and jail the validator
@@ -72,6 +77,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+			// Chronic offenders are punished harder
+			slashFraction, jailDuration := k.downtimePenalty(ctx, consAddr)
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -79,10 +87,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(emtypes.AttributeKeySlashFraction, slashFraction.String()),
+					sdk.NewAttribute(emtypes.AttributeKeyJailDuration, jailDuration.String()),
 				),
 			)
 
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
 			// fetch signing info
@@ -91,10 +101,19 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 				panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 			}
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+
+			k.recordDowntimeOffence(ctx, consAddr, emtypes.DowntimeOffence{
+				Time:          ctx.BlockTime(),
+				Height:        height,
+				MissedRatio:   missedRatio,
+				SlashFraction: slashFraction,
+				JailDuration:  jailDuration,
+			})
 
 			// Reset number of blocks missed.
 			k.deleteMissingBlocksForValidator(batch, consAddr)
+			k.setLivenessWarning(batch, consAddr, sdk.ZeroDec())
 			k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 		} else {
 			// Validator was (a) not found or (b) already jailed, don't slash
@@ -104,3 +123,37 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 		}
 	}
 }
+
+// updateLivenessWarning emits a warning when the missed ratio of the validator reaches a higher threshold of the
+// allowed missed blocks than it was last warned about. The warned threshold drops along with the missed ratio, so
+// crossing it again is warned about again.
+func (k Keeper) updateLivenessWarning(ctx sdk.Context, batch db.Batch, consAddr sdk.ConsAddress, missed int, missedRatio, maxMissedRatio sdk.Dec) {
+	crossed := sdk.ZeroDec()
+	if missed > 0 {
+		for _, threshold := range k.LivenessWarningThresholds(ctx) {
+			if threshold.GT(crossed) && !missedRatio.LT(maxMissedRatio.Mul(threshold)) {
+				crossed = threshold
+			}
+		}
+	}
+
+	warned := k.getLivenessWarning(consAddr)
+	if crossed.Equal(warned) {
+		return
+	}
+
+	k.setLivenessWarning(batch, consAddr, crossed)
+	if crossed.LT(warned) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			emtypes.EventTypeLivenessWarning,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", missed)),
+			sdk.NewAttribute(emtypes.AttributeKeyThreshold, crossed.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
@@ -18,15 +18,16 @@ import (
 var _ evidencetypes.SlashingKeeper = Keeper{}
 
 const (
-	dbKeyMissedByVal = "%v.missedBlocks"
-	dbKeyBlockTimes  = "blocktimes"
+	dbKeyMissedByVal  = "%v.missedBlocks"
+	dbKeyWarningByVal = "%v.livenessWarning"
+	dbKeyBlockTimes   = "blocktimes"
 )
 
 type Keeper struct {
 	sdkslashingkeeper.Keeper
 	StoreKey sdk.StoreKey
 
-	paramspace sdkslashingtypes.ParamSubspace
+	paramspace types.ParamSubspace
 
 	cdc        codec.BinaryCodec
 	sk         sdkslashingtypes.StakingKeeper
@@ -40,11 +41,16 @@ func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
 	sk sdkslashingtypes.StakingKeeper,
-	paramspace sdkslashingtypes.ParamSubspace,
+	paramspace types.ParamSubspace,
 	bankKeeper sdkslashingtypes.BankKeeper,
 	database types.ReadOnlyDB,
 	feeModuleName string,
 ) Keeper {
+	// set KeyTable before the SDK keeper registers one without the e-money parameters
+	if !paramspace.HasKeyTable() {
+		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		Keeper:        sdkslashingkeeper.NewKeeper(cdc, key, sk, paramspace),
 		StoreKey:      key,
@@ -97,6 +103,39 @@ func (k Keeper) deleteMissingBlocksForValidator(batch db.Batch, address sdk.Cons
 	batch.Delete(key)
 }
 
+// getLivenessWarning returns the highest liveness warning threshold the validator has crossed. Zero if none.
+func (k Keeper) getLivenessWarning(address sdk.ConsAddress) sdk.Dec {
+	key := []byte(fmt.Sprintf(dbKeyWarningByVal, address.String()))
+	bz, err := k.database.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	var threshold sdk.Dec
+	if err := threshold.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return threshold
+}
+
+func (k Keeper) setLivenessWarning(batch db.Batch, address sdk.ConsAddress, threshold sdk.Dec) {
+	key := []byte(fmt.Sprintf(dbKeyWarningByVal, address.String()))
+	if !threshold.IsPositive() {
+		batch.Delete(key)
+		return
+	}
+
+	bz, err := threshold.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	batch.Set(key, bz)
+}
+
 func (k Keeper) getBlockTimes() []time.Time {
 	bz, err := k.database.Get([]byte(dbKeyBlockTimes))
 	if err != nil {
@@ -589,3 +589,70 @@ func blockTimeGenerator(blocktime time.Duration) func(int) time.Time {
 		return now
 	}
 }
+
+func TestLivenessWarnings(t *testing.T) {
+	ctx, keeper, _, _, _, database := createTestComponents(t)
+	params := keeperTestParams()
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	val := pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	nextBlocktime := blockTimeGenerator(10 * time.Second)
+
+	handleSignature := func(height int64, signed bool, blockCount int64, slashable bool) []map[string]string {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(nextBlocktime(1)).WithEventManager(sdk.NewEventManager())
+		batch := database.NewBatch()
+		keeper.HandleValidatorSignature(ctx, batch, val.Address(), 100, signed, blockCount, slashable)
+		require.NoError(t, batch.Write())
+
+		var warnings []map[string]string
+		for _, ev := range ctx.EventManager().Events() {
+			if ev.Type != types.EventTypeLivenessWarning {
+				continue
+			}
+			attrs := make(map[string]string)
+			for _, a := range ev.Attributes {
+				attrs[string(a.Key)] = string(a.Value)
+			}
+			warnings = append(warnings, attrs)
+		}
+		return warnings
+	}
+
+	// 50 of 100 blocks may be missed. Warnings are due at 25, 37.5 and 45 missed blocks, also while the window fills.
+	var warnings []map[string]string
+	for i := 0; i < 48; i++ {
+		warnings = append(warnings, handleSignature(int64(i), false, 100, false)...)
+	}
+
+	require.Len(t, warnings, 3)
+	expected := []struct{ missed, threshold string }{
+		{"25", "0.500000000000000000"},
+		{"38", "0.750000000000000000"},
+		{"45", "0.900000000000000000"},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.missed, warnings[i][sdkslashingtypes.AttributeKeyMissedBlocks])
+		require.Equal(t, e.threshold, warnings[i][types.AttributeKeyThreshold])
+		require.Equal(t, consAddr.String(), warnings[i][sdkslashingtypes.AttributeKeyAddress])
+	}
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), keeper.getLivenessWarning(consAddr))
+
+	// Staying at the same level does not repeat the warning
+	require.Empty(t, handleSignature(48, true, 100, true))
+
+	// The warning is reset when the missed ratio drops below the lowest threshold
+	require.Empty(t, handleSignature(49, true, 200, true))
+	require.True(t, keeper.getLivenessWarning(consAddr).IsZero())
+
+	// and given again when it rises
+	warnings = handleSignature(50, false, 100, true)
+	require.Len(t, warnings, 1)
+	require.Equal(t, "49", warnings[0][sdkslashingtypes.AttributeKeyMissedBlocks])
+	require.Equal(t, "0.900000000000000000", warnings[0][types.AttributeKeyThreshold])
+
+	// Thresholds can be changed through the parameters
+	keeper.SetLivenessWarningThresholds(ctx, []sdk.Dec{sdk.NewDecWithPrec(99, 2)})
+	require.Equal(t, []sdk.Dec{sdk.NewDecWithPrec(99, 2)}, keeper.LivenessWarningThresholds(ctx))
+}
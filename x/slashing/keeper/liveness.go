@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// ExportLivenessState returns the block times, missed blocks and liveness warnings kept in the non-IAVL database.
+func (k Keeper) ExportLivenessState() types.LivenessState {
+	state := types.LivenessState{
+		BlockTimes:   k.getBlockTimes(),
+		MissedBlocks: []types.ValidatorMissedBlockTimes{},
+		Warnings:     []types.ValidatorLivenessWarning{},
+	}
+
+	k.iterateValidatorKeys(dbKeyMissedByVal, func(address sdk.ConsAddress, _ []byte) {
+		missed := k.getMissingBlocksForValidator(address)
+		if len(missed) == 0 {
+			return
+		}
+
+		state.MissedBlocks = append(state.MissedBlocks, types.ValidatorMissedBlockTimes{
+			Address:          address.String(),
+			MissedBlockTimes: missed,
+		})
+	})
+
+	k.iterateValidatorKeys(dbKeyWarningByVal, func(address sdk.ConsAddress, _ []byte) {
+		state.Warnings = append(state.Warnings, types.ValidatorLivenessWarning{
+			Address:   address.String(),
+			Threshold: k.getLivenessWarning(address),
+		})
+	})
+
+	return state
+}
+
+// ImportLivenessState replaces the block times, missed blocks and liveness warnings in the non-IAVL database.
+func (k Keeper) ImportLivenessState(batch db.Batch, state types.LivenessState) error {
+	deleteKey := func(_ sdk.ConsAddress, key []byte) {
+		batch.Delete(key)
+	}
+	k.iterateValidatorKeys(dbKeyMissedByVal, deleteKey)
+	k.iterateValidatorKeys(dbKeyWarningByVal, deleteKey)
+
+	for _, m := range state.MissedBlocks {
+		address, err := sdk.ConsAddressFromBech32(m.Address)
+		if err != nil {
+			return err
+		}
+		k.setMissingBlocksForValidator(batch, address, m.MissedBlockTimes)
+	}
+
+	for _, w := range state.Warnings {
+		address, err := sdk.ConsAddressFromBech32(w.Address)
+		if err != nil {
+			return err
+		}
+		k.setLivenessWarning(batch, address, w.Threshold)
+	}
+
+	k.setBlockTimes(batch, state.BlockTimes)
+	return nil
+}
+
+// iterateValidatorKeys calls cb for every key of the database built from a validator address and the key format.
+func (k Keeper) iterateValidatorKeys(format string, cb func(address sdk.ConsAddress, key []byte)) {
+	suffix := strings.TrimPrefix(format, "%v")
+
+	it, err := k.database.Iterator(nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := string(it.Key())
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+
+		// The database is shared with other modules, so keys that are not validator addresses are skipped
+		address, err := sdk.ConsAddressFromBech32(strings.TrimSuffix(key, suffix))
+		if err != nil {
+			continue
+		}
+
+		cb(address, it.Key())
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLivenessStateExportImport(t *testing.T) {
+	_, keeper, _, _, _, database := createTestComponents(t)
+
+	var (
+		start    = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		val1     = sdk.ConsAddress(pks[0].Address())
+		val2     = sdk.ConsAddress(pks[1].Address())
+		val3     = sdk.ConsAddress(pks[2].Address())
+		expected = types.LivenessState{
+			BlockTimes: []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)},
+		}
+	)
+
+	batch := database.NewBatch()
+	keeper.setBlockTimes(batch, expected.BlockTimes)
+	keeper.setMissingBlocksForValidator(batch, val1, []time.Time{start.Add(time.Minute)})
+	keeper.setMissingBlocksForValidator(batch, val2, []time.Time{start, start.Add(2 * time.Minute)})
+	keeper.setMissingBlocksForValidator(batch, val3, nil)
+	keeper.setLivenessWarning(batch, val2, sdk.NewDecWithPrec(5, 1))
+	batch.Set([]byte("emdistr/previousproposer"), val1)
+	require.NoError(t, batch.Write())
+
+	state := keeper.ExportLivenessState()
+	require.Equal(t, expected.BlockTimes, state.BlockTimes)
+	require.ElementsMatch(t, []types.ValidatorMissedBlockTimes{
+		{Address: val1.String(), MissedBlockTimes: []time.Time{start.Add(time.Minute)}},
+		{Address: val2.String(), MissedBlockTimes: []time.Time{start, start.Add(2 * time.Minute)}},
+	}, state.MissedBlocks)
+	require.Equal(t, []types.ValidatorLivenessWarning{
+		{Address: val2.String(), Threshold: sdk.NewDecWithPrec(5, 1)},
+	}, state.Warnings)
+
+	// Import into a keeper with different data
+	_, other, _, _, _, otherDatabase := createTestComponents(t)
+	batch = otherDatabase.NewBatch()
+	other.setMissingBlocksForValidator(batch, val3, []time.Time{start})
+	other.setLivenessWarning(batch, val3, sdk.NewDecWithPrec(9, 1))
+	require.NoError(t, batch.Write())
+
+	batch = otherDatabase.NewBatch()
+	require.NoError(t, other.ImportLivenessState(batch, state))
+	require.NoError(t, batch.Write())
+
+	require.Equal(t, state, other.ExportLivenessState())
+	require.Empty(t, other.getMissingBlocksForValidator(val3))
+	require.True(t, other.getLivenessWarning(val3).IsZero())
+}
+
+func TestSnapshotter(t *testing.T) {
+	_, keeper, _, _, _, database := createTestComponents(t)
+
+	var (
+		start = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		val   = sdk.ConsAddress(pks[0].Address())
+	)
+
+	batch := database.NewBatch()
+	keeper.setBlockTimes(batch, []time.Time{start, start.Add(time.Minute)})
+	keeper.setMissingBlocksForValidator(batch, val, []time.Time{start.Add(time.Minute)})
+	require.NoError(t, batch.Write())
+
+	snapshotter := NewSnapshotter(keeper, database, 10)
+
+	// Only snapshot heights are captured
+	snapshotter.Capture(15)
+	require.Error(t, snapshotter.Snapshot(15, protoio.NewDelimitedWriter(new(bytes.Buffer))))
+
+	snapshotter.Capture(20)
+	expected := keeper.ExportLivenessState()
+
+	// Later blocks do not change the snapshot
+	batch = database.NewBatch()
+	keeper.setMissingBlocksForValidator(batch, val, []time.Time{start, start.Add(time.Minute)})
+	require.NoError(t, batch.Write())
+
+	buf := new(bytes.Buffer)
+	writer := protoio.NewDelimitedWriter(buf)
+	require.NoError(t, snapshotter.Snapshot(20, writer))
+
+	// Items of the next extension are handed back to the snapshot manager
+	next := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{Name: "next", Format: 1},
+		},
+	}
+	require.NoError(t, writer.WriteMsg(&next))
+
+	restoreDatabase := dbm.NewMemDB()
+	restorer := NewSnapshotter(Keeper{cdc: keeper.cdc, database: restoreDatabase}, restoreDatabase, 10)
+
+	_, err := restorer.Restore(20, SnapshotFormat+1, protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1e6))
+	require.Error(t, err)
+
+	item, err := restorer.Restore(20, SnapshotFormat, protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1e6))
+	require.NoError(t, err)
+	require.Equal(t, "next", item.GetExtension().Name)
+
+	require.Equal(t, expected, restorer.keeper.ExportLivenessState())
+}
@@ -23,3 +23,36 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &res)
 	return
 }
+
+// LivenessWarningThresholds returns the fractions of the allowed missed blocks at which a warning is emitted
+func (k Keeper) LivenessWarningThresholds(ctx sdk.Context) []sdk.Dec {
+	thresholds := types.DefaultLivenessWarningThresholds
+	k.paramspace.GetIfExists(ctx, types.KeyLivenessWarningThresholds, &thresholds)
+	return thresholds
+}
+
+func (k Keeper) SetLivenessWarningThresholds(ctx sdk.Context, thresholds []sdk.Dec) {
+	k.paramspace.Set(ctx, types.KeyLivenessWarningThresholds, thresholds)
+}
+
+// DowntimePenaltyCurve returns the multipliers of the downtime penalty by number of earlier offences
+func (k Keeper) DowntimePenaltyCurve(ctx sdk.Context) []types.DowntimePenaltyStep {
+	curve := types.DefaultDowntimePenaltyCurve
+	k.paramspace.GetIfExists(ctx, types.KeyDowntimePenaltyCurve, &curve)
+	return curve
+}
+
+func (k Keeper) SetDowntimePenaltyCurve(ctx sdk.Context, curve []types.DowntimePenaltyStep) {
+	k.paramspace.Set(ctx, types.KeyDowntimePenaltyCurve, curve)
+}
+
+// DowntimeOffencePeriod returns how long downtime offences count towards the penalty of later offences
+func (k Keeper) DowntimeOffencePeriod(ctx sdk.Context) time.Duration {
+	period := types.DefaultDowntimeOffencePeriod
+	k.paramspace.GetIfExists(ctx, types.KeyDowntimeOffencePeriod, &period)
+	return period
+}
+
+func (k Keeper) SetDowntimeOffencePeriod(ctx sdk.Context, period time.Duration) {
+	k.paramspace.Set(ctx, types.KeyDowntimeOffencePeriod, period)
+}
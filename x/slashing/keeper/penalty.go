@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+)
+
+var maxJailDuration = sdk.NewDec(math.MaxInt64)
+
+// downtimePenalty scales the downtime slash fraction and jail duration with the number of earlier offences of the
+// validator within the offence period. The missed ratio is not used, as it barely exceeds the allowed ratio whenever
+// a validator is slashed.
+func (k Keeper) downtimePenalty(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.Dec, time.Duration) {
+	offences := uint32(len(k.GetDowntimeOffences(ctx, consAddr)))
+
+	slashMultiplier, jailMultiplier := sdk.OneDec(), sdk.OneDec()
+	for _, step := range k.DowntimePenaltyCurve(ctx) {
+		if offences < step.Offences {
+			break
+		}
+		slashMultiplier, jailMultiplier = step.SlashMultiplier, step.JailMultiplier
+	}
+
+	slashFraction := k.SlashFractionDowntime(ctx).Mul(slashMultiplier)
+	slashFraction = sdk.MinDec(slashFraction, sdk.OneDec())
+
+	jailDuration := sdk.NewDec(int64(k.DowntimeJailDuration(ctx))).Mul(jailMultiplier)
+	jailDuration = sdk.MinDec(jailDuration, maxJailDuration)
+
+	return slashFraction, time.Duration(jailDuration.TruncateInt64())
+}
+
+// GetDowntimeOffences returns the downtime offences of the validator within the offence period.
+func (k Keeper) GetDowntimeOffences(ctx sdk.Context, consAddr sdk.ConsAddress) []types.DowntimeOffence {
+	bz := ctx.KVStore(k.StoreKey).Get(types.GetDowntimeOffencesKey(consAddr))
+	if bz == nil {
+		return nil
+	}
+
+	var history types.ValidatorDowntimeOffences
+	k.cdc.MustUnmarshal(bz, &history)
+	return k.withinOffencePeriod(ctx, history.Offences)
+}
+
+func (k Keeper) SetDowntimeOffences(ctx sdk.Context, history types.ValidatorDowntimeOffences) {
+	consAddr, err := sdk.ConsAddressFromBech32(history.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.StoreKey)
+	if len(history.Offences) == 0 {
+		store.Delete(types.GetDowntimeOffencesKey(consAddr))
+		return
+	}
+	store.Set(types.GetDowntimeOffencesKey(consAddr), k.cdc.MustMarshal(&history))
+}
+
+// recordDowntimeOffence adds the offence to the history of the validator and drops offences outside the offence period.
+func (k Keeper) recordDowntimeOffence(ctx sdk.Context, consAddr sdk.ConsAddress, offence types.DowntimeOffence) {
+	offences := append(k.GetDowntimeOffences(ctx, consAddr), offence)
+	k.SetDowntimeOffences(ctx, types.ValidatorDowntimeOffences{
+		Address:  consAddr.String(),
+		Offences: offences,
+	})
+}
+
+func (k Keeper) IterateDowntimeOffences(ctx sdk.Context, cb func(history types.ValidatorDowntimeOffences) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.StoreKey), types.DowntimeOffencesKeyPrefix)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var history types.ValidatorDowntimeOffences
+		k.cdc.MustUnmarshal(it.Value(), &history)
+		if cb(history) {
+			return
+		}
+	}
+}
+
+func (k Keeper) withinOffencePeriod(ctx sdk.Context, offences []types.DowntimeOffence) []types.DowntimeOffence {
+	threshold := ctx.BlockTime().Add(-k.DowntimeOffencePeriod(ctx))
+
+	res := make([]types.DowntimeOffence, 0, len(offences))
+	for _, o := range offences {
+		if o.Time.After(threshold) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
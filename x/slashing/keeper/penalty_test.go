@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestGraduatedDowntimePenalty(t *testing.T) {
+	ctx, keeper, _, _, sk, database := createTestComponents(t)
+	params := keeperTestParams()
+	params.SignedBlocksWindow = (10 * time.Minute).Nanoseconds()
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	keeper.SetDowntimePenaltyCurve(ctx, []types.DowntimePenaltyStep{
+		{Offences: 1, SlashMultiplier: sdk.NewDec(2), JailMultiplier: sdk.NewDec(3)},
+		{Offences: 2, SlashMultiplier: sdk.NewDec(10), JailMultiplier: sdk.NewDec(10)},
+	})
+	keeper.SetDowntimeOffencePeriod(ctx, 24*time.Hour)
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.OneInt())
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+
+	_, err := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, sk)
+
+	nextBlocktime := blockTimeGenerator(time.Minute)
+	ctx = ctx.WithBlockTime(nextBlocktime(0))
+
+	beginBlock := func(signed bool) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(nextBlocktime(1))
+		batch := database.NewBatch()
+		BeginBlocker(apptypes.WithCurrentBatch(ctx, batch), abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{
+				Votes: []abci.VoteInfo{{
+					Validator:       abci.Validator{Address: val.Address(), Power: power},
+					SignedLastBlock: signed,
+				}},
+			},
+		}, keeper)
+		require.NoError(t, batch.Write())
+	}
+
+	// missOffence fills the signed blocks window and misses blocks until the validator is jailed
+	missOffence := func() (sdk.Dec, time.Duration) {
+		for i := 0; i < 12; i++ {
+			beginBlock(true)
+		}
+		for i := 0; !sk.Validator(ctx, addr).IsJailed(); i++ {
+			require.Less(t, i, 12, "validator was not jailed")
+			beginBlock(false)
+		}
+		sk.Unjail(ctx, consAddr)
+
+		offences := keeper.GetDowntimeOffences(ctx, consAddr)
+		last := offences[len(offences)-1]
+		require.Equal(t, ctx.BlockTime(), last.Time)
+		// Validators are slashed as soon as the allowed ratio is exceeded, so the missed ratio stays just above it
+		require.True(t, last.MissedRatio.GT(sdk.NewDecWithPrec(5, 1)))
+		require.True(t, last.MissedRatio.LTE(sdk.NewDecWithPrec(7, 1)))
+
+		info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, ctx.BlockTime().Add(last.JailDuration), info.JailedUntil)
+		return last.SlashFraction, last.JailDuration
+	}
+
+	// A first offence gets the SDK penalty
+	slashFraction, jailDuration := missOffence()
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), slashFraction)
+	require.Equal(t, 30*time.Minute, jailDuration)
+
+	// Repeated offences within the offence period are punished harder
+	slashFraction, jailDuration = missOffence()
+	require.Equal(t, sdk.NewDecWithPrec(2, 3), slashFraction)
+	require.Equal(t, 90*time.Minute, jailDuration)
+
+	slashFraction, jailDuration = missOffence()
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), slashFraction)
+	require.Equal(t, 300*time.Minute, jailDuration)
+
+	// The last step applies to all further offences
+	slashFraction, jailDuration = missOffence()
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), slashFraction)
+	require.Equal(t, 300*time.Minute, jailDuration)
+	require.Len(t, keeper.GetDowntimeOffences(ctx, consAddr), 4)
+
+	// Offences outside the period are forgotten
+	ctx = ctx.WithBlockTime(nextBlocktime(int(24 * time.Hour / time.Minute)))
+	require.Empty(t, keeper.GetDowntimeOffences(ctx, consAddr))
+
+	slashFraction, jailDuration = missOffence()
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), slashFraction)
+	require.Equal(t, 30*time.Minute, jailDuration)
+	require.Len(t, keeper.GetDowntimeOffences(ctx, consAddr), 1)
+}
+
+func TestDowntimePenaltyDefaults(t *testing.T) {
+	ctx, keeper, _, _, _, _ := createTestComponents(t)
+	keeper.SetParams(ctx, keeperTestParams())
+
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	keeper.recordDowntimeOffence(ctx, consAddr, types.DowntimeOffence{Time: ctx.BlockTime()})
+
+	// Without a penalty curve the SDK parameters apply unchanged
+	slashFraction, jailDuration := keeper.downtimePenalty(ctx, consAddr)
+	require.Equal(t, keeper.SlashFractionDowntime(ctx), slashFraction)
+	require.Equal(t, keeper.DowntimeJailDuration(ctx), jailDuration)
+}
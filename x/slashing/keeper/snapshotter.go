@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	protoio "github.com/gogo/protobuf/io"
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	SnapshotName   = "emslashing"
+	SnapshotFormat = 1
+)
+
+var _ snapshottypes.ExtensionSnapshotter = &Snapshotter{}
+
+// Snapshotter adds the liveness state kept outside of the IAVL store to state-sync snapshots.
+type Snapshotter struct {
+	keeper   Keeper
+	database db.DB
+	interval uint64
+
+	mtx      sync.Mutex
+	captured map[uint64][]byte
+}
+
+func NewSnapshotter(keeper Keeper, database db.DB, interval uint64) *Snapshotter {
+	return &Snapshotter{
+		keeper:   keeper,
+		database: database,
+		interval: interval,
+		captured: make(map[uint64][]byte),
+	}
+}
+
+// Capture records the liveness state if a snapshot is taken at the height. Snapshots are created in the background
+// while the following blocks change the database, so the state must be read as soon as the block has been processed.
+func (s *Snapshotter) Capture(height int64) {
+	if s.interval == 0 || height <= 0 || uint64(height)%s.interval != 0 {
+		return
+	}
+
+	state := s.keeper.ExportLivenessState()
+	bz := s.keeper.cdc.MustMarshal(&state)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Drop states of snapshots that were never taken
+	for h := range s.captured {
+		if h < uint64(height) {
+			delete(s.captured, h)
+		}
+	}
+	s.captured[uint64(height)] = bz
+}
+
+func (s *Snapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+func (s *Snapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	s.mtx.Lock()
+	bz, found := s.captured[height]
+	delete(s.captured, height)
+	s.mtx.Unlock()
+
+	if !found {
+		return fmt.Errorf("liveness state of height %d was not captured", height)
+	}
+
+	return snapshottypes.WriteExtensionItem(protoWriter, bz)
+}
+
+func (s *Snapshotter) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	if format != SnapshotFormat {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	for {
+		var item snapshottypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return snapshottypes.SnapshotItem{}, nil
+		}
+		if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			// The item belongs to the next extension
+			return item, nil
+		}
+
+		var state types.LivenessState
+		if err := s.keeper.cdc.Unmarshal(payload.Payload, &state); err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid liveness state")
+		}
+
+		batch := s.database.NewBatch()
+		if err := s.keeper.ImportLivenessState(batch, state); err != nil {
+			batch.Close()
+			return snapshottypes.SnapshotItem{}, err
+		}
+		if err := batch.WriteSync(); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+		batch.Close()
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+)
+
+// Upper bound on the number of blocks simulated when projecting the jail time of a validator.
+const maxProjectedBlocks = 100_000
+
+// GetMissedBlocksTimeline returns the blocks missed by the validator within the signed blocks window.
+func (k Keeper) GetMissedBlocksTimeline(ctx sdk.Context, consAddr sdk.ConsAddress) types.MissedBlocksTimeline {
+	var (
+		window        = k.SignedBlocksWindowDuration(ctx)
+		_, blockTimes = truncateByWindow(ctx.BlockTime(), k.getBlockTimes(), window)
+		_, missed     = truncateByWindow(ctx.BlockTime(), k.getMissingBlocksForValidator(consAddr), window)
+	)
+
+	timeline := types.MissedBlocksTimeline{
+		MissedBlocks: missed,
+		TotalBlocks:  int64(len(blockTimes)),
+		MissedRatio:  sdk.ZeroDec(),
+	}
+	if timeline.MissedBlocks == nil {
+		timeline.MissedBlocks = []time.Time{}
+	}
+	if len(blockTimes) > 0 {
+		timeline.MissedRatio = sdk.NewDec(int64(len(missed))).QuoInt64(int64(len(blockTimes)))
+	}
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsJailed() {
+		return timeline
+	}
+
+	maxMissedRatio := sdk.OneDec().Sub(k.MinSignedPerWindow(ctx))
+	if jailTime, ok := projectJailTime(blockTimes, missed, window, maxMissedRatio); ok {
+		timeline.ProjectedJailTime = &jailTime
+	}
+
+	return timeline
+}
+
+// projectJailTime estimates when a validator that misses every block from now on is jailed for downtime.
+// Blocks are assumed to follow each other at the average interval of the window.
+func projectJailTime(blockTimes, missed []time.Time, window time.Duration, maxMissedRatio sdk.Dec) (time.Time, bool) {
+	if len(blockTimes) < 2 {
+		return time.Time{}, false
+	}
+
+	var (
+		first    = blockTimes[0]
+		last     = blockTimes[len(blockTimes)-1]
+		interval = last.Sub(first) / time.Duration(len(blockTimes)-1)
+	)
+	if interval <= 0 {
+		return time.Time{}, false
+	}
+
+	// Copy to avoid appending into the backing arrays of the caller
+	blockTimes = append([]time.Time{}, blockTimes...)
+	missed = append([]time.Time{}, missed...)
+
+	// Once every block in the window has been missed, the ratio cannot increase any further
+	steps := int64(window/interval) + 1
+	if steps > maxProjectedBlocks {
+		steps = maxProjectedBlocks
+	}
+
+	for i := int64(1); i <= steps; i++ {
+		blockTime := last.Add(time.Duration(i) * interval)
+
+		slashable, bt := truncateByWindow(blockTime, append(blockTimes, blockTime), window)
+		_, m := truncateByWindow(blockTime, append(missed, blockTime), window)
+		blockTimes, missed = bt, m
+
+		// The window must be full before a validator can be slashed
+		if !slashable {
+			continue
+		}
+
+		missedRatio := sdk.NewDec(int64(len(missed))).QuoInt64(int64(len(blockTimes)))
+		if maxMissedRatio.LT(missedRatio) {
+			return blockTime, true
+		}
+	}
+
+	return time.Time{}, false
+}
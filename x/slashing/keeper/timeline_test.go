@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestMissedBlocksTimeline(t *testing.T) {
+	ctx, keeper, _, _, stakingKeeper, database := createTestComponents(t)
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.OneInt())
+	addr, pk := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(pk.Address())
+
+	_, err := staking.NewHandler(stakingKeeper)(ctx, NewTestMsgCreateValidator(addr, pk, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	var (
+		now    = time.Now().UTC()
+		val    = abci.Validator{Address: pk.Address(), Power: power}
+		missed []time.Time
+	)
+
+	// Two hours of blocks with a one minute interval, of which the last ten are missed
+	for height := int64(1); height <= 120; height++ {
+		now = now.Add(time.Minute)
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(now)
+
+		signed := height <= 110
+		if !signed {
+			missed = append(missed, now)
+		}
+
+		req := abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{
+				Votes: []abci.VoteInfo{{Validator: val, SignedLastBlock: signed}},
+			},
+		}
+		batch := database.NewBatch()
+		BeginBlocker(apptypes.WithCurrentBatch(ctx, batch), req, keeper)
+		require.NoError(t, batch.Write())
+	}
+
+	timeline := keeper.GetMissedBlocksTimeline(ctx, consAddr)
+	require.Equal(t, missed, timeline.MissedBlocks)
+	require.Equal(t, int64(61), timeline.TotalBlocks)
+	require.Equal(t, sdk.NewDec(10).QuoInt64(61), timeline.MissedRatio)
+
+	// With 61 blocks in the window, the validator is jailed once 55 of them are missed
+	require.NotNil(t, timeline.ProjectedJailTime)
+	require.Equal(t, now.Add(45*time.Minute), *timeline.ProjectedJailTime)
+
+	// Nothing is projected for an unknown validator
+	timeline = keeper.GetMissedBlocksTimeline(ctx, sdk.ConsAddress(pks[1].Address()))
+	require.Empty(t, timeline.MissedBlocks)
+	require.True(t, timeline.MissedRatio.IsZero())
+	require.Nil(t, timeline.ProjectedJailTime)
+}
+
+func TestProjectJailTime(t *testing.T) {
+	var (
+		start      = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		blockTimes []time.Time
+	)
+	for i := 0; i < 10; i++ {
+		blockTimes = append(blockTimes, start.Add(time.Duration(i)*time.Minute))
+	}
+	last := blockTimes[len(blockTimes)-1]
+
+	// Window of ten blocks with none missed: more than half of the window has to be missed
+	jailTime, ok := projectJailTime(blockTimes, nil, 10*time.Minute, sdk.NewDecWithPrec(5, 1))
+	require.True(t, ok)
+	require.Equal(t, last.Add(6*time.Minute), jailTime)
+
+	// A validator can never miss more than all blocks
+	_, ok = projectJailTime(blockTimes, nil, 10*time.Minute, sdk.OneDec())
+	require.False(t, ok)
+
+	// The projection does not modify the arguments
+	missed := blockTimes[8:9:9]
+	_, _ = projectJailTime(blockTimes[:9:9], missed, 10*time.Minute, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, blockTimes[8], missed[0])
+	require.Len(t, blockTimes, 10)
+
+	// Too few blocks to project anything
+	_, ok = projectJailTime(blockTimes[:1], nil, 10*time.Minute, sdk.NewDecWithPrec(5, 1))
+	require.False(t, ok)
+}
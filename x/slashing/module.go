@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	emcli "github.com/e-money/em-ledger/x/slashing/client/cli"
 	"github.com/e-money/em-ledger/x/slashing/migration"
 
 	sdkslashing "github.com/cosmos/cosmos-sdk/x/slashing"
@@ -59,22 +60,17 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 // DefaultGenesis returns default genesis state as raw bytes for the slashing
 // module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	genesis := &sdkslashingtypes.GenesisState{
-		Params:       types.DefaultParams(),
-		SigningInfos: []sdkslashingtypes.SigningInfo{},
-		MissedBlocks: []sdkslashingtypes.ValidatorMissedBlocks{},
-	}
-	return cdc.MustMarshalJSON(genesis)
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 // ValidateGenesis performs genesis state validation for the slashing module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	var data sdkslashingtypes.GenesisState
+	var data types.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", sdkslashingtypes.ModuleName, err)
 	}
 
-	return sdkslashingtypes.ValidateGenesis(data)
+	return types.ValidateGenesis(data)
 }
 
 // RegisterRESTRoutes registers the REST routes for the slashing module.
@@ -92,9 +88,9 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
-// GetQueryCmd returns no root query command for the slashing module.
+// GetQueryCmd returns the root query command for the slashing module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
+	return emcli.GetQueryCmd()
 }
 
 //____________________________________________________________________________
@@ -158,9 +154,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // InitGenesis performs genesis initialization for the slashing module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState sdkslashingtypes.GenesisState
+	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
-	sdkslashing.InitGenesis(ctx, am.keeper.Keeper, am.stakingKeeper, &genesisState)
+	InitGenesis(ctx, am.keeper, am.stakingKeeper, &genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,10 @@
+package types
+
+// slashing module event types
+const (
+	EventTypeLivenessWarning = "liveness_warning"
+
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyJailDuration  = "jail_duration"
+)
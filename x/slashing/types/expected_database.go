@@ -4,7 +4,18 @@
 
 package types
 
-import db "github.com/tendermint/tm-db"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// ParamSubspace extends the SDK slashing parameter subspace with access to optional parameters.
+type ParamSubspace interface {
+	sdkslashingtypes.ParamSubspace
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+	Set(ctx sdk.Context, key []byte, value interface{})
+}
 
 // A reduced database interface which ensures that all modifications to state are written elsewhere.
 type ReadOnlyDB interface {
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		SigningInfos: []slashingtypes.SigningInfo{},
+		MissedBlocks: []slashingtypes.ValidatorMissedBlocks{},
+		Liveness: LivenessState{
+			MissedBlocks: []ValidatorMissedBlockTimes{},
+			Warnings:     []ValidatorLivenessWarning{},
+		},
+		DowntimeOffences:          []ValidatorDowntimeOffences{},
+		LivenessWarningThresholds: DefaultLivenessWarningThresholds,
+		DowntimePenaltyCurve:      DefaultDowntimePenaltyCurve,
+		DowntimeOffencePeriod:     DefaultDowntimeOffencePeriod,
+	}
+}
+
+// SDKGenesisState returns the part of the genesis state handled by the SDK slashing module.
+func (gs GenesisState) SDKGenesisState() *slashingtypes.GenesisState {
+	return slashingtypes.NewGenesisState(gs.Params, gs.SigningInfos, gs.MissedBlocks)
+}
+
+func ValidateGenesis(gs GenesisState) error {
+	if err := slashingtypes.ValidateGenesis(*gs.SDKGenesisState()); err != nil {
+		return err
+	}
+
+	if err := ValidateLivenessWarningThresholds(gs.LivenessWarningThresholds); err != nil {
+		return err
+	}
+	if err := ValidateDowntimePenaltyCurve(gs.DowntimePenaltyCurve); err != nil {
+		return err
+	}
+	if err := ValidateDowntimeOffencePeriod(gs.DowntimeOffencePeriod); err != nil {
+		return err
+	}
+
+	for _, m := range gs.Liveness.MissedBlocks {
+		if _, err := sdk.ConsAddressFromBech32(m.Address); err != nil {
+			return fmt.Errorf("invalid missed blocks address %q: %w", m.Address, err)
+		}
+	}
+
+	for _, w := range gs.Liveness.Warnings {
+		if _, err := sdk.ConsAddressFromBech32(w.Address); err != nil {
+			return fmt.Errorf("invalid liveness warning address %q: %w", w.Address, err)
+		}
+		if w.Threshold.IsNil() || !w.Threshold.IsPositive() {
+			return fmt.Errorf("invalid liveness warning threshold %s", w.Threshold)
+		}
+	}
+
+	for _, o := range gs.DowntimeOffences {
+		if _, err := sdk.ConsAddressFromBech32(o.Address); err != nil {
+			return fmt.Errorf("invalid downtime offences address %q: %w", o.Address, err)
+		}
+	}
+
+	return nil
+}
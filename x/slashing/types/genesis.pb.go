@@ -0,0 +1,1531 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/slashing/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState extends the SDK slashing genesis state with the liveness data
+// that is kept outside of the IAVL store.
+type GenesisState struct {
+	Params           types.Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SigningInfos     []types.SigningInfo           `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks     []types.ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	Liveness         LivenessState                 `protobuf:"bytes,4,opt,name=liveness,proto3" json:"liveness" yaml:"liveness"`
+	DowntimeOffences []ValidatorDowntimeOffences   `protobuf:"bytes,5,rep,name=downtime_offences,json=downtimeOffences,proto3" json:"downtime_offences" yaml:"downtime_offences"`
+	// Fractions of the allowed missed blocks at which a validator is warned.
+	LivenessWarningThresholds []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,rep,name=liveness_warning_thresholds,json=livenessWarningThresholds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liveness_warning_thresholds" yaml:"liveness_warning_thresholds"`
+	DowntimePenaltyCurve      []DowntimePenaltyStep                    `protobuf:"bytes,7,rep,name=downtime_penalty_curve,json=downtimePenaltyCurve,proto3" json:"downtime_penalty_curve" yaml:"downtime_penalty_curve"`
+	DowntimeOffencePeriod     time.Duration                            `protobuf:"bytes,8,opt,name=downtime_offence_period,json=downtimeOffencePeriod,proto3,stdduration" json:"downtime_offence_period" yaml:"downtime_offence_period"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
+func (m *GenesisState) GetSigningInfos() []types.SigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []types.ValidatorMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiveness() LivenessState {
+	if m != nil {
+		return m.Liveness
+	}
+	return LivenessState{}
+}
+
+func (m *GenesisState) GetDowntimeOffences() []ValidatorDowntimeOffences {
+	if m != nil {
+		return m.DowntimeOffences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDowntimePenaltyCurve() []DowntimePenaltyStep {
+	if m != nil {
+		return m.DowntimePenaltyCurve
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDowntimeOffencePeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeOffencePeriod
+	}
+	return 0
+}
+
+// LivenessState holds the block times within the signed blocks window, the
+// blocks missed by each validator and the liveness warnings in effect.
+type LivenessState struct {
+	BlockTimes   []time.Time                 `protobuf:"bytes,1,rep,name=block_times,json=blockTimes,proto3,stdtime" json:"block_times" yaml:"block_times"`
+	MissedBlocks []ValidatorMissedBlockTimes `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	Warnings     []ValidatorLivenessWarning  `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings" yaml:"warnings"`
+}
+
+func (m *LivenessState) Reset()         { *m = LivenessState{} }
+func (m *LivenessState) String() string { return proto.CompactTextString(m) }
+func (*LivenessState) ProtoMessage()    {}
+func (*LivenessState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{1}
+}
+func (m *LivenessState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessState.Merge(m, src)
+}
+func (m *LivenessState) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessState) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessState proto.InternalMessageInfo
+
+func (m *LivenessState) GetBlockTimes() []time.Time {
+	if m != nil {
+		return m.BlockTimes
+	}
+	return nil
+}
+
+func (m *LivenessState) GetMissedBlocks() []ValidatorMissedBlockTimes {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *LivenessState) GetWarnings() []ValidatorLivenessWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// ValidatorMissedBlockTimes holds the times of the blocks a validator did not
+// sign.
+type ValidatorMissedBlockTimes struct {
+	Address          string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	MissedBlockTimes []time.Time `protobuf:"bytes,2,rep,name=missed_block_times,json=missedBlockTimes,proto3,stdtime" json:"missed_block_times" yaml:"missed_block_times"`
+}
+
+func (m *ValidatorMissedBlockTimes) Reset()         { *m = ValidatorMissedBlockTimes{} }
+func (m *ValidatorMissedBlockTimes) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlockTimes) ProtoMessage()    {}
+func (*ValidatorMissedBlockTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{2}
+}
+func (m *ValidatorMissedBlockTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlockTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlockTimes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlockTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlockTimes.Merge(m, src)
+}
+func (m *ValidatorMissedBlockTimes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlockTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlockTimes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlockTimes proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlockTimes) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedBlockTimes) GetMissedBlockTimes() []time.Time {
+	if m != nil {
+		return m.MissedBlockTimes
+	}
+	return nil
+}
+
+// ValidatorLivenessWarning holds the highest liveness warning threshold a
+// validator has crossed since its missed ratio last dropped below it.
+type ValidatorLivenessWarning struct {
+	Address   string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold" yaml:"threshold"`
+}
+
+func (m *ValidatorLivenessWarning) Reset()         { *m = ValidatorLivenessWarning{} }
+func (m *ValidatorLivenessWarning) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessWarning) ProtoMessage()    {}
+func (*ValidatorLivenessWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{3}
+}
+func (m *ValidatorLivenessWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessWarning.Merge(m, src)
+}
+func (m *ValidatorLivenessWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessWarning proto.InternalMessageInfo
+
+func (m *ValidatorLivenessWarning) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "em.slashing.v1.GenesisState")
+	proto.RegisterType((*LivenessState)(nil), "em.slashing.v1.LivenessState")
+	proto.RegisterType((*ValidatorMissedBlockTimes)(nil), "em.slashing.v1.ValidatorMissedBlockTimes")
+	proto.RegisterType((*ValidatorLivenessWarning)(nil), "em.slashing.v1.ValidatorLivenessWarning")
+}
+
+func init() { proto.RegisterFile("em/slashing/v1/genesis.proto", fileDescriptor_97b433287170e3d8) }
+
+var fileDescriptor_97b433287170e3d8 = []byte{
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x18, 0x8d, 0x03, 0x97, 0x9f, 0xe1, 0xe7, 0x72, 0x47, 0xdc, 0x8b, 0x93, 0x0b, 0x76, 0x34, 0x2d,
+	0x28, 0xad, 0x1a, 0x5b, 0xd0, 0x5d, 0xa5, 0x6e, 0x5c, 0x24, 0x54, 0x89, 0xaa, 0xc8, 0xa0, 0x56,
+	0x6a, 0x55, 0xa5, 0x4e, 0x3c, 0x71, 0x2c, 0x6c, 0x4f, 0xea, 0x71, 0x02, 0x59, 0x54, 0xea, 0x23,
+	0xb0, 0xe8, 0xa2, 0xcf, 0xd0, 0x37, 0x68, 0x9f, 0x80, 0x25, 0xcb, 0xaa, 0x8b, 0xb4, 0x82, 0x37,
+	0xe0, 0x09, 0x2a, 0xcf, 0x8c, 0x8d, 0x6d, 0x88, 0x10, 0xab, 0x24, 0xfe, 0xce, 0x77, 0xce, 0x37,
+	0x67, 0xbe, 0xe3, 0x80, 0x55, 0xec, 0xeb, 0xd4, 0xb3, 0x68, 0xd7, 0x0d, 0x1c, 0x7d, 0xb0, 0xa9,
+	0x3b, 0x38, 0xc0, 0xd4, 0xa5, 0x5a, 0x2f, 0x24, 0x11, 0x81, 0x8b, 0xd8, 0xd7, 0x92, 0xaa, 0x36,
+	0xd8, 0xac, 0x2e, 0x3b, 0xc4, 0x21, 0xac, 0xa4, 0xc7, 0xdf, 0x38, 0xaa, 0xaa, 0x38, 0x84, 0x38,
+	0x1e, 0xd6, 0xd9, 0xaf, 0x56, 0xbf, 0xa3, 0xdb, 0xfd, 0xd0, 0x8a, 0x5c, 0x12, 0x88, 0xba, 0x5a,
+	0xac, 0x47, 0xae, 0x8f, 0x69, 0x64, 0xf9, 0x3d, 0x01, 0x58, 0x6f, 0x13, 0xea, 0x13, 0x9a, 0x1d,
+	0xa4, 0x85, 0x23, 0xab, 0x30, 0x4d, 0x75, 0x63, 0x1c, 0x2c, 0x1d, 0x91, 0xe3, 0xd6, 0x0a, 0x67,
+	0xca, 0x97, 0xd1, 0xc9, 0x34, 0x98, 0xdf, 0xe1, 0xc4, 0xfb, 0x91, 0x15, 0x61, 0xf8, 0x14, 0x4c,
+	0xf5, 0xac, 0xd0, 0xf2, 0xa9, 0x2c, 0xd5, 0xa4, 0xfa, 0xdc, 0x96, 0xaa, 0x71, 0xa1, 0xec, 0xd1,
+	0x99, 0x90, 0xb6, 0xc7, 0x60, 0xc6, 0xe4, 0xe9, 0x48, 0x2d, 0x99, 0xa2, 0x09, 0x3a, 0x60, 0x81,
+	0xba, 0x4e, 0xe0, 0x06, 0x4e, 0xd3, 0x0d, 0x3a, 0x84, 0xca, 0xe5, 0xda, 0x44, 0x7d, 0x6e, 0xeb,
+	0xfe, 0x58, 0x96, 0x7d, 0x8e, 0x7e, 0x1e, 0x74, 0x88, 0xb1, 0x1a, 0x53, 0x5d, 0x8e, 0xd4, 0xe5,
+	0xa1, 0xe5, 0x7b, 0x4f, 0x50, 0x8e, 0x08, 0x99, 0xf3, 0xf4, 0x0a, 0x4a, 0xe1, 0x07, 0xb0, 0xe0,
+	0xbb, 0x94, 0x62, 0xbb, 0xd9, 0xf2, 0x48, 0xfb, 0x90, 0xca, 0x13, 0x4c, 0x48, 0x1b, 0x2b, 0xf4,
+	0xca, 0xf2, 0x5c, 0xdb, 0x8a, 0x48, 0xf8, 0x82, 0xb5, 0x19, 0xac, 0xab, 0x28, 0x99, 0xa3, 0x44,
+	0xe6, 0xbc, 0x9f, 0xc1, 0x42, 0x13, 0xcc, 0x78, 0xee, 0x20, 0x36, 0x8b, 0xca, 0x93, 0xcc, 0x9c,
+	0x35, 0x2d, 0xbf, 0x13, 0xda, 0xae, 0xa8, 0x33, 0x2f, 0x8d, 0x15, 0x41, 0xfe, 0x37, 0x27, 0x4f,
+	0x9a, 0x91, 0x99, 0xf2, 0xc0, 0x63, 0xf0, 0x8f, 0x4d, 0x8e, 0x82, 0x78, 0x09, 0x9a, 0xa4, 0xd3,
+	0xc1, 0x41, 0x1b, 0x53, 0xf9, 0x2f, 0x76, 0x94, 0x07, 0x45, 0xf2, 0xf4, 0x04, 0xdb, 0xa2, 0xe3,
+	0xa5, 0x68, 0x30, 0x6a, 0x42, 0x48, 0xe6, 0x42, 0xd7, 0x18, 0x91, 0xb9, 0x64, 0x17, 0x7a, 0xe0,
+	0x67, 0x09, 0xfc, 0x9f, 0x8c, 0xd1, 0x3c, 0xb2, 0x42, 0x66, 0x75, 0xd4, 0x0d, 0x31, 0xed, 0x12,
+	0xcf, 0xa6, 0xf2, 0x54, 0x6d, 0xa2, 0x3e, 0x6b, 0x1c, 0xc4, 0xcc, 0x3f, 0x47, 0xea, 0x86, 0xe3,
+	0x46, 0xdd, 0x7e, 0x4b, 0x6b, 0x13, 0x5f, 0x17, 0x9b, 0xc7, 0x3f, 0x1a, 0xd4, 0x3e, 0xd4, 0xa3,
+	0x61, 0x0f, 0x53, 0x6d, 0x1b, 0xb7, 0x2f, 0x47, 0x2a, 0xca, 0x1f, 0xf6, 0x06, 0x6a, 0x64, 0x56,
+	0x92, 0xea, 0x6b, 0x5e, 0x3c, 0x48, 0x6b, 0xf0, 0x93, 0x04, 0xfe, 0x4b, 0xe7, 0xef, 0xe1, 0xc0,
+	0xf2, 0xa2, 0x61, 0xb3, 0xdd, 0x0f, 0x07, 0x58, 0x9e, 0x66, 0xb6, 0xdc, 0x2b, 0xda, 0x92, 0xb8,
+	0xb1, 0xc7, 0xc1, 0xfb, 0x11, 0xee, 0x19, 0xeb, 0xc2, 0x90, 0xb5, 0x82, 0x21, 0x39, 0x42, 0x64,
+	0x2e, 0xdb, 0xf9, 0xde, 0x67, 0xf1, 0x63, 0xf8, 0x11, 0xac, 0x14, 0x1d, 0x6c, 0xf6, 0x70, 0xe8,
+	0x12, 0x5b, 0x9e, 0x61, 0xd7, 0x5e, 0xd1, 0x78, 0x88, 0xb5, 0x24, 0xc4, 0xda, 0xb6, 0x08, 0xb9,
+	0xf1, 0x50, 0x08, 0x2b, 0x37, 0xdf, 0x84, 0xe0, 0x41, 0x5f, 0x7e, 0xa9, 0x92, 0xf9, 0x6f, 0xe1,
+	0x4e, 0xf6, 0x78, 0xed, 0x5b, 0x19, 0x2c, 0xe4, 0xf6, 0x08, 0xbe, 0x05, 0x73, 0x6c, 0x23, 0x9b,
+	0x31, 0x38, 0x0e, 0x66, 0xec, 0x43, 0xf5, 0xda, 0x10, 0x07, 0xc9, 0x9b, 0xc4, 0x50, 0xc4, 0x14,
+	0x90, 0x4f, 0x91, 0x69, 0x46, 0x27, 0xb1, 0x32, 0x60, 0x4f, 0x18, 0x1e, 0x7a, 0xc5, 0x20, 0x95,
+	0x6f, 0xd9, 0xbe, 0x4c, 0x7e, 0x18, 0xc3, 0x9d, 0x32, 0xf4, 0x0e, 0xcc, 0x88, 0x85, 0x48, 0x12,
+	0x5b, 0x1f, 0x2b, 0xb4, 0x9b, 0x5f, 0x92, 0x62, 0x9c, 0x12, 0x1e, 0x64, 0xa6, 0x94, 0xe8, 0xbb,
+	0x04, 0x2a, 0x63, 0x07, 0x85, 0x8f, 0xc0, 0xb4, 0x65, 0xdb, 0x21, 0xa6, 0xfc, 0xe5, 0x36, 0x6b,
+	0xc0, 0xcb, 0x91, 0xba, 0xc8, 0xd9, 0x44, 0x01, 0x99, 0x09, 0x04, 0x12, 0x00, 0xb3, 0x47, 0x11,
+	0xe6, 0x97, 0x6f, 0x35, 0x3f, 0xd9, 0xbd, 0xca, 0x75, 0x3b, 0xb2, 0x77, 0xb0, 0xe4, 0x17, 0xc6,
+	0x43, 0x5f, 0x25, 0x20, 0x8f, 0x3b, 0xfc, 0x1d, 0x67, 0x7f, 0x0f, 0x66, 0xd3, 0xbc, 0xc9, 0x65,
+	0x86, 0x37, 0xee, 0x9c, 0xe4, 0x25, 0xce, 0x9e, 0x12, 0x21, 0xf3, 0x8a, 0xd4, 0xd8, 0x39, 0x3d,
+	0x57, 0xa4, 0xb3, 0x73, 0x45, 0xfa, 0x7d, 0xae, 0x48, 0x27, 0x17, 0x4a, 0xe9, 0xec, 0x42, 0x29,
+	0xfd, 0xb8, 0x50, 0x4a, 0x6f, 0x1a, 0x19, 0x01, 0xdc, 0xf0, 0x49, 0x80, 0x87, 0x3a, 0xf6, 0x1b,
+	0x1e, 0xb6, 0x1d, 0x1c, 0xea, 0xc7, 0x57, 0xff, 0x46, 0x4c, 0xab, 0x35, 0xc5, 0x2c, 0x7c, 0xfc,
+	0x67, 0x00, 0xdb, 0x25, 0xab, 0x22, 0x7d, 0x07, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeOffencePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffencePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.DowntimePenaltyCurve) > 0 {
+		for iNdEx := len(m.DowntimePenaltyCurve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimePenaltyCurve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LivenessWarningThresholds) > 0 {
+		for iNdEx := len(m.LivenessWarningThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LivenessWarningThresholds[iNdEx].Size()
+				i -= size
+				if _, err := m.LivenessWarningThresholds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DowntimeOffences) > 0 {
+		for iNdEx := len(m.DowntimeOffences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeOffences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LivenessState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlockTimes) > 0 {
+		for iNdEx := len(m.BlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedBlockTimes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlockTimes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlockTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlockTimes) > 0 {
+		for iNdEx := len(m.MissedBlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.MissedBlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.MissedBlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Liveness.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DowntimeOffences) > 0 {
+		for _, e := range m.DowntimeOffences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LivenessWarningThresholds) > 0 {
+		for _, e := range m.LivenessWarningThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DowntimePenaltyCurve) > 0 {
+		for _, e := range m.DowntimePenaltyCurve {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffencePeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LivenessState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockTimes) > 0 {
+		for _, e := range m.BlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlockTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedBlockTimes) > 0 {
+		for _, e := range m.MissedBlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorLivenessWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, types.SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, types.ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeOffences = append(m.DowntimeOffences, ValidatorDowntimeOffences{})
+			if err := m.DowntimeOffences[len(m.DowntimeOffences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWarningThresholds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LivenessWarningThresholds = append(m.LivenessWarningThresholds, v)
+			if err := m.LivenessWarningThresholds[len(m.LivenessWarningThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePenaltyCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimePenaltyCurve = append(m.DowntimePenaltyCurve, DowntimePenaltyStep{})
+			if err := m.DowntimePenaltyCurve[len(m.DowntimePenaltyCurve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffencePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeOffencePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LivenessState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockTimes = append(m.BlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.BlockTimes[len(m.BlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, ValidatorMissedBlockTimes{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, ValidatorLivenessWarning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlockTimes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlockTimes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlockTimes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlockTimes = append(m.MissedBlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.MissedBlockTimes[len(m.MissedBlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLivenessWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
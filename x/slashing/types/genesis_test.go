@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(gs *GenesisState)
+		expErr bool
+	}{
+		"default": {
+			mutate: func(gs *GenesisState) {},
+		},
+		"no liveness warnings": {
+			mutate: func(gs *GenesisState) { gs.LivenessWarningThresholds = nil },
+		},
+		"liveness warning threshold of one": {
+			mutate: func(gs *GenesisState) { gs.LivenessWarningThresholds = []sdk.Dec{sdk.OneDec()} },
+			expErr: true,
+		},
+		"negative liveness warning threshold": {
+			mutate: func(gs *GenesisState) { gs.LivenessWarningThresholds = []sdk.Dec{sdk.NewDecWithPrec(-5, 1)} },
+			expErr: true,
+		},
+		"unordered penalty curve": {
+			mutate: func(gs *GenesisState) {
+				gs.DowntimePenaltyCurve = []DowntimePenaltyStep{
+					{Offences: 2, SlashMultiplier: sdk.OneDec(), JailMultiplier: sdk.OneDec()},
+					{Offences: 1, SlashMultiplier: sdk.OneDec(), JailMultiplier: sdk.OneDec()},
+				}
+			},
+			expErr: true,
+		},
+		"negative offence period": {
+			mutate: func(gs *GenesisState) { gs.DowntimeOffencePeriod = -time.Hour },
+			expErr: true,
+		},
+		"penalty step without multiplier": {
+			mutate: func(gs *GenesisState) {
+				gs.DowntimePenaltyCurve = []DowntimePenaltyStep{{Offences: 1, SlashMultiplier: sdk.OneDec()}}
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			spec.mutate(gs)
+
+			err := ValidateGenesis(*gs)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Prefixes below 0x10 are reserved for the SDK slashing module, which shares the store.
+var DowntimeOffencesKeyPrefix = []byte{0x10}
+
+func GetDowntimeOffencesKey(consAddr sdk.ConsAddress) []byte {
+	return append(DowntimeOffencesKeyPrefix, address.MustLengthPrefix(consAddr)...)
+}
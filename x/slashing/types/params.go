@@ -1,9 +1,11 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -11,12 +13,23 @@ const (
 	DefaultParamspace                 = slashingtypes.ModuleName
 	DefaultSignedBlocksWindowDuration = time.Hour
 	DefaultDowntimeJailDuration       = DefaultSignedBlocksWindowDuration
+	DefaultDowntimeOffencePeriod      = 30 * 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(1, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(1000))
+
+	// Fractions of the allowed missed blocks at which a validator is warned.
+	DefaultLivenessWarningThresholds = []sdk.Dec{
+		sdk.NewDecWithPrec(50, 2),
+		sdk.NewDecWithPrec(75, 2),
+		sdk.NewDecWithPrec(90, 2),
+	}
+
+	// Repeated offences are not punished harder unless changed by the authority.
+	DefaultDowntimePenaltyCurve = []DowntimePenaltyStep{}
 )
 
 var (
@@ -26,8 +39,25 @@ var (
 	KeyDowntimeJailDuration    = slashingtypes.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign = slashingtypes.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime   = slashingtypes.KeySlashFractionDowntime
+
+	KeyLivenessWarningThresholds = []byte("LivenessWarningThresholds")
+	KeyDowntimePenaltyCurve      = []byte("DowntimePenaltyCurve")
+	KeyDowntimeOffencePeriod     = []byte("DowntimeOffencePeriod")
 )
 
+// ParamKeyTable extends the SDK slashing parameters with the e-money specific ones.
+func ParamKeyTable() paramtypes.KeyTable {
+	var (
+		thresholds    = make([]sdk.Dec, 0)
+		curve         = make([]DowntimePenaltyStep, 0)
+		offencePeriod time.Duration
+	)
+	return slashingtypes.ParamKeyTable().
+		RegisterType(paramtypes.NewParamSetPair(KeyLivenessWarningThresholds, &thresholds, ValidateLivenessWarningThresholds)).
+		RegisterType(paramtypes.NewParamSetPair(KeyDowntimePenaltyCurve, &curve, ValidateDowntimePenaltyCurve)).
+		RegisterType(paramtypes.NewParamSetPair(KeyDowntimeOffencePeriod, &offencePeriod, ValidateDowntimeOffencePeriod))
+}
+
 func DefaultParams() slashingtypes.Params {
 	return slashingtypes.Params{
 		SignedBlocksWindow:      DefaultSignedBlocksWindowDuration.Nanoseconds(),
@@ -37,3 +67,49 @@ func DefaultParams() slashingtypes.Params {
 		SlashFractionDowntime:   DefaultSlashFractionDowntime,
 	}
 }
+
+func ValidateLivenessWarningThresholds(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, t := range v {
+		if t.IsNil() || !t.IsPositive() || !t.LT(sdk.OneDec()) {
+			return fmt.Errorf("liveness warning threshold must be between 0 and 1: %s", t)
+		}
+	}
+	return nil
+}
+
+// ValidateDowntimePenaltyCurve requires steps in increasing order of earlier offences with positive multipliers.
+func ValidateDowntimePenaltyCurve(i interface{}) error {
+	v, ok := i.([]DowntimePenaltyStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for n, step := range v {
+		if n > 0 && v[n-1].Offences >= step.Offences {
+			return fmt.Errorf("penalty steps must be ordered by increasing offences: %d", step.Offences)
+		}
+		if step.SlashMultiplier.IsNil() || !step.SlashMultiplier.IsPositive() {
+			return fmt.Errorf("slash multiplier of penalty step must be positive: %s", step.SlashMultiplier)
+		}
+		if step.JailMultiplier.IsNil() || !step.JailMultiplier.IsPositive() {
+			return fmt.Errorf("jail multiplier of penalty step must be positive: %s", step.JailMultiplier)
+		}
+	}
+	return nil
+}
+
+func ValidateDowntimeOffencePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("downtime offence period must not be negative: %s", v)
+	}
+	return nil
+}
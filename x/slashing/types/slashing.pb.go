@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/slashing/v1/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DowntimePenaltyStep scales the downtime penalty of validators with at least
+// the given number of earlier downtime offences within the offence period.
+type DowntimePenaltyStep struct {
+	Offences uint32 `protobuf:"varint,1,opt,name=offences,proto3" json:"offences,omitempty" yaml:"offences"`
+	// Multiplier of the downtime slash fraction.
+	SlashMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_multiplier,json=slashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_multiplier" yaml:"slash_multiplier"`
+	// Multiplier of the downtime jail duration.
+	JailMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=jail_multiplier,json=jailMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_multiplier" yaml:"jail_multiplier"`
+}
+
+func (m *DowntimePenaltyStep) Reset()         { *m = DowntimePenaltyStep{} }
+func (m *DowntimePenaltyStep) String() string { return proto.CompactTextString(m) }
+func (*DowntimePenaltyStep) ProtoMessage()    {}
+func (*DowntimePenaltyStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{0}
+}
+func (m *DowntimePenaltyStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePenaltyStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePenaltyStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePenaltyStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePenaltyStep.Merge(m, src)
+}
+func (m *DowntimePenaltyStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePenaltyStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePenaltyStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePenaltyStep proto.InternalMessageInfo
+
+func (m *DowntimePenaltyStep) GetOffences() uint32 {
+	if m != nil {
+		return m.Offences
+	}
+	return 0
+}
+
+// DowntimeOffence records a validator being slashed and jailed for downtime.
+type DowntimeOffence struct {
+	Time          time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Height        int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	MissedRatio   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=missed_ratio,json=missedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"missed_ratio" yaml:"missed_ratio"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	JailDuration  time.Duration                          `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+}
+
+func (m *DowntimeOffence) Reset()         { *m = DowntimeOffence{} }
+func (m *DowntimeOffence) String() string { return proto.CompactTextString(m) }
+func (*DowntimeOffence) ProtoMessage()    {}
+func (*DowntimeOffence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{1}
+}
+func (m *DowntimeOffence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeOffence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeOffence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeOffence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeOffence.Merge(m, src)
+}
+func (m *DowntimeOffence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeOffence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeOffence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeOffence proto.InternalMessageInfo
+
+func (m *DowntimeOffence) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeOffence) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// ValidatorDowntimeOffences holds the downtime offences of a validator within
+// the offence period.
+type ValidatorDowntimeOffences struct {
+	Address  string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Offences []DowntimeOffence `protobuf:"bytes,2,rep,name=offences,proto3" json:"offences" yaml:"offences"`
+}
+
+func (m *ValidatorDowntimeOffences) Reset()         { *m = ValidatorDowntimeOffences{} }
+func (m *ValidatorDowntimeOffences) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeOffences) ProtoMessage()    {}
+func (*ValidatorDowntimeOffences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{2}
+}
+func (m *ValidatorDowntimeOffences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeOffences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeOffences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeOffences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeOffences.Merge(m, src)
+}
+func (m *ValidatorDowntimeOffences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeOffences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeOffences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeOffences proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeOffences) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorDowntimeOffences) GetOffences() []DowntimeOffence {
+	if m != nil {
+		return m.Offences
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DowntimePenaltyStep)(nil), "em.slashing.v1.DowntimePenaltyStep")
+	proto.RegisterType((*DowntimeOffence)(nil), "em.slashing.v1.DowntimeOffence")
+	proto.RegisterType((*ValidatorDowntimeOffences)(nil), "em.slashing.v1.ValidatorDowntimeOffences")
+}
+
+func init() { proto.RegisterFile("em/slashing/v1/slashing.proto", fileDescriptor_7e6e1177b659eb4c) }
+
+var fileDescriptor_7e6e1177b659eb4c = []byte{
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x72, 0xd3, 0x3a,
+	0x14, 0x8d, 0x93, 0xbe, 0x3e, 0xaa, 0x34, 0x09, 0x28, 0x85, 0xa6, 0x99, 0xc1, 0xce, 0x68, 0xc1,
+	0x84, 0x19, 0x62, 0x4f, 0xcb, 0x8e, 0xa5, 0x27, 0x10, 0x58, 0x30, 0x30, 0xa6, 0xc3, 0x82, 0x4d,
+	0x71, 0x62, 0xc5, 0x11, 0x58, 0x56, 0xb0, 0x94, 0x42, 0xfe, 0xa2, 0x4b, 0x76, 0x7c, 0x02, 0xdf,
+	0xc0, 0xae, 0xcb, 0x2e, 0x19, 0x16, 0x86, 0x49, 0xfe, 0x20, 0x5f, 0xc0, 0x48, 0xb2, 0x53, 0x13,
+	0x86, 0x45, 0x56, 0xd6, 0x3d, 0xf7, 0xe8, 0x1e, 0xe9, 0xdc, 0x2b, 0x83, 0xbb, 0x98, 0x3a, 0x3c,
+	0xf2, 0xf9, 0x84, 0xc4, 0xa1, 0x73, 0x7e, 0xbc, 0x5e, 0xdb, 0xd3, 0x84, 0x09, 0x06, 0xeb, 0x98,
+	0xda, 0x6b, 0xe8, 0xfc, 0xb8, 0x7d, 0x10, 0xb2, 0x90, 0xa9, 0x94, 0x23, 0x57, 0x9a, 0xd5, 0x36,
+	0x43, 0xc6, 0xc2, 0x08, 0x3b, 0x2a, 0x1a, 0xce, 0xc6, 0x4e, 0x30, 0x4b, 0x7c, 0x41, 0x58, 0x9c,
+	0xe5, 0xad, 0xcd, 0xbc, 0x20, 0x14, 0x73, 0xe1, 0xd3, 0xa9, 0x26, 0xa0, 0xaf, 0x65, 0xd0, 0xec,
+	0xb3, 0x8f, 0xb1, 0xc4, 0x5f, 0xe2, 0xd8, 0x8f, 0xc4, 0xfc, 0x95, 0xc0, 0x53, 0xe8, 0x80, 0x1b,
+	0x6c, 0x3c, 0xc6, 0xf1, 0x08, 0xf3, 0x96, 0xd1, 0x31, 0xba, 0x35, 0xb7, 0xb9, 0x4a, 0xad, 0xc6,
+	0xdc, 0xa7, 0xd1, 0x23, 0x94, 0x67, 0x90, 0xb7, 0x26, 0x41, 0x01, 0x6e, 0xaa, 0xe3, 0x9e, 0xd1,
+	0x59, 0x24, 0xc8, 0x34, 0x22, 0x38, 0x69, 0x95, 0x3b, 0x46, 0x77, 0xcf, 0x7d, 0x76, 0x99, 0x5a,
+	0xa5, 0x1f, 0xa9, 0x75, 0x2f, 0x24, 0x62, 0x32, 0x1b, 0xda, 0x23, 0x46, 0x9d, 0x11, 0xe3, 0x94,
+	0xf1, 0xec, 0xd3, 0xe3, 0xc1, 0x7b, 0x47, 0xcc, 0xa7, 0x98, 0xdb, 0x7d, 0x3c, 0x5a, 0xa5, 0xd6,
+	0xa1, 0x96, 0xd9, 0xac, 0x87, 0xbc, 0x86, 0x82, 0x9e, 0xaf, 0x11, 0xf8, 0x01, 0x34, 0xde, 0xf9,
+	0x24, 0x2a, 0x8a, 0x56, 0x94, 0xe8, 0xd3, 0xad, 0x45, 0xef, 0x68, 0xd1, 0x8d, 0x72, 0xc8, 0xab,
+	0x4b, 0xe4, 0x5a, 0x12, 0x7d, 0xab, 0x80, 0x46, 0xee, 0xd8, 0x0b, 0x7d, 0x7b, 0x38, 0x00, 0x3b,
+	0x32, 0x54, 0x4e, 0x55, 0x4f, 0xda, 0xb6, 0x76, 0xdd, 0xce, 0x5d, 0xb7, 0x4f, 0x73, 0xd7, 0xdd,
+	0x43, 0x79, 0xae, 0x55, 0x6a, 0x55, 0xb5, 0x9a, 0xdc, 0x85, 0x2e, 0x7e, 0x5a, 0x86, 0xa7, 0x0a,
+	0xc0, 0xfb, 0x60, 0x77, 0x82, 0x49, 0x38, 0x11, 0xca, 0xbb, 0x8a, 0x7b, 0x6b, 0x95, 0x5a, 0x35,
+	0x4d, 0xd5, 0x38, 0xf2, 0x32, 0x02, 0x9c, 0x80, 0x7d, 0x4a, 0x38, 0xc7, 0xc1, 0x99, 0xea, 0x78,
+	0x76, 0xef, 0xc7, 0x5b, 0xdf, 0xbb, 0xa9, 0xcb, 0x17, 0x6b, 0x21, 0xaf, 0xaa, 0x43, 0x4f, 0x46,
+	0x30, 0x06, 0x75, 0xdd, 0x8a, 0x71, 0xe2, 0x8f, 0xe4, 0x70, 0xb5, 0x76, 0x94, 0xd6, 0x60, 0x6b,
+	0xad, 0xdb, 0xc5, 0xc6, 0xe6, 0xd5, 0x90, 0x57, 0x53, 0xc0, 0x93, 0x2c, 0x86, 0x6f, 0x41, 0x4d,
+	0x75, 0x21, 0x9f, 0xe5, 0xd6, 0x7f, 0xca, 0xd6, 0xa3, 0xbf, 0x6c, 0xed, 0x67, 0x04, 0xb7, 0x93,
+	0xb9, 0x7a, 0x50, 0xe8, 0x61, 0xbe, 0x1b, 0x7d, 0x96, 0xf6, 0xee, 0x4b, 0x2c, 0xe7, 0xa3, 0x2f,
+	0x06, 0x38, 0x7a, 0xed, 0x47, 0x24, 0xf0, 0x05, 0x4b, 0x36, 0x9a, 0xc9, 0xe1, 0x03, 0xf0, 0xbf,
+	0x1f, 0x04, 0x09, 0xe6, 0x7a, 0xf4, 0xf7, 0x5c, 0xb8, 0x4a, 0xad, 0xba, 0x2e, 0x9d, 0x25, 0x90,
+	0x97, 0x53, 0xe0, 0x69, 0xe1, 0xa5, 0x94, 0x3b, 0x95, 0x6e, 0xf5, 0xc4, 0xb2, 0xff, 0x7c, 0xbb,
+	0xf6, 0x86, 0xc2, 0x7a, 0x08, 0xfe, 0xfd, 0x9c, 0xdc, 0xc1, 0xe5, 0xc2, 0x34, 0xae, 0x16, 0xa6,
+	0xf1, 0x6b, 0x61, 0x1a, 0x17, 0x4b, 0xb3, 0x74, 0xb5, 0x34, 0x4b, 0xdf, 0x97, 0x66, 0xe9, 0x4d,
+	0xaf, 0xe0, 0x36, 0xee, 0x51, 0x16, 0xe3, 0xb9, 0x83, 0x69, 0x2f, 0xc2, 0x41, 0x88, 0x13, 0xe7,
+	0xd3, 0xf5, 0x3f, 0x45, 0x19, 0x3f, 0xdc, 0x55, 0x6e, 0x3d, 0xfc, 0x3d, 0x00, 0xaa, 0x47, 0x60,
+	0xa3, 0x6f, 0x04, 0x00, 0x00,
+}
+
+func (m *DowntimePenaltyStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePenaltyStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePenaltyStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.JailMultiplier.Size()
+		i -= size
+		if _, err := m.JailMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashMultiplier.Size()
+		i -= size
+		if _, err := m.SlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Offences != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Offences))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DowntimeOffence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeOffence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeOffence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MissedRatio.Size()
+		i -= size
+		if _, err := m.MissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDowntimeOffences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDowntimeOffences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDowntimeOffences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offences) > 0 {
+		for iNdEx := len(m.Offences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DowntimePenaltyStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offences != 0 {
+		n += 1 + sovSlashing(uint64(m.Offences))
+	}
+	l = m.SlashMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.JailMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeOffence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = m.MissedRatio.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorDowntimeOffences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Offences) > 0 {
+		for _, e := range m.Offences {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DowntimePenaltyStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePenaltyStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePenaltyStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offences", wireType)
+			}
+			m.Offences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offences |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeOffence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeOffence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeOffence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDowntimeOffences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDowntimeOffences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDowntimeOffences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offences = append(m.Offences, DowntimeOffence{})
+			if err := m.Offences[len(m.Offences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MissedBlocksTimeline describes the liveness of a validator within the signed blocks window.
+type MissedBlocksTimeline struct {
+	// Block times of the blocks the validator did not sign.
+	MissedBlocks []time.Time
+	// Number of blocks in the window.
+	TotalBlocks int64
+	MissedRatio sdk.Dec
+	// Time at which the validator is jailed if it keeps missing blocks. Nil if it would not be jailed.
+	ProjectedJailTime *time.Time
+}